@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/spf13/cobra"
+)
+
+var analyzeFormat string
+
+var analyzeCmd = &cobra.Command{
+	Use:   "analyze",
+	Short: "Detect vulnerabilities and print the findings without writing any file.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if analyzeFormat != "text" && analyzeFormat != "json" {
+			return src.Errorf(src.InputError, "unknown format [%s], expected text or json", analyzeFormat)
+		}
+
+		in, err := loadInput()
+		if err != nil {
+			return err
+		}
+		_, findings, err := analyze(in, false, "")
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		if analyzeFormat == "json" {
+			if findings == nil {
+				findings = []*src.Finding{}
+			}
+			bz, err := json.MarshalIndent(findings, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal findings: [%v]", err)
+			}
+			fmt.Fprintln(out, string(bz))
+		} else {
+			for _, finding := range findings {
+				fmt.Fprintln(out, finding)
+				if finding.Explanation != nil && finding.Explanation.String() != "" {
+					fmt.Fprintln(out, finding.Explanation)
+				}
+			}
+		}
+
+		return failOn(findings)
+	},
+}
+
+func init() {
+	analyzeCmd.Flags().StringVar(&analyzeFormat, "format", "text", "Format of the findings printed to stdout, text or json.")
+	analyzeCmd.RegisterFlagCompletionFunc("format", fixedCompletion("text", "json"))
+	addFailOnFlag(analyzeCmd, "high")
+}
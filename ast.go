@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/roundtrip"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/cobra"
+)
+
+var (
+	astFormat   string
+	astNodeType string
+	astID       int
+)
+
+var astCmd = &cobra.Command{
+	Use:   "ast",
+	Short: "Dump the parsed abstract syntax tree, or query its nodes by type or id.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if astFormat != "tree" && astFormat != "json" && astFormat != "source" {
+			return src.Errorf(src.InputError, "unknown format [%s], expected one of tree, json and source", astFormat)
+		}
+		query := astNodeType != "" || astID >= 0
+		if astFormat == "source" && query {
+			return src.Errorf(src.InputError, "--node-type and --id can not be used with the source format")
+		}
+
+		in, err := loadInput()
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		if astFormat == "source" {
+			version, err := versionDir(in.version)
+			if err != nil {
+				return err
+			}
+			code, _, err := roundtrip.Regenerate(version, in.source, logger)
+			if err != nil {
+				return src.WrapError(src.ParseError, err)
+			}
+			fmt.Fprint(out, code)
+			return nil
+		}
+
+		nodes := []jsoniter.Any{in.source}
+		if query {
+			nodes = nil
+			walk(in.source, 0, func(node jsoniter.Any, depth int) {
+				if astNodeType != "" && node.Get("nodeType").ToString() != astNodeType {
+					return
+				}
+				if astID >= 0 && node.Get("id").ToInt() != astID {
+					return
+				}
+				nodes = append(nodes, node)
+			})
+		}
+
+		if astFormat == "json" {
+			values := make([]interface{}, 0, len(nodes))
+			for _, node := range nodes {
+				values = append(values, node.GetInterface())
+			}
+			var bz []byte
+			if query {
+				bz, err = json.MarshalIndent(values, "", "  ")
+			} else {
+				bz, err = json.MarshalIndent(values[0], "", "  ")
+			}
+			if err != nil {
+				return fmt.Errorf("failed to marshal ast: [%v]", err)
+			}
+			fmt.Fprintln(out, string(bz))
+			return nil
+		}
+
+		for _, node := range nodes {
+			if query {
+				printNode(out, node, 0)
+				continue
+			}
+			walk(node, 0, func(node jsoniter.Any, depth int) {
+				printNode(out, node, depth)
+			})
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	astCmd.Flags().StringVar(&astFormat, "format", "tree", "Format of the output, one of tree, json and source.")
+	astCmd.Flags().StringVar(&astNodeType, "node-type", "", "Only print the nodes of this type, such as FunctionDefinition.")
+	astCmd.Flags().IntVar(&astID, "id", -1, "Only print the node with this id.")
+	astCmd.RegisterFlagCompletionFunc("format", fixedCompletion("tree", "json", "source"))
+}
+
+func printNode(w io.Writer, node jsoniter.Any, depth int) {
+	line := fmt.Sprintf("%s%s #%d", strings.Repeat("  ", depth), node.Get("nodeType").ToString(), node.Get("id").ToInt())
+	if name := node.Get("name"); name.ValueType() == jsoniter.StringValue && name.ToString() != "" {
+		line += " " + name.ToString()
+	}
+	fmt.Fprintf(w, "%s (%s)\n", line, node.Get("src").ToString())
+}
+
+// walk 按照源码中出现的顺序深度优先遍历所有带 nodeType 的节点。
+func walk(node jsoniter.Any, depth int, fn func(node jsoniter.Any, depth int)) {
+	fn(node, depth)
+	for _, child := range children(node) {
+		walk(child, depth+1, fn)
+	}
+}
+
+func children(node jsoniter.Any) []jsoniter.Any {
+	var result []jsoniter.Any
+	var collect func(value jsoniter.Any)
+	collect = func(value jsoniter.Any) {
+		switch value.ValueType() {
+		case jsoniter.ObjectValue:
+			if value.Get("nodeType").ValueType() == jsoniter.StringValue {
+				result = append(result, value)
+				return
+			}
+			for _, key := range value.Keys() {
+				collect(value.Get(key))
+			}
+		case jsoniter.ArrayValue:
+			for i := 0; i < value.Size(); i++ {
+				collect(value.Get(i))
+			}
+		}
+	}
+	for _, key := range node.Keys() {
+		collect(node.Get(key))
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		si, sj := srcStart(result[i]), srcStart(result[j])
+		if si != sj {
+			return si < sj
+		}
+		return result[i].Get("id").ToInt() < result[j].Get("id").ToInt()
+	})
+	return result
+}
+
+func srcStart(node jsoniter.Any) int {
+	var start int
+	fmt.Sscanf(node.Get("src").ToString(), "%d:", &start)
+	return start
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^ 0.8.0;
+contract Lib {
+    address private _owner;
+    constructor() public {
+        _owner = msg.sender;
+    }
+    function owner() public view returns (address) {
+        return _owner;
+    }
+}
+contract Hack {
+    Lib private lib;
+    constructor(Lib _lib) public {
+        lib = _lib;
+    }
+    function call() public {
+        address(lib).delegatecall(msg.data);
+    }
+}
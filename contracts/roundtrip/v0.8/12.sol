@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^ 0.8.0;
+contract Ownable {
+    address private _owner;
+    address private _test;
+    function owner() internal view returns (address) {
+        return _owner;
+    }
+    function test() public view returns (address) {
+        return _test;
+    }
+}
+contract A is Ownable {
+    address private _b;
+}
+contract B is A {
+    address private _owner;
+    bytes private _test;
+    mapping (bytes => address) private xxx_track_mapping_owner_;
+    function func() public {
+        xxx_track_mapping_owner_["haha"] = address(this);
+        _test = "haha";
+        _owner.delegatecall(msg.data);
+        assert(xxx_track_mapping_owner_[_test] == owner());
+    }
+}
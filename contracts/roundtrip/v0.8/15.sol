@@ -0,0 +1,1768 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^ 0.8.0;
+/// @dev Wrappers over Solidity's arithmetic operations.
+/// NOTE: `SafeMath` is generally not needed starting with Solidity 0.8, since the compiler
+/// now has built in overflow checking.
+library SafeMath {
+    /// @dev Returns the addition of two unsigned integers, with an overflow flag.
+    /// _Available since v3.4._
+    function tryAdd(uint256 a, uint256 b) internal pure returns (bool, uint256) {
+        unchecked {
+            uint256 c = a + b;
+            if(c < a) {
+                return (false, 0);
+            }
+            return (true, c);
+        }
+    }
+    /// @dev Returns the subtraction of two unsigned integers, with an overflow flag.
+    /// _Available since v3.4._
+    function trySub(uint256 a, uint256 b) internal pure returns (bool, uint256) {
+        unchecked {
+            if(b > a) {
+                return (false, 0);
+            }
+            return (true, a - b);
+        }
+    }
+    /// @dev Returns the multiplication of two unsigned integers, with an overflow flag.
+    /// _Available since v3.4._
+    function tryMul(uint256 a, uint256 b) internal pure returns (bool, uint256) {
+        unchecked {
+            if(a == 0) {
+                return (true, 0);
+            }
+            uint256 c = a * b;
+            if(c / a != b) {
+                return (false, 0);
+            }
+            return (true, c);
+        }
+    }
+    /// @dev Returns the division of two unsigned integers, with a division by zero flag.
+    /// _Available since v3.4._
+    function tryDiv(uint256 a, uint256 b) internal pure returns (bool, uint256) {
+        unchecked {
+            if(b == 0) {
+                return (false, 0);
+            }
+            return (true, a / b);
+        }
+    }
+    /// @dev Returns the remainder of dividing two unsigned integers, with a division by zero flag.
+    /// _Available since v3.4._
+    function tryMod(uint256 a, uint256 b) internal pure returns (bool, uint256) {
+        unchecked {
+            if(b == 0) {
+                return (false, 0);
+            }
+            return (true, a % b);
+        }
+    }
+    /// @dev Returns the addition of two unsigned integers, reverting on
+    /// overflow.
+    /// Counterpart to Solidity's `+` operator.
+    /// Requirements:
+    /// - Addition cannot overflow.
+    function add(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a + b;
+    }
+    /// @dev Returns the subtraction of two unsigned integers, reverting on
+    /// overflow (when the result is negative).
+    /// Counterpart to Solidity's `-` operator.
+    /// Requirements:
+    /// - Subtraction cannot overflow.
+    function sub(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a - b;
+    }
+    /// @dev Returns the multiplication of two unsigned integers, reverting on
+    /// overflow.
+    /// Counterpart to Solidity's `*` operator.
+    /// Requirements:
+    /// - Multiplication cannot overflow.
+    function mul(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a * b;
+    }
+    /// @dev Returns the integer division of two unsigned integers, reverting on
+    /// division by zero. The result is rounded towards zero.
+    /// Counterpart to Solidity's `/` operator.
+    /// Requirements:
+    /// - The divisor cannot be zero.
+    function div(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a / b;
+    }
+    /// @dev Returns the remainder of dividing two unsigned integers. (unsigned integer modulo),
+    /// reverting when dividing by zero.
+    /// Counterpart to Solidity's `%` operator. This function uses a `revert`
+    /// opcode (which leaves remaining gas untouched) while Solidity uses an
+    /// invalid opcode to revert (consuming all remaining gas).
+    /// Requirements:
+    /// - The divisor cannot be zero.
+    function mod(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a % b;
+    }
+    /// @dev Returns the subtraction of two unsigned integers, reverting with custom message on
+    /// overflow (when the result is negative).
+    /// CAUTION: This function is deprecated because it requires allocating memory for the error
+    /// message unnecessarily. For custom revert reasons use {trySub}.
+    /// Counterpart to Solidity's `-` operator.
+    /// Requirements:
+    /// - Subtraction cannot overflow.
+    function sub(uint256 a, uint256 b, string memory errorMessage) internal pure returns (uint256) {
+        unchecked {
+            require(b <= a, errorMessage);
+            return a - b;
+        }
+    }
+    /// @dev Returns the integer division of two unsigned integers, reverting with custom message on
+    /// division by zero. The result is rounded towards zero.
+    /// Counterpart to Solidity's `/` operator. Note: this function uses a
+    /// `revert` opcode (which leaves remaining gas untouched) while Solidity
+    /// uses an invalid opcode to revert (consuming all remaining gas).
+    /// Requirements:
+    /// - The divisor cannot be zero.
+    function div(uint256 a, uint256 b, string memory errorMessage) internal pure returns (uint256) {
+        unchecked {
+            require(b > 0, errorMessage);
+            return a / b;
+        }
+    }
+    /// @dev Returns the remainder of dividing two unsigned integers. (unsigned integer modulo),
+    /// reverting with custom message when dividing by zero.
+    /// CAUTION: This function is deprecated because it requires allocating memory for the error
+    /// message unnecessarily. For custom revert reasons use {tryMod}.
+    /// Counterpart to Solidity's `%` operator. This function uses a `revert`
+    /// opcode (which leaves remaining gas untouched) while Solidity uses an
+    /// invalid opcode to revert (consuming all remaining gas).
+    /// Requirements:
+    /// - The divisor cannot be zero.
+    function mod(uint256 a, uint256 b, string memory errorMessage) internal pure returns (uint256) {
+        unchecked {
+            require(b > 0, errorMessage);
+            return a % b;
+        }
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @title ERC721 token receiver interface
+/// @dev Interface for any contract that wants to support safeTransfers
+/// from ERC721 asset contracts.
+interface IERC721Receiver {
+    /// @dev Whenever an {IERC721} `tokenId` token is transferred to this contract via {IERC721-safeTransferFrom}
+    /// by `operator` from `from`, this function is called.
+    /// It must return its Solidity selector to confirm the token transfer.
+    /// If any other value is returned or the interface is not implemented by the recipient, the transfer will be reverted.
+    /// The selector can be obtained in Solidity with `IERC721Receiver.onERC721Received.selector`.
+    function onERC721Received(address operator, address from, uint256 tokenId, bytes calldata data) external returns (bytes4);
+}
+pragma solidity ^ 0.8.0;
+/// @dev Implementation of the {IERC721Receiver} interface.
+/// Accepts all token transfers.
+/// Make sure the contract is able to use its token with {IERC721-safeTransferFrom}, {IERC721-approve} or {IERC721-setApprovalForAll}.
+contract ERC721Holder is IERC721Receiver {
+    /// @dev See {IERC721Receiver-onERC721Received}.
+    /// Always returns `IERC721Receiver.onERC721Received.selector`.
+    function onERC721Received(address, address, uint256, bytes memory) public override virtual returns (bytes4) {
+        return this.onERC721Received.selector;
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Interface of the ERC165 standard, as defined in the
+/// https://eips.ethereum.org/EIPS/eip-165[EIP].
+/// Implementers can declare support of contract interfaces, which can then be
+/// queried by others ({ERC165Checker}).
+/// For an implementation, see {ERC165}.
+interface IERC165 {
+    /// @dev Returns true if this contract implements the interface defined by
+    /// `interfaceId`. See the corresponding
+    /// https://eips.ethereum.org/EIPS/eip-165#how-interfaces-are-identified[EIP section]
+    /// to learn more about how these ids are created.
+    /// This function call must use less than 30 000 gas.
+    function supportsInterface(bytes4 interfaceId) external view returns (bool);
+}
+pragma solidity ^ 0.8.0;
+/// @dev Implementation of the {IERC165} interface.
+/// Contracts that want to implement ERC165 should inherit from this contract and override {supportsInterface} to check
+/// for the additional interface id that will be supported. For example:
+/// ```solidity
+/// function supportsInterface(bytes4 interfaceId) public view virtual override returns (bool) {
+///     return interfaceId == type(MyInterface).interfaceId || super.supportsInterface(interfaceId);
+/// }
+/// ```
+/// Alternatively, {ERC165Storage} provides an easier to use but more expensive implementation.
+abstract contract ERC165 is IERC165 {
+    /// @dev See {IERC165-supportsInterface}.
+    function supportsInterface(bytes4 interfaceId) public view override virtual returns (bool) {
+        return interfaceId == type(IERC165).interfaceId;
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev _Available since v3.1._
+interface IERC1155Receiver is IERC165 {
+    /// @dev Handles the receipt of a single ERC1155 token type. This function is
+    /// called at the end of a `safeTransferFrom` after the balance has been updated.
+    /// NOTE: To accept the transfer, this must return
+    /// `bytes4(keccak256("onERC1155Received(address,address,uint256,uint256,bytes)"))`
+    /// (i.e. 0xf23a6e61, or its own function selector).
+    /// @param operator The address which initiated the transfer (i.e. msg.sender)
+    /// @param from The address which previously owned the token
+    /// @param id The ID of the token being transferred
+    /// @param value The amount of tokens being transferred
+    /// @param data Additional data with no specified format
+    /// @return `bytes4(keccak256("onERC1155Received(address,address,uint256,uint256,bytes)"))` if transfer is allowed
+    function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes calldata data) external returns (bytes4);
+    /// @dev Handles the receipt of a multiple ERC1155 token types. This function
+    /// is called at the end of a `safeBatchTransferFrom` after the balances have
+    /// been updated.
+    /// NOTE: To accept the transfer(s), this must return
+    /// `bytes4(keccak256("onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)"))`
+    /// (i.e. 0xbc197c81, or its own function selector).
+    /// @param operator The address which initiated the batch transfer (i.e. msg.sender)
+    /// @param from The address which previously owned the token
+    /// @param ids An array containing ids of each token being transferred (order and length must match values array)
+    /// @param values An array containing amounts of each token being transferred (order and length must match ids array)
+    /// @param data Additional data with no specified format
+    /// @return `bytes4(keccak256("onERC1155BatchReceived(address,address,uint256[],uint256[],bytes)"))` if transfer is allowed
+    function onERC1155BatchReceived(address operator, address from, uint256[] calldata ids, uint256[] calldata values, bytes calldata data) external returns (bytes4);
+}
+pragma solidity ^ 0.8.0;
+/// @dev _Available since v3.1._
+abstract contract ERC1155Receiver is ERC165, IERC1155Receiver {
+    /// @dev See {IERC165-supportsInterface}.
+    function supportsInterface(bytes4 interfaceId) public view override(ERC165, IERC165) virtual returns (bool) {
+        return interfaceId == type(IERC1155Receiver).interfaceId || super.supportsInterface(interfaceId);
+    }
+}
+pragma solidity ^ 0.8.0;
+/// Simple implementation of `ERC1155Receiver` that will allow a contract to hold ERC1155 tokens.
+/// IMPORTANT: When inheriting this contract, you must include a way to use the received tokens, otherwise they will be
+/// stuck.
+/// @dev _Available since v3.1._
+contract ERC1155Holder is ERC1155Receiver {
+    function onERC1155Received(address, address, uint256, uint256, bytes memory) public override virtual returns (bytes4) {
+        return this.onERC1155Received.selector;
+    }
+    function onERC1155BatchReceived(address, address, uint256[] memory, uint256[] memory, bytes memory) public override virtual returns (bytes4) {
+        return this.onERC1155BatchReceived.selector;
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Required interface of an ERC1155 compliant contract, as defined in the
+/// https://eips.ethereum.org/EIPS/eip-1155[EIP].
+/// _Available since v3.1._
+interface IERC1155 is IERC165 {
+    /// @dev Emitted when `value` tokens of token type `id` are transferred from `from` to `to` by `operator`.
+    event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value);
+    /// @dev Equivalent to multiple {TransferSingle} events, where `operator`, `from` and `to` are the same for all
+    /// transfers.
+    event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values);
+    /// @dev Emitted when `account` grants or revokes permission to `operator` to transfer their tokens, according to
+    /// `approved`.
+    event ApprovalForAll(address indexed account, address indexed operator, bool approved);
+    /// @dev Emitted when the URI for token type `id` changes to `value`, if it is a non-programmatic URI.
+    /// If an {URI} event was emitted for `id`, the standard
+    /// https://eips.ethereum.org/EIPS/eip-1155#metadata-extensions[guarantees] that `value` will equal the value
+    /// returned by {IERC1155MetadataURI-uri}.
+    event URI(string value, uint256 indexed id);
+    /// @dev Returns the amount of tokens of token type `id` owned by `account`.
+    /// Requirements:
+    /// - `account` cannot be the zero address.
+    function balanceOf(address account, uint256 id) external view returns (uint256);
+    /// @dev xref:ROOT:erc1155.adoc#batch-operations[Batched] version of {balanceOf}.
+    /// Requirements:
+    /// - `accounts` and `ids` must have the same length.
+    function balanceOfBatch(address[] calldata accounts, uint256[] calldata ids) external view returns (uint256[] memory);
+    /// @dev Grants or revokes permission to `operator` to transfer the caller's tokens, according to `approved`,
+    /// Emits an {ApprovalForAll} event.
+    /// Requirements:
+    /// - `operator` cannot be the caller.
+    function setApprovalForAll(address operator, bool approved) external;
+    /// @dev Returns true if `operator` is approved to transfer ``account``'s tokens.
+    /// See {setApprovalForAll}.
+    function isApprovedForAll(address account, address operator) external view returns (bool);
+    /// @dev Transfers `amount` tokens of token type `id` from `from` to `to`.
+    /// Emits a {TransferSingle} event.
+    /// Requirements:
+    /// - `to` cannot be the zero address.
+    /// - If the caller is not `from`, it must be have been approved to spend ``from``'s tokens via {setApprovalForAll}.
+    /// - `from` must have a balance of tokens of type `id` of at least `amount`.
+    /// - If `to` refers to a smart contract, it must implement {IERC1155Receiver-onERC1155Received} and return the
+    /// acceptance magic value.
+    function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes calldata data) external;
+    /// @dev xref:ROOT:erc1155.adoc#batch-operations[Batched] version of {safeTransferFrom}.
+    /// Emits a {TransferBatch} event.
+    /// Requirements:
+    /// - `ids` and `amounts` must have the same length.
+    /// - If `to` refers to a smart contract, it must implement {IERC1155Receiver-onERC1155BatchReceived} and return the
+    /// acceptance magic value.
+    function safeBatchTransferFrom(address from, address to, uint256[] calldata ids, uint256[] calldata amounts, bytes calldata data) external;
+}
+pragma solidity ^ 0.8.0;
+/// @dev Required interface of an ERC721 compliant contract.
+interface IERC721 is IERC165 {
+    /// @dev Emitted when `tokenId` token is transferred from `from` to `to`.
+    event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+    /// @dev Emitted when `owner` enables `approved` to manage the `tokenId` token.
+    event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId);
+    /// @dev Emitted when `owner` enables or disables (`approved`) `operator` to manage all of its assets.
+    event ApprovalForAll(address indexed owner, address indexed operator, bool approved);
+    /// @dev Returns the number of tokens in ``owner``'s account.
+    function balanceOf(address owner) external view returns (uint256 balance);
+    /// @dev Returns the owner of the `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function ownerOf(uint256 tokenId) external view returns (address owner);
+    /// @dev Safely transfers `tokenId` token from `from` to `to`.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must exist and be owned by `from`.
+    /// - If the caller is not `from`, it must be approved to move this token by either {approve} or {setApprovalForAll}.
+    /// - If `to` refers to a smart contract, it must implement {IERC721Receiver-onERC721Received}, which is called upon a safe transfer.
+    /// Emits a {Transfer} event.
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external;
+    /// @dev Safely transfers `tokenId` token from `from` to `to`, checking first that contract recipients
+    /// are aware of the ERC721 protocol to prevent tokens from being forever locked.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must exist and be owned by `from`.
+    /// - If the caller is not `from`, it must be have been allowed to move this token by either {approve} or {setApprovalForAll}.
+    /// - If `to` refers to a smart contract, it must implement {IERC721Receiver-onERC721Received}, which is called upon a safe transfer.
+    /// Emits a {Transfer} event.
+    function safeTransferFrom(address from, address to, uint256 tokenId) external;
+    /// @dev Transfers `tokenId` token from `from` to `to`.
+    /// WARNING: Usage of this method is discouraged, use {safeTransferFrom} whenever possible.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must be owned by `from`.
+    /// - If the caller is not `from`, it must be approved to move this token by either {approve} or {setApprovalForAll}.
+    /// Emits a {Transfer} event.
+    function transferFrom(address from, address to, uint256 tokenId) external;
+    /// @dev Gives permission to `to` to transfer `tokenId` token to another account.
+    /// The approval is cleared when the token is transferred.
+    /// Only a single account can be approved at a time, so approving the zero address clears previous approvals.
+    /// Requirements:
+    /// - The caller must own the token or be an approved operator.
+    /// - `tokenId` must exist.
+    /// Emits an {Approval} event.
+    function approve(address to, uint256 tokenId) external;
+    /// @dev Approve or remove `operator` as an operator for the caller.
+    /// Operators can call {transferFrom} or {safeTransferFrom} for any token owned by the caller.
+    /// Requirements:
+    /// - The `operator` cannot be the caller.
+    /// Emits an {ApprovalForAll} event.
+    function setApprovalForAll(address operator, bool _approved) external;
+    /// @dev Returns the account approved for `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function getApproved(uint256 tokenId) external view returns (address operator);
+    /// @dev Returns if the `operator` is allowed to manage all of the assets of `owner`.
+    /// See {setApprovalForAll}
+    function isApprovedForAll(address owner, address operator) external view returns (bool);
+}
+pragma solidity ^ 0.8.0;
+/// @dev Interface of the ERC20 standard as defined in the EIP.
+interface IERC20 {
+    /// @dev Emitted when `value` tokens are moved from one account (`from`) to
+    /// another (`to`).
+    /// Note that `value` may be zero.
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    /// @dev Emitted when the allowance of a `spender` for an `owner` is set by
+    /// a call to {approve}. `value` is the new allowance.
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+    /// @dev Returns the amount of tokens in existence.
+    function totalSupply() external view returns (uint256);
+    /// @dev Returns the amount of tokens owned by `account`.
+    function balanceOf(address account) external view returns (uint256);
+    /// @dev Moves `amount` tokens from the caller's account to `to`.
+    /// Returns a boolean value indicating whether the operation succeeded.
+    /// Emits a {Transfer} event.
+    function transfer(address to, uint256 amount) external returns (bool);
+    /// @dev Returns the remaining number of tokens that `spender` will be
+    /// allowed to spend on behalf of `owner` through {transferFrom}. This is
+    /// zero by default.
+    /// This value changes when {approve} or {transferFrom} are called.
+    function allowance(address owner, address spender) external view returns (uint256);
+    /// @dev Sets `amount` as the allowance of `spender` over the caller's tokens.
+    /// Returns a boolean value indicating whether the operation succeeded.
+    /// IMPORTANT: Beware that changing an allowance with this method brings the risk
+    /// that someone may use both the old and the new allowance by unfortunate
+    /// transaction ordering. One possible solution to mitigate this race
+    /// condition is to first reduce the spender's allowance to 0 and set the
+    /// desired value afterwards:
+    /// https://github.com/ethereum/EIPs/issues/20#issuecomment-263524729
+    /// Emits an {Approval} event.
+    function approve(address spender, uint256 amount) external returns (bool);
+    /// @dev Moves `amount` tokens from `from` to `to` using the
+    /// allowance mechanism. `amount` is then deducted from the caller's
+    /// allowance.
+    /// Returns a boolean value indicating whether the operation succeeded.
+    /// Emits a {Transfer} event.
+    function transferFrom(address from, address to, uint256 amount) external returns (bool);
+}
+pragma solidity ^ 0.8.0;
+/// @dev Provides information about the current execution context, including the
+/// sender of the transaction and its data. While these are generally available
+/// via msg.sender and msg.data, they should not be accessed in such a direct
+/// manner, since when dealing with meta-transactions the account sending and
+/// paying for execution may not be the actual sender (as far as an application
+/// is concerned).
+/// This contract is only required for intermediate, library-like contracts.
+abstract contract Context {
+    function _msgSender() internal view virtual returns (address) {
+        return msg.sender;
+    }
+    function _msgData() internal view virtual returns (bytes calldata) {
+        return msg.data;
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Contract module which provides a basic access control mechanism, where
+/// there is an account (an owner) that can be granted exclusive access to
+/// specific functions.
+/// By default, the owner account will be the one that deploys the contract. This
+/// can later be changed with {transferOwnership}.
+/// This module is used through inheritance. It will make available the modifier
+/// `onlyOwner`, which can be applied to your functions to restrict their use to
+/// the owner.
+abstract contract Ownable is Context {
+    address private _owner;
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+    /// @dev Initializes the contract setting the deployer as the initial owner.
+    constructor() internal {
+        _transferOwnership(_msgSender());
+    }
+    /// @dev Returns the address of the current owner.
+    function owner() public view virtual returns (address) {
+        return _owner;
+    }
+    /// @dev Throws if called by any account other than the owner.
+    modifier onlyOwner() {
+        require(owner() == _msgSender(), "Ownable: caller is not the owner");
+        _;
+    }
+    /// @dev Leaves the contract without owner. It will not be possible to call
+    /// `onlyOwner` functions anymore. Can only be called by the current owner.
+    /// NOTE: Renouncing ownership will leave the contract without an owner,
+    /// thereby removing any functionality that is only available to the owner.
+    function renounceOwnership() public onlyOwner virtual {
+        _transferOwnership(address(0));
+    }
+    /// @dev Transfers ownership of the contract to a new account (`newOwner`).
+    /// Can only be called by the current owner.
+    function transferOwnership(address newOwner) public onlyOwner virtual {
+        require(newOwner != address(0), "Ownable: new owner is the zero address");
+        _transferOwnership(newOwner);
+    }
+    /// @dev Transfers ownership of the contract to a new account (`newOwner`).
+    /// Internal function without access restriction.
+    function _transferOwnership(address newOwner) internal virtual {
+        address oldOwner = _owner;
+        _owner = newOwner;
+        emit OwnershipTransferred(oldOwner, newOwner);
+    }
+}
+pragma solidity ^ 0.8.0;
+contract TrustNFTRelay is Ownable, ERC721Holder, ERC1155Holder {
+    struct NFT{
+        address nftAddress;
+        uint256 nftTokenId;
+        uint8 nftTokenType;
+    }
+    function transferPayment(address from, address payable to, address currency, uint256 quantity1, uint256 quantity2) public {
+        if(currency != address(0)) {
+            require(IERC20(currency).transferFrom(from, to, quantity1), "Transfer of tokens to receiver failed");
+            require(IERC20(currency).transferFrom(from, owner(), quantity2), "Transfer of tokens to TrustNFT failed");
+        } else {
+            require(to.send(quantity1), "Transfer of BNB to receiver failed");
+            require(payable(owner()).send(quantity2), "Transfer of BNB to TrustNFT failed");
+        }
+    }
+    function transferNFT(address from, address to, NFT memory nft) public {
+        if(nft.nftTokenType == 0) {
+            IERC721(nft.nftAddress).safeTransferFrom(from, to, nft.nftTokenId);
+        } else {
+            IERC1155(nft.nftAddress).safeTransferFrom(from, to, nft.nftTokenId, 1, "0x00");
+        }
+    }
+}
+pragma solidity ^ 0.8.0;
+contract LendingCore is TrustNFTRelay {
+    using SafeMath for uint256;
+    using SafeMath for uint8;
+    event NewLoan(address indexed owner, address indexed currency, uint256 indexed loanId, uint256 time, uint256 loanAmount, uint256 amountDue, NFT nft);
+    event LoanApproved(address indexed lender, uint256 indexed loanId, uint256 loanPaymentEnd);
+    event LoanCancelled(uint256 indexed loanId);
+    event LoanTerminated(address indexed requester, uint256 indexed loanId, Status status);
+    event LoanPayment(uint256 indexed loanId, uint256 amount, uint256 amountPaidAsInstallmentToLender, uint256 interestPerInstallement, uint256 platformFeePerInstallment, Status status);
+    address public promissoryNoteAddress;
+    address public lendingMethodsAddress;
+    uint256 public id = 1;
+    uint256 public ltv = 60;
+    uint256 public interestRate = 20;
+    uint256 public interestRateToPlatform = 40;
+    uint32 public lenderFee = 100;
+    enum Status {
+        LISTED,
+        APPROVED,
+        LIQUIDATED,
+        CANCELLED,
+        WITHDRAWN
+    }
+    struct Installment{
+        uint256 time;
+        uint256 amount;
+        uint16 nrOfInstallments;
+        uint256 nrOfPayments;
+        uint8 defaultingLimit;
+    }
+    struct Loan{
+        address payable borrower;
+        address payable lender;
+        address currency;
+        Status status;
+        NFT nft;
+        Installment installment;
+        uint256 loanAmount;
+        uint256 nftValue;
+        uint256[2] startEnd;
+        uint256 amountDue;
+        uint256 paidAmount;
+    }
+    mapping (uint256 => Loan) public loans;
+    mapping (uint256 => address) public promissoryPermissions;
+    modifier isPromissoryNote() {
+        require(msg.sender == promissoryNoteAddress, "Lending Methods: Access denied");
+        _;
+    }
+    function canBeTerminated(uint256 loanId) public view returns (bool) {
+        require(loans[loanId].status == Status.APPROVED || loans[loanId].status == Status.LIQUIDATED, "Loan is not yet approved");
+        return loans[loanId].startEnd[0].add(loans[loanId].installment.nrOfPayments.mul(loans[loanId].installment.time)).add(loans[loanId].installment.defaultingLimit.mul(loans[loanId].installment.time)) <= min(block.timestamp, loans[loanId].startEnd[1]);
+    }
+    function checkLtv(uint256 loanValue, uint256 nftValue) public view {
+        require(loanValue <= nftValue.div(100).mul(ltv), "LTV too high");
+    }
+    function min(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a < b?a:b;
+    }
+    function getLoanStartEnd(uint256 loanId) external view returns (uint256[2] memory) {
+        return loans[loanId].startEnd;
+    }
+    function getPromissoryPermission(uint256 loanId) external view returns (address) {
+        require(loans[loanId].status == Status.APPROVED, "Loan is no longer approved");
+        return promissoryPermissions[loanId];
+    }
+}
+pragma solidity >= 0.4.22 <0.9.0;
+library console {
+    address constant CONSOLE_ADDRESS = address(0x000000000000000000636F6e736F6c652e6c6f67);
+    function _sendLogPayload(bytes memory payload) private view {
+        uint256 payloadLength = payload.length;
+        address consoleAddress = CONSOLE_ADDRESS;
+        assembly {
+            let payloadStart := add(payload, 32)
+            let r := staticcall(gas(), consoleAddress, payloadStart, payloadLength, 0, 0)
+        }
+    }
+    function log() internal view {
+        _sendLogPayload(abi.encodeWithSignature("log()"));
+    }
+    function logInt(int256 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(int256)", p0));
+    }
+    function logUint(uint256 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256)", p0));
+    }
+    function logString(string memory p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string)", p0));
+    }
+    function logBool(bool p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool)", p0));
+    }
+    function logAddress(address p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address)", p0));
+    }
+    function logBytes(bytes memory p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes)", p0));
+    }
+    function logBytes1(bytes1 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes1)", p0));
+    }
+    function logBytes2(bytes2 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes2)", p0));
+    }
+    function logBytes3(bytes3 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes3)", p0));
+    }
+    function logBytes4(bytes4 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes4)", p0));
+    }
+    function logBytes5(bytes5 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes5)", p0));
+    }
+    function logBytes6(bytes6 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes6)", p0));
+    }
+    function logBytes7(bytes7 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes7)", p0));
+    }
+    function logBytes8(bytes8 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes8)", p0));
+    }
+    function logBytes9(bytes9 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes9)", p0));
+    }
+    function logBytes10(bytes10 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes10)", p0));
+    }
+    function logBytes11(bytes11 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes11)", p0));
+    }
+    function logBytes12(bytes12 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes12)", p0));
+    }
+    function logBytes13(bytes13 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes13)", p0));
+    }
+    function logBytes14(bytes14 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes14)", p0));
+    }
+    function logBytes15(bytes15 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes15)", p0));
+    }
+    function logBytes16(bytes16 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes16)", p0));
+    }
+    function logBytes17(bytes17 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes17)", p0));
+    }
+    function logBytes18(bytes18 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes18)", p0));
+    }
+    function logBytes19(bytes19 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes19)", p0));
+    }
+    function logBytes20(bytes20 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes20)", p0));
+    }
+    function logBytes21(bytes21 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes21)", p0));
+    }
+    function logBytes22(bytes22 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes22)", p0));
+    }
+    function logBytes23(bytes23 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes23)", p0));
+    }
+    function logBytes24(bytes24 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes24)", p0));
+    }
+    function logBytes25(bytes25 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes25)", p0));
+    }
+    function logBytes26(bytes26 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes26)", p0));
+    }
+    function logBytes27(bytes27 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes27)", p0));
+    }
+    function logBytes28(bytes28 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes28)", p0));
+    }
+    function logBytes29(bytes29 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes29)", p0));
+    }
+    function logBytes30(bytes30 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes30)", p0));
+    }
+    function logBytes31(bytes31 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes31)", p0));
+    }
+    function logBytes32(bytes32 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bytes32)", p0));
+    }
+    function log(uint256 p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256)", p0));
+    }
+    function log(string memory p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string)", p0));
+    }
+    function log(bool p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool)", p0));
+    }
+    function log(address p0) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address)", p0));
+    }
+    function log(uint256 p0, uint256 p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256)", p0, p1));
+    }
+    function log(uint256 p0, string memory p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string)", p0, p1));
+    }
+    function log(uint256 p0, bool p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool)", p0, p1));
+    }
+    function log(uint256 p0, address p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address)", p0, p1));
+    }
+    function log(string memory p0, uint256 p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256)", p0, p1));
+    }
+    function log(string memory p0, string memory p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string)", p0, p1));
+    }
+    function log(string memory p0, bool p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool)", p0, p1));
+    }
+    function log(string memory p0, address p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address)", p0, p1));
+    }
+    function log(bool p0, uint256 p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256)", p0, p1));
+    }
+    function log(bool p0, string memory p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string)", p0, p1));
+    }
+    function log(bool p0, bool p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool)", p0, p1));
+    }
+    function log(bool p0, address p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address)", p0, p1));
+    }
+    function log(address p0, uint256 p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256)", p0, p1));
+    }
+    function log(address p0, string memory p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string)", p0, p1));
+    }
+    function log(address p0, bool p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool)", p0, p1));
+    }
+    function log(address p0, address p1) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address)", p0, p1));
+    }
+    function log(uint256 p0, uint256 p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,uint256)", p0, p1, p2));
+    }
+    function log(uint256 p0, uint256 p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,string)", p0, p1, p2));
+    }
+    function log(uint256 p0, uint256 p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,bool)", p0, p1, p2));
+    }
+    function log(uint256 p0, uint256 p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,address)", p0, p1, p2));
+    }
+    function log(uint256 p0, string memory p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,uint256)", p0, p1, p2));
+    }
+    function log(uint256 p0, string memory p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,string)", p0, p1, p2));
+    }
+    function log(uint256 p0, string memory p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,bool)", p0, p1, p2));
+    }
+    function log(uint256 p0, string memory p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,address)", p0, p1, p2));
+    }
+    function log(uint256 p0, bool p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,uint256)", p0, p1, p2));
+    }
+    function log(uint256 p0, bool p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,string)", p0, p1, p2));
+    }
+    function log(uint256 p0, bool p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,bool)", p0, p1, p2));
+    }
+    function log(uint256 p0, bool p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,address)", p0, p1, p2));
+    }
+    function log(uint256 p0, address p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,uint256)", p0, p1, p2));
+    }
+    function log(uint256 p0, address p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,string)", p0, p1, p2));
+    }
+    function log(uint256 p0, address p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,bool)", p0, p1, p2));
+    }
+    function log(uint256 p0, address p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,address)", p0, p1, p2));
+    }
+    function log(string memory p0, uint256 p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,uint256)", p0, p1, p2));
+    }
+    function log(string memory p0, uint256 p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,string)", p0, p1, p2));
+    }
+    function log(string memory p0, uint256 p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,bool)", p0, p1, p2));
+    }
+    function log(string memory p0, uint256 p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,address)", p0, p1, p2));
+    }
+    function log(string memory p0, string memory p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,uint256)", p0, p1, p2));
+    }
+    function log(string memory p0, string memory p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,string)", p0, p1, p2));
+    }
+    function log(string memory p0, string memory p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,bool)", p0, p1, p2));
+    }
+    function log(string memory p0, string memory p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,address)", p0, p1, p2));
+    }
+    function log(string memory p0, bool p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,uint256)", p0, p1, p2));
+    }
+    function log(string memory p0, bool p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,string)", p0, p1, p2));
+    }
+    function log(string memory p0, bool p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,bool)", p0, p1, p2));
+    }
+    function log(string memory p0, bool p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,address)", p0, p1, p2));
+    }
+    function log(string memory p0, address p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,uint256)", p0, p1, p2));
+    }
+    function log(string memory p0, address p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,string)", p0, p1, p2));
+    }
+    function log(string memory p0, address p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,bool)", p0, p1, p2));
+    }
+    function log(string memory p0, address p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,address)", p0, p1, p2));
+    }
+    function log(bool p0, uint256 p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,uint256)", p0, p1, p2));
+    }
+    function log(bool p0, uint256 p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,string)", p0, p1, p2));
+    }
+    function log(bool p0, uint256 p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,bool)", p0, p1, p2));
+    }
+    function log(bool p0, uint256 p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,address)", p0, p1, p2));
+    }
+    function log(bool p0, string memory p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,uint256)", p0, p1, p2));
+    }
+    function log(bool p0, string memory p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,string)", p0, p1, p2));
+    }
+    function log(bool p0, string memory p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,bool)", p0, p1, p2));
+    }
+    function log(bool p0, string memory p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,address)", p0, p1, p2));
+    }
+    function log(bool p0, bool p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,uint256)", p0, p1, p2));
+    }
+    function log(bool p0, bool p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,string)", p0, p1, p2));
+    }
+    function log(bool p0, bool p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,bool)", p0, p1, p2));
+    }
+    function log(bool p0, bool p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,address)", p0, p1, p2));
+    }
+    function log(bool p0, address p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,uint256)", p0, p1, p2));
+    }
+    function log(bool p0, address p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,string)", p0, p1, p2));
+    }
+    function log(bool p0, address p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,bool)", p0, p1, p2));
+    }
+    function log(bool p0, address p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,address)", p0, p1, p2));
+    }
+    function log(address p0, uint256 p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,uint256)", p0, p1, p2));
+    }
+    function log(address p0, uint256 p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,string)", p0, p1, p2));
+    }
+    function log(address p0, uint256 p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,bool)", p0, p1, p2));
+    }
+    function log(address p0, uint256 p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,address)", p0, p1, p2));
+    }
+    function log(address p0, string memory p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,uint256)", p0, p1, p2));
+    }
+    function log(address p0, string memory p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,string)", p0, p1, p2));
+    }
+    function log(address p0, string memory p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,bool)", p0, p1, p2));
+    }
+    function log(address p0, string memory p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,address)", p0, p1, p2));
+    }
+    function log(address p0, bool p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,uint256)", p0, p1, p2));
+    }
+    function log(address p0, bool p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,string)", p0, p1, p2));
+    }
+    function log(address p0, bool p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,bool)", p0, p1, p2));
+    }
+    function log(address p0, bool p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,address)", p0, p1, p2));
+    }
+    function log(address p0, address p1, uint256 p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,uint256)", p0, p1, p2));
+    }
+    function log(address p0, address p1, string memory p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,string)", p0, p1, p2));
+    }
+    function log(address p0, address p1, bool p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,bool)", p0, p1, p2));
+    }
+    function log(address p0, address p1, address p2) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,address)", p0, p1, p2));
+    }
+    function log(uint256 p0, uint256 p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,string,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,string,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,string,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,bool,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,bool,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,address,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,address,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, uint256 p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,uint256,address,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,string,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,string,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,string,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,bool,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,bool,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,address,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,address,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, string memory p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,string,address,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,string,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,string,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,string,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,bool,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,bool,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,address,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,address,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, bool p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,bool,address,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,string,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,string,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,string,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,bool,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,bool,address)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,address,string)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,address,bool)", p0, p1, p2, p3));
+    }
+    function log(uint256 p0, address p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(uint256,address,address,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,string,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,string,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,string,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,bool,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,bool,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,address,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,address,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, uint256 p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,uint256,address,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,string,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,string,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,string,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,bool,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,bool,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,address,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,address,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, string memory p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,string,address,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,string,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,string,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,string,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,bool,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,bool,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,address,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,address,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, bool p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,bool,address,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,string,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,string,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,string,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,bool,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,bool,address)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,address,string)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,address,bool)", p0, p1, p2, p3));
+    }
+    function log(string memory p0, address p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(string,address,address,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,string,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,string,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,string,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,bool,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,bool,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,address,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,address,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, uint256 p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,uint256,address,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,string,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,string,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,string,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,bool,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,bool,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,address,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,address,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, string memory p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,string,address,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,string,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,string,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,string,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,bool,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,bool,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,address,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,address,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, bool p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,bool,address,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,string,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,string,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,string,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,bool,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,bool,address)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,address,string)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,address,bool)", p0, p1, p2, p3));
+    }
+    function log(bool p0, address p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(bool,address,address,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,string,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,string,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,string,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,bool,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,bool,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,address,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,address,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, uint256 p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,uint256,address,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,string,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,string,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,string,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,bool,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,bool,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,address,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,address,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, string memory p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,string,address,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,string,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,string,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,string,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,bool,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,bool,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,address,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,address,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, bool p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,bool,address,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, uint256 p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,uint256,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, uint256 p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,uint256,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, uint256 p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,uint256,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, uint256 p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,uint256,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, string memory p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,string,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, string memory p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,string,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, string memory p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,string,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, string memory p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,string,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, bool p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,bool,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, bool p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,bool,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, bool p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,bool,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, bool p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,bool,address)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, address p2, uint256 p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,address,uint256)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, address p2, string memory p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,address,string)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, address p2, bool p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,address,bool)", p0, p1, p2, p3));
+    }
+    function log(address p0, address p1, address p2, address p3) internal view {
+        _sendLogPayload(abi.encodeWithSignature("log(address,address,address,address)", p0, p1, p2, p3));
+    }
+}
+pragma solidity ^ 0.8.0;
+contract LendingProxy is Ownable, LendingCore {
+    using SafeMath for uint256;
+    using SafeMath for uint16;
+    constructor(address _promissoryNoteAddress, address _lendingMethodsAddress) public {
+        require(_lendingMethodsAddress != address(0), "Lending Methods address not valid");
+        require(_promissoryNoteAddress != address(0), "Promissory Note address not valid");
+        promissoryNoteAddress = _promissoryNoteAddress;
+        lendingMethodsAddress = _lendingMethodsAddress;
+    }
+    function borrow(uint256 loanAmount, uint256 time, address currency, uint256 nftValue, NFT calldata nft) external {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("borrow(uint256,uint256,address,uint256,(address,uint256,uint8))", loanAmount, time, currency, nftValue, nft));
+        require(success, "Failed to borrow via delegatecall");
+    }
+    function lend(uint256 loanId) external payable {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("lend(uint256)", loanId));
+        require(success, "Failed to lend via delegatecall");
+    }
+    function cancel(uint256 loanId) external {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("cancel(uint256)", loanId));
+        require(success, "Failed to cancel via delegatecall");
+    }
+    function pay(uint256 loanId, uint256 amount) external payable {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("pay(uint256,uint256)", loanId, amount));
+        require(success, "Failed to pay via delegatecall");
+    }
+    function terminate(uint256 loanId) external {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("terminate(uint256)", loanId));
+        require(success, "Failed to terminate via delegatecall");
+    }
+    function editConfigParams(uint256 _ltv, uint256 _interestRate, uint256 _interestRateToPlatform, uint32 _lenderFee, address _promissoryNoteAddress, address _lendingMethodsAddress) external onlyOwner {
+        require(_lendingMethodsAddress != address(0), "Lending Methods address not valid");
+        require(_promissoryNoteAddress != address(0), "Promissory Note address not valid");
+        ltv = _ltv;
+        interestRate = _interestRate;
+        interestRateToPlatform = _interestRateToPlatform;
+        lenderFee = _lenderFee;
+        promissoryNoteAddress = _promissoryNoteAddress;
+        lendingMethodsAddress = _lendingMethodsAddress;
+    }
+    function exchangePromissoryNote(address from, address payable to, uint256[] calldata loanIds) external {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("exchangePromissoryNote(address,address,uint256[])", from, to, loanIds));
+        require(success, "Lending Template: Failed to execute exchangePromissoryNote via delegatecall");
+    }
+    function setPromissoryPermissions(uint256[] calldata loanIds, address beneficiary) external {
+        (bool success, ) = lendingMethodsAddress.delegatecall(abi.encodeWithSignature("setPromissoryPermissions(uint256[],address)", loanIds, beneficiary));
+        require(success, "Lending Template: Failed to execute setPromissoryPermissions via delegatecall");
+    }
+    function getRemainingAmount(uint256 loanId) external view returns (uint256) {
+        return loans[loanId].amountDue.sub(loans[loanId].paidAmount);
+    }
+    function getApprovalCost(uint256 loanId) external view returns (uint256, uint256, uint256, address) {
+        return (loans[loanId].loanAmount.add(loans[loanId].loanAmount.div(lenderFee)), loans[loanId].loanAmount, lenderFee, msg.sender);
+    }
+    function getInstallmentCost(uint256 loanId, uint256 nrOfInstallments) external view returns (uint256 totalAmount, uint256 interestPerInstallement, uint256 platformFeePerInstallment, uint256 amountPaidAsInstallmentToLender) {
+        require(nrOfInstallments <= loans[loanId].installment.nrOfInstallments, "Number of installments too high");
+        totalAmount = uint256(loans[loanId].installment.amount.mul(nrOfInstallments));
+        interestPerInstallement = uint256(totalAmount.mul(interestRate).div(100).div(loans[loanId].installment.nrOfInstallments));
+        platformFeePerInstallment = interestPerInstallement.mul(interestRateToPlatform).div(100);
+        amountPaidAsInstallmentToLender = interestPerInstallement.mul(uint256(100).sub(interestRateToPlatform)).div(100);
+    }
+}
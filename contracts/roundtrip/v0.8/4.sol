@@ -0,0 +1,1242 @@
+// SPDX-License-Identifier: GPL-3.0
+pragma solidity ^ 0.8.13;
+address constant CANONICAL_OPERATOR_FILTER_REGISTRY_ADDRESS = 0x000000000000AAeB6D7670E522A718067333cd4E;
+address constant CANONICAL_CORI_SUBSCRIPTION = 0x3cc6CddA760b79bAfa08dF41ECFA224f810dCeB6;
+pragma solidity ^ 0.8.13;
+interface IOperatorFilterRegistry {
+    /// @notice Returns true if operator is not filtered for a given token, either by address or codeHash. Also returns
+    ///         true if supplied registrant address is not registered.
+    function isOperatorAllowed(address registrant, address operator) external view returns (bool);
+    /// @notice Registers an address with the registry. May be called by address itself or by EIP-173 owner.
+    function register(address registrant) external;
+    /// @notice Registers an address with the registry and "subscribes" to another address's filtered operators and codeHashes.
+    function registerAndSubscribe(address registrant, address subscription) external;
+    /// @notice Registers an address with the registry and copies the filtered operators and codeHashes from another
+    ///         address without subscribing.
+    function registerAndCopyEntries(address registrant, address registrantToCopy) external;
+    /// @notice Unregisters an address with the registry and removes its subscription. May be called by address itself or by EIP-173 owner.
+    ///         Note that this does not remove any filtered addresses or codeHashes.
+    ///         Also note that any subscriptions to this registrant will still be active and follow the existing filtered addresses and codehashes.
+    function unregister(address addr) external;
+    /// @notice Update an operator address for a registered address - when filtered is true, the operator is filtered.
+    function updateOperator(address registrant, address operator, bool filtered) external;
+    /// @notice Update multiple operators for a registered address - when filtered is true, the operators will be filtered. Reverts on duplicates.
+    function updateOperators(address registrant, address[] calldata operators, bool filtered) external;
+    /// @notice Update a codeHash for a registered address - when filtered is true, the codeHash is filtered.
+    function updateCodeHash(address registrant, bytes32 codehash, bool filtered) external;
+    /// @notice Update multiple codeHashes for a registered address - when filtered is true, the codeHashes will be filtered. Reverts on duplicates.
+    function updateCodeHashes(address registrant, bytes32[] calldata codeHashes, bool filtered) external;
+    /// @notice Subscribe an address to another registrant's filtered operators and codeHashes. Will remove previous
+    ///         subscription if present.
+    ///         Note that accounts with subscriptions may go on to subscribe to other accounts - in this case,
+    ///         subscriptions will not be forwarded. Instead the former subscription's existing entries will still be
+    ///         used.
+    function subscribe(address registrant, address registrantToSubscribe) external;
+    /// @notice Unsubscribe an address from its current subscribed registrant, and optionally copy its filtered operators and codeHashes.
+    function unsubscribe(address registrant, bool copyExistingEntries) external;
+    /// @notice Get the subscription address of a given registrant, if any.
+    function subscriptionOf(address addr) external returns (address registrant);
+    /// @notice Get the set of addresses subscribed to a given registrant.
+    ///         Note that order is not guaranteed as updates are made.
+    function subscribers(address registrant) external returns (address[] memory);
+    /// @notice Get the subscriber at a given index in the set of addresses subscribed to a given registrant.
+    ///         Note that order is not guaranteed as updates are made.
+    function subscriberAt(address registrant, uint256 index) external returns (address);
+    /// @notice Copy filtered operators and codeHashes from a different registrantToCopy to addr.
+    function copyEntriesOf(address registrant, address registrantToCopy) external;
+    /// @notice Returns true if operator is filtered by a given address or its subscription.
+    function isOperatorFiltered(address registrant, address operator) external returns (bool);
+    /// @notice Returns true if the hash of an address's code is filtered by a given address or its subscription.
+    function isCodeHashOfFiltered(address registrant, address operatorWithCode) external returns (bool);
+    /// @notice Returns true if a codeHash is filtered by a given address or its subscription.
+    function isCodeHashFiltered(address registrant, bytes32 codeHash) external returns (bool);
+    /// @notice Returns a list of filtered operators for a given address or its subscription.
+    function filteredOperators(address addr) external returns (address[] memory);
+    /// @notice Returns the set of filtered codeHashes for a given address or its subscription.
+    ///         Note that order is not guaranteed as updates are made.
+    function filteredCodeHashes(address addr) external returns (bytes32[] memory);
+    /// @notice Returns the filtered operator at the given index of the set of filtered operators for a given address or
+    ///         its subscription.
+    ///         Note that order is not guaranteed as updates are made.
+    function filteredOperatorAt(address registrant, uint256 index) external returns (address);
+    /// @notice Returns the filtered codeHash at the given index of the list of filtered codeHashes for a given address or
+    ///         its subscription.
+    ///         Note that order is not guaranteed as updates are made.
+    function filteredCodeHashAt(address registrant, uint256 index) external returns (bytes32);
+    /// @notice Returns true if an address has registered
+    function isRegistered(address addr) external returns (bool);
+    /// @dev Convenience method to compute the code hash of an arbitrary contract
+    function codeHashOf(address addr) external returns (bytes32);
+}
+pragma solidity ^ 0.8.13;
+/// @title  OperatorFilterer
+/// @notice Abstract contract whose constructor automatically registers and optionally subscribes to or copies another
+///         registrant's entries in the OperatorFilterRegistry.
+/// @dev    This smart contract is meant to be inherited by token contracts so they can use the following:
+///         - `onlyAllowedOperator` modifier for `transferFrom` and `safeTransferFrom` methods.
+///         - `onlyAllowedOperatorApproval` modifier for `approve` and `setApprovalForAll` methods.
+///         Please note that if your token contract does not provide an owner with EIP-173, it must provide
+///         administration methods on the contract itself to interact with the registry otherwise the subscription
+///         will be locked to the options set during construction.
+abstract contract OperatorFilterer {
+    error OperatorNotAllowed(address operator);
+    IOperatorFilterRegistry public constant OPERATOR_FILTER_REGISTRY = IOperatorFilterRegistry(CANONICAL_OPERATOR_FILTER_REGISTRY_ADDRESS);
+    ///@dev The constructor that is called when the contract is being deployed.
+    constructor(address subscriptionOrRegistrantToCopy, bool subscribe) internal {
+        if(address(OPERATOR_FILTER_REGISTRY).code.length > 0) {
+            if(subscribe) {
+                OPERATOR_FILTER_REGISTRY.registerAndSubscribe(address(this), subscriptionOrRegistrantToCopy);
+            } else {
+                if(subscriptionOrRegistrantToCopy != address(0)) {
+                    OPERATOR_FILTER_REGISTRY.registerAndCopyEntries(address(this), subscriptionOrRegistrantToCopy);
+                } else {
+                    OPERATOR_FILTER_REGISTRY.register(address(this));
+                }
+            }
+        }
+    }
+    /// @dev A helper function to check if an operator is allowed.
+    modifier onlyAllowedOperator(address from) {
+        if(from != msg.sender) {
+            _checkFilterOperator(msg.sender);
+        }
+        _;
+    }
+    /// @dev A helper function to check if an operator approval is allowed.
+    modifier onlyAllowedOperatorApproval(address operator) {
+        _checkFilterOperator(operator);
+        _;
+    }
+    /// @dev A helper function to check if an operator is allowed.
+    function _checkFilterOperator(address operator) internal view virtual {
+        if(address(OPERATOR_FILTER_REGISTRY).code.length > 0) {
+            if(!OPERATOR_FILTER_REGISTRY.isOperatorAllowed(address(this), operator)) {
+                revert OperatorNotAllowed(operator);
+            }
+        }
+    }
+}
+pragma solidity ^ 0.8.13;
+/// @title  DefaultOperatorFilterer
+/// @notice Inherits from OperatorFilterer and automatically subscribes to the default OpenSea subscription.
+/// @dev    Please note that if your token contract does not provide an owner with EIP-173, it must provide
+///         administration methods on the contract itself to interact with the registry otherwise the subscription
+///         will be locked to the options set during construction.
+abstract contract DefaultOperatorFilterer is OperatorFilterer {
+    ///@dev The constructor that is called when the contract is being deployed.
+    constructor() OperatorFilterer(CANONICAL_CORI_SUBSCRIPTION, true) internal {
+
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Standard math utilities missing in the Solidity language.
+library Math {
+    enum Rounding {
+        Down,
+        Up,
+        Zero
+    }
+    /// @dev Returns the largest of two numbers.
+    function max(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a > b?a:b;
+    }
+    /// @dev Returns the smallest of two numbers.
+    function min(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a < b?a:b;
+    }
+    /// @dev Returns the average of two numbers. The result is rounded towards
+    /// zero.
+    function average(uint256 a, uint256 b) internal pure returns (uint256) {
+        return (a & b) + (a ^ b) / 2;
+    }
+    /// @dev Returns the ceiling of the division of two numbers.
+    /// This differs from standard division with `/` in that it rounds up instead
+    /// of rounding down.
+    function ceilDiv(uint256 a, uint256 b) internal pure returns (uint256) {
+        return a == 0?0:(a - 1) / b + 1;
+    }
+    /// @notice Calculates floor(x * y / denominator) with full precision. Throws if result overflows a uint256 or denominator == 0
+    /// @dev Original credit to Remco Bloemen under MIT license (https://xn--2-umb.com/21/muldiv)
+    /// with further edits by Uniswap Labs also under MIT license.
+    function mulDiv(uint256 x, uint256 y, uint256 denominator) internal pure returns (uint256 result) {
+        unchecked {
+            uint256 prod0;
+            uint256 prod1;
+            assembly {
+                let mm := mulmod(x, y, not(0))
+                prod0 := mul(x, y)
+                prod1 := sub(sub(mm, prod0), lt(mm, prod0))
+            }
+            if(prod1 == 0) {
+                return prod0 / denominator;
+            }
+            require(denominator > prod1);
+            uint256 remainder;
+            assembly {
+                remainder := mulmod(x, y, denominator)
+                prod1 := sub(prod1, gt(remainder, prod0))
+                prod0 := sub(prod0, remainder)
+            }
+            uint256 twos = denominator & (~denominator + 1);
+            assembly {
+                denominator := div(denominator, twos)
+                prod0 := div(prod0, twos)
+                twos := add(div(sub(0, twos), twos), 1)
+            }
+            prod0 |= prod1 * twos;
+            uint256 inverse = (3 * denominator) ^ 2;
+            inverse *= 2 - denominator * inverse;
+            inverse *= 2 - denominator * inverse;
+            inverse *= 2 - denominator * inverse;
+            inverse *= 2 - denominator * inverse;
+            inverse *= 2 - denominator * inverse;
+            inverse *= 2 - denominator * inverse;
+            result = prod0 * inverse;
+            return result;
+        }
+    }
+    /// @notice Calculates x * y / denominator with full precision, following the selected rounding direction.
+    function mulDiv(uint256 x, uint256 y, uint256 denominator, Rounding rounding) internal pure returns (uint256) {
+        uint256 result = mulDiv(x, y, denominator);
+        if(rounding == Rounding.Up && mulmod(x, y, denominator) > 0) {
+            result += 1;
+        }
+        return result;
+    }
+    /// @dev Returns the square root of a number. If the number is not a perfect square, the value is rounded down.
+    /// Inspired by Henry S. Warren, Jr.'s "Hacker's Delight" (Chapter 11).
+    function sqrt(uint256 a) internal pure returns (uint256) {
+        if(a == 0) {
+            return 0;
+        }
+        uint256 result = 1 << (log2(a) >> 1);
+        unchecked {
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            result = (result + a / result) >> 1;
+            return min(result, a / result);
+        }
+    }
+    /// @notice Calculates sqrt(a), following the selected rounding direction.
+    function sqrt(uint256 a, Rounding rounding) internal pure returns (uint256) {
+        unchecked {
+            uint256 result = sqrt(a);
+            return result + (rounding == Rounding.Up && result * result < a?1:0);
+        }
+    }
+    /// @dev Return the log in base 2, rounded down, of a positive value.
+    /// Returns 0 if given 0.
+    function log2(uint256 value) internal pure returns (uint256) {
+        uint256 result = 0;
+        unchecked {
+            if(value >> 128 > 0) {
+                value >>= 128;
+                result += 128;
+            }
+            if(value >> 64 > 0) {
+                value >>= 64;
+                result += 64;
+            }
+            if(value >> 32 > 0) {
+                value >>= 32;
+                result += 32;
+            }
+            if(value >> 16 > 0) {
+                value >>= 16;
+                result += 16;
+            }
+            if(value >> 8 > 0) {
+                value >>= 8;
+                result += 8;
+            }
+            if(value >> 4 > 0) {
+                value >>= 4;
+                result += 4;
+            }
+            if(value >> 2 > 0) {
+                value >>= 2;
+                result += 2;
+            }
+            if(value >> 1 > 0) {
+                result += 1;
+            }
+        }
+        return result;
+    }
+    /// @dev Return the log in base 2, following the selected rounding direction, of a positive value.
+    /// Returns 0 if given 0.
+    function log2(uint256 value, Rounding rounding) internal pure returns (uint256) {
+        unchecked {
+            uint256 result = log2(value);
+            return result + (rounding == Rounding.Up && 1 << result < value?1:0);
+        }
+    }
+    /// @dev Return the log in base 10, rounded down, of a positive value.
+    /// Returns 0 if given 0.
+    function log10(uint256 value) internal pure returns (uint256) {
+        uint256 result = 0;
+        unchecked {
+            if(value >= 10 ** 64) {
+                value /= 10 ** 64;
+                result += 64;
+            }
+            if(value >= 10 ** 32) {
+                value /= 10 ** 32;
+                result += 32;
+            }
+            if(value >= 10 ** 16) {
+                value /= 10 ** 16;
+                result += 16;
+            }
+            if(value >= 10 ** 8) {
+                value /= 10 ** 8;
+                result += 8;
+            }
+            if(value >= 10 ** 4) {
+                value /= 10 ** 4;
+                result += 4;
+            }
+            if(value >= 10 ** 2) {
+                value /= 10 ** 2;
+                result += 2;
+            }
+            if(value >= 10 ** 1) {
+                result += 1;
+            }
+        }
+        return result;
+    }
+    /// @dev Return the log in base 10, following the selected rounding direction, of a positive value.
+    /// Returns 0 if given 0.
+    function log10(uint256 value, Rounding rounding) internal pure returns (uint256) {
+        unchecked {
+            uint256 result = log10(value);
+            return result + (rounding == Rounding.Up && 10 ** result < value?1:0);
+        }
+    }
+    /// @dev Return the log in base 256, rounded down, of a positive value.
+    /// Returns 0 if given 0.
+    /// Adding one to the result gives the number of pairs of hex symbols needed to represent `value` as a hex string.
+    function log256(uint256 value) internal pure returns (uint256) {
+        uint256 result = 0;
+        unchecked {
+            if(value >> 128 > 0) {
+                value >>= 128;
+                result += 16;
+            }
+            if(value >> 64 > 0) {
+                value >>= 64;
+                result += 8;
+            }
+            if(value >> 32 > 0) {
+                value >>= 32;
+                result += 4;
+            }
+            if(value >> 16 > 0) {
+                value >>= 16;
+                result += 2;
+            }
+            if(value >> 8 > 0) {
+                result += 1;
+            }
+        }
+        return result;
+    }
+    /// @dev Return the log in base 10, following the selected rounding direction, of a positive value.
+    /// Returns 0 if given 0.
+    function log256(uint256 value, Rounding rounding) internal pure returns (uint256) {
+        unchecked {
+            uint256 result = log256(value);
+            return result + (rounding == Rounding.Up && 1 << (result * 8) < value?1:0);
+        }
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev String operations.
+library Strings {
+    bytes16 private constant _SYMBOLS = "0123456789abcdef";
+    uint8 private constant _ADDRESS_LENGTH = 20;
+    /// @dev Converts a `uint256` to its ASCII `string` decimal representation.
+    function toString(uint256 value) internal pure returns (string memory) {
+        unchecked {
+            uint256 length = Math.log10(value) + 1;
+            string memory buffer = new string(length);
+            uint256 ptr;
+            assembly {
+                ptr := add(buffer, add(32, length))
+            }
+            while (true) {
+                ptr--;
+                assembly {
+                    mstore8(ptr, byte(mod(value, 10), _SYMBOLS))
+                }
+                value /= 10;
+                if(value == 0) {
+                    break;
+                }
+            }
+            return buffer;
+        }
+    }
+    /// @dev Converts a `uint256` to its ASCII `string` hexadecimal representation.
+    function toHexString(uint256 value) internal pure returns (string memory) {
+        unchecked {
+            return toHexString(value, Math.log256(value) + 1);
+        }
+    }
+    /// @dev Converts a `uint256` to its ASCII `string` hexadecimal representation with fixed length.
+    function toHexString(uint256 value, uint256 length) internal pure returns (string memory) {
+        bytes memory buffer = new bytes(2 * length + 2);
+        buffer[0] = "0";
+        buffer[1] = "x";
+        for (uint256 i = 2 * length + 1; i > 1; --i) {
+            buffer[i] = _SYMBOLS[value & 0xf];
+            value >>= 4;
+        }
+        require(value == 0, "Strings: hex length insufficient");
+        return string(buffer);
+    }
+    /// @dev Converts an `address` with fixed length of 20 bytes to its not checksummed ASCII `string` hexadecimal representation.
+    function toHexString(address addr) internal pure returns (string memory) {
+        return toHexString(uint256(uint160(addr)), _ADDRESS_LENGTH);
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Provides information about the current execution context, including the
+/// sender of the transaction and its data. While these are generally available
+/// via msg.sender and msg.data, they should not be accessed in such a direct
+/// manner, since when dealing with meta-transactions the account sending and
+/// paying for execution may not be the actual sender (as far as an application
+/// is concerned).
+/// This contract is only required for intermediate, library-like contracts.
+abstract contract Context {
+    function _msgSender() internal view virtual returns (address) {
+        return msg.sender;
+    }
+    function _msgData() internal view virtual returns (bytes calldata) {
+        return msg.data;
+    }
+}
+pragma solidity ^ 0.8.0;
+/// @dev Contract module which provides a basic access control mechanism, where
+/// there is an account (an owner) that can be granted exclusive access to
+/// specific functions.
+/// By default, the owner account will be the one that deploys the contract. This
+/// can later be changed with {transferOwnership}.
+/// This module is used through inheritance. It will make available the modifier
+/// `onlyOwner`, which can be applied to your functions to restrict their use to
+/// the owner.
+abstract contract Ownable is Context {
+    address private _owner;
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+    /// @dev Initializes the contract setting the deployer as the initial owner.
+    constructor() internal {
+        _transferOwnership(_msgSender());
+    }
+    /// @dev Throws if called by any account other than the owner.
+    modifier onlyOwner() {
+        _checkOwner();
+        _;
+    }
+    /// @dev Returns the address of the current owner.
+    function owner() public view virtual returns (address) {
+        return _owner;
+    }
+    /// @dev Throws if the sender is not the owner.
+    function _checkOwner() internal view virtual {
+        require(owner() == _msgSender(), "Ownable: caller is not the owner");
+    }
+    /// @dev Leaves the contract without owner. It will not be possible to call
+    /// `onlyOwner` functions anymore. Can only be called by the current owner.
+    /// NOTE: Renouncing ownership will leave the contract without an owner,
+    /// thereby removing any functionality that is only available to the owner.
+    function renounceOwnership() public onlyOwner virtual {
+        _transferOwnership(address(0));
+    }
+    /// @dev Transfers ownership of the contract to a new account (`newOwner`).
+    /// Can only be called by the current owner.
+    function transferOwnership(address newOwner) public onlyOwner virtual {
+        require(newOwner != address(0), "Ownable: new owner is the zero address");
+        _transferOwnership(newOwner);
+    }
+    /// @dev Transfers ownership of the contract to a new account (`newOwner`).
+    /// Internal function without access restriction.
+    function _transferOwnership(address newOwner) internal virtual {
+        address oldOwner = _owner;
+        _owner = newOwner;
+        emit OwnershipTransferred(oldOwner, newOwner);
+    }
+}
+pragma solidity ^ 0.8.4;
+/// @dev Interface of ERC721A.
+interface IERC721A {
+    error ApprovalCallerNotOwnerNorApproved();
+    error ApprovalQueryForNonexistentToken();
+    error BalanceQueryForZeroAddress();
+    error MintToZeroAddress();
+    error MintZeroQuantity();
+    error OwnerQueryForNonexistentToken();
+    error TransferCallerNotOwnerNorApproved();
+    error TransferFromIncorrectOwner();
+    error TransferToNonERC721ReceiverImplementer();
+    error TransferToZeroAddress();
+    error URIQueryForNonexistentToken();
+    error MintERC2309QuantityExceedsLimit();
+    error OwnershipNotInitializedForExtraData();
+    struct TokenOwnership{
+        address addr;
+        uint64 startTimestamp;
+        bool burned;
+        uint24 extraData;
+    }
+    /// @dev Returns the total number of tokens in existence.
+    /// Burned tokens will reduce the count.
+    /// To get the total number of tokens minted, please see {_totalMinted}.
+    function totalSupply() external view returns (uint256);
+    /// @dev Returns true if this contract implements the interface defined by
+    /// `interfaceId`. See the corresponding
+    /// [EIP section](https://eips.ethereum.org/EIPS/eip-165#how-interfaces-are-identified)
+    /// to learn more about how these ids are created.
+    /// This function call must use less than 30000 gas.
+    function supportsInterface(bytes4 interfaceId) external view returns (bool);
+    /// @dev Emitted when `tokenId` token is transferred from `from` to `to`.
+    event Transfer(address indexed from, address indexed to, uint256 indexed tokenId);
+    /// @dev Emitted when `owner` enables `approved` to manage the `tokenId` token.
+    event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId);
+    /// @dev Emitted when `owner` enables or disables
+    /// (`approved`) `operator` to manage all of its assets.
+    event ApprovalForAll(address indexed owner, address indexed operator, bool approved);
+    /// @dev Returns the number of tokens in `owner`'s account.
+    function balanceOf(address owner) external view returns (uint256 balance);
+    /// @dev Returns the owner of the `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function ownerOf(uint256 tokenId) external view returns (address owner);
+    /// @dev Safely transfers `tokenId` token from `from` to `to`,
+    /// checking first that contract recipients are aware of the ERC721 protocol
+    /// to prevent tokens from being forever locked.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must exist and be owned by `from`.
+    /// - If the caller is not `from`, it must be have been allowed to move
+    /// this token by either {approve} or {setApprovalForAll}.
+    /// - If `to` refers to a smart contract, it must implement
+    /// {IERC721Receiver-onERC721Received}, which is called upon a safe transfer.
+    /// Emits a {Transfer} event.
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes calldata data) external payable;
+    /// @dev Equivalent to `safeTransferFrom(from, to, tokenId, '')`.
+    function safeTransferFrom(address from, address to, uint256 tokenId) external payable;
+    /// @dev Transfers `tokenId` from `from` to `to`.
+    /// WARNING: Usage of this method is discouraged, use {safeTransferFrom}
+    /// whenever possible.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must be owned by `from`.
+    /// - If the caller is not `from`, it must be approved to move this token
+    /// by either {approve} or {setApprovalForAll}.
+    /// Emits a {Transfer} event.
+    function transferFrom(address from, address to, uint256 tokenId) external payable;
+    /// @dev Gives permission to `to` to transfer `tokenId` token to another account.
+    /// The approval is cleared when the token is transferred.
+    /// Only a single account can be approved at a time, so approving the
+    /// zero address clears previous approvals.
+    /// Requirements:
+    /// - The caller must own the token or be an approved operator.
+    /// - `tokenId` must exist.
+    /// Emits an {Approval} event.
+    function approve(address to, uint256 tokenId) external payable;
+    /// @dev Approve or remove `operator` as an operator for the caller.
+    /// Operators can call {transferFrom} or {safeTransferFrom}
+    /// for any token owned by the caller.
+    /// Requirements:
+    /// - The `operator` cannot be the caller.
+    /// Emits an {ApprovalForAll} event.
+    function setApprovalForAll(address operator, bool _approved) external;
+    /// @dev Returns the account approved for `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function getApproved(uint256 tokenId) external view returns (address operator);
+    /// @dev Returns if the `operator` is allowed to manage all of the assets of `owner`.
+    /// See {setApprovalForAll}.
+    function isApprovedForAll(address owner, address operator) external view returns (bool);
+    /// @dev Returns the token collection name.
+    function name() external view returns (string memory);
+    /// @dev Returns the token collection symbol.
+    function symbol() external view returns (string memory);
+    /// @dev Returns the Uniform Resource Identifier (URI) for `tokenId` token.
+    function tokenURI(uint256 tokenId) external view returns (string memory);
+    /// @dev Emitted when tokens in `fromTokenId` to `toTokenId`
+    /// (inclusive) is transferred from `from` to `to`, as defined in the
+    /// [ERC2309](https://eips.ethereum.org/EIPS/eip-2309) standard.
+    /// See {_mintERC2309} for more details.
+    event ConsecutiveTransfer(uint256 indexed fromTokenId, uint256 toTokenId, address indexed from, address indexed to);
+}
+pragma solidity ^ 0.8.4;
+/// @dev Interface of ERC721 token receiver.
+interface ERC721A__IERC721Receiver {
+    function onERC721Received(address operator, address from, uint256 tokenId, bytes calldata data) external returns (bytes4);
+}
+/// @title ERC721A
+/// @dev Implementation of the [ERC721](https://eips.ethereum.org/EIPS/eip-721)
+/// Non-Fungible Token Standard, including the Metadata extension.
+/// Optimized for lower gas during batch mints.
+/// Token IDs are minted in sequential order (e.g. 0, 1, 2, 3, ...)
+/// starting from `_startTokenId()`.
+/// Assumptions:
+/// - An owner cannot have more than 2**64 - 1 (max value of uint64) of supply.
+/// - The maximum token ID cannot exceed 2**256 - 1 (max value of uint256).
+contract ERC721A is IERC721A {
+    struct TokenApprovalRef{
+        address value;
+    }
+    uint256 private constant _BITMASK_ADDRESS_DATA_ENTRY = (1 << 64) - 1;
+    uint256 private constant _BITPOS_NUMBER_MINTED = 64;
+    uint256 private constant _BITPOS_NUMBER_BURNED = 128;
+    uint256 private constant _BITPOS_AUX = 192;
+    uint256 private constant _BITMASK_AUX_COMPLEMENT = (1 << 192) - 1;
+    uint256 private constant _BITPOS_START_TIMESTAMP = 160;
+    uint256 private constant _BITMASK_BURNED = 1 << 224;
+    uint256 private constant _BITPOS_NEXT_INITIALIZED = 225;
+    uint256 private constant _BITMASK_NEXT_INITIALIZED = 1 << 225;
+    uint256 private constant _BITPOS_EXTRA_DATA = 232;
+    uint256 private constant _BITMASK_EXTRA_DATA_COMPLEMENT = (1 << 232) - 1;
+    uint256 private constant _BITMASK_ADDRESS = (1 << 160) - 1;
+    uint256 private constant _MAX_MINT_ERC2309_QUANTITY_LIMIT = 5000;
+    bytes32 private constant _TRANSFER_EVENT_SIGNATURE = 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef;
+    uint256 private _currentIndex;
+    uint256 private _burnCounter;
+    string private _name;
+    string private _symbol;
+    mapping (uint256 => uint256) private _packedOwnerships;
+    mapping (address => uint256) private _packedAddressData;
+    mapping (uint256 => TokenApprovalRef) private _tokenApprovals;
+    mapping (address => mapping (address => bool)) private _operatorApprovals;
+    constructor(string memory name_, string memory symbol_) public {
+        _name = name_;
+        _symbol = symbol_;
+        _currentIndex = _startTokenId();
+    }
+    /// @dev Returns the starting token ID.
+    /// To change the starting token ID, please override this function.
+    function _startTokenId() internal view virtual returns (uint256) {
+        return 0;
+    }
+    /// @dev Returns the next token ID to be minted.
+    function _nextTokenId() internal view virtual returns (uint256) {
+        return _currentIndex;
+    }
+    /// @dev Returns the total number of tokens in existence.
+    /// Burned tokens will reduce the count.
+    /// To get the total number of tokens minted, please see {_totalMinted}.
+    function totalSupply() public view override virtual returns (uint256) {
+        unchecked {
+            return _currentIndex - _burnCounter - _startTokenId();
+        }
+    }
+    /// @dev Returns the total amount of tokens minted in the contract.
+    function _totalMinted() internal view virtual returns (uint256) {
+        unchecked {
+            return _currentIndex - _startTokenId();
+        }
+    }
+    /// @dev Returns the total number of tokens burned.
+    function _totalBurned() internal view virtual returns (uint256) {
+        return _burnCounter;
+    }
+    /// @dev Returns the number of tokens in `owner`'s account.
+    function balanceOf(address owner) public view override virtual returns (uint256) {
+        if(owner == address(0)) {
+        revert BalanceQueryForZeroAddress();
+        }
+        return _packedAddressData[owner] & _BITMASK_ADDRESS_DATA_ENTRY;
+    }
+    /// Returns the number of tokens minted by `owner`.
+    function _numberMinted(address owner) internal view returns (uint256) {
+        return (_packedAddressData[owner] >> _BITPOS_NUMBER_MINTED) & _BITMASK_ADDRESS_DATA_ENTRY;
+    }
+    /// Returns the number of tokens burned by or on behalf of `owner`.
+    function _numberBurned(address owner) internal view returns (uint256) {
+        return (_packedAddressData[owner] >> _BITPOS_NUMBER_BURNED) & _BITMASK_ADDRESS_DATA_ENTRY;
+    }
+    /// Returns the auxiliary data for `owner`. (e.g. number of whitelist mint slots used).
+    function _getAux(address owner) internal view returns (uint64) {
+        return uint64(_packedAddressData[owner] >> _BITPOS_AUX);
+    }
+    /// Sets the auxiliary data for `owner`. (e.g. number of whitelist mint slots used).
+    /// If there are multiple variables, please pack them into a uint64.
+    function _setAux(address owner, uint64 aux) internal virtual {
+        uint256 packed = _packedAddressData[owner];
+        uint256 auxCasted;
+        assembly {
+            auxCasted := aux
+        }
+        packed = (packed & _BITMASK_AUX_COMPLEMENT) | (auxCasted << _BITPOS_AUX);
+        _packedAddressData[owner] = packed;
+    }
+    /// @dev Returns true if this contract implements the interface defined by
+    /// `interfaceId`. See the corresponding
+    /// [EIP section](https://eips.ethereum.org/EIPS/eip-165#how-interfaces-are-identified)
+    /// to learn more about how these ids are created.
+    /// This function call must use less than 30000 gas.
+    function supportsInterface(bytes4 interfaceId) public view override virtual returns (bool) {
+        return interfaceId == 0x01ffc9a7 || interfaceId == 0x80ac58cd || interfaceId == 0x5b5e139f;
+    }
+    /// @dev Returns the token collection name.
+    function name() public view override virtual returns (string memory) {
+        return _name;
+    }
+    /// @dev Returns the token collection symbol.
+    function symbol() public view override virtual returns (string memory) {
+        return _symbol;
+    }
+    /// @dev Returns the Uniform Resource Identifier (URI) for `tokenId` token.
+    function tokenURI(uint256 tokenId) public view override virtual returns (string memory) {
+        if(!_exists(tokenId)) {
+        revert URIQueryForNonexistentToken();
+        }
+        string memory baseURI = _baseURI();
+        return bytes(baseURI).length != 0?string(abi.encodePacked(baseURI, _toString(tokenId))):"";
+    }
+    /// @dev Base URI for computing {tokenURI}. If set, the resulting URI for each
+    /// token will be the concatenation of the `baseURI` and the `tokenId`. Empty
+    /// by default, it can be overridden in child contracts.
+    function _baseURI() internal view virtual returns (string memory) {
+        return "";
+    }
+    /// @dev Returns the owner of the `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function ownerOf(uint256 tokenId) public view override virtual returns (address) {
+        return address(uint160(_packedOwnershipOf(tokenId)));
+    }
+    /// @dev Gas spent here starts off proportional to the maximum mint batch size.
+    /// It gradually moves to O(1) as tokens get transferred around over time.
+    function _ownershipOf(uint256 tokenId) internal view virtual returns (TokenOwnership memory) {
+        return _unpackedOwnership(_packedOwnershipOf(tokenId));
+    }
+    /// @dev Returns the unpacked `TokenOwnership` struct at `index`.
+    function _ownershipAt(uint256 index) internal view virtual returns (TokenOwnership memory) {
+        return _unpackedOwnership(_packedOwnerships[index]);
+    }
+    /// @dev Initializes the ownership slot minted at `index` for efficiency purposes.
+    function _initializeOwnershipAt(uint256 index) internal virtual {
+        if(_packedOwnerships[index] == 0) {
+            _packedOwnerships[index] = _packedOwnershipOf(index);
+        }
+    }
+    /// Returns the packed ownership data of `tokenId`.
+    function _packedOwnershipOf(uint256 tokenId) private view returns (uint256) {
+        uint256 curr = tokenId;
+        unchecked {
+            if(_startTokenId() <= curr) {
+            if(curr < _currentIndex) {
+                uint256 packed = _packedOwnerships[curr];
+                if(packed & _BITMASK_BURNED == 0) {
+                    while (packed == 0) {
+                        packed = _packedOwnerships[--curr];
+                    }
+                    return packed;
+                }
+            }
+            }
+        }
+        revert OwnerQueryForNonexistentToken();
+    }
+    /// @dev Returns the unpacked `TokenOwnership` struct from `packed`.
+    function _unpackedOwnership(uint256 packed) private pure returns (TokenOwnership memory ownership) {
+        ownership.addr = address(uint160(packed));
+        ownership.startTimestamp = uint64(packed >> _BITPOS_START_TIMESTAMP);
+        ownership.burned = packed & _BITMASK_BURNED != 0;
+        ownership.extraData = uint24(packed >> _BITPOS_EXTRA_DATA);
+    }
+    /// @dev Packs ownership data into a single uint256.
+    function _packOwnershipData(address owner, uint256 flags) private view returns (uint256 result) {
+        assembly {
+            owner := and(owner, _BITMASK_ADDRESS)
+            result := or(owner, or(shl(_BITPOS_START_TIMESTAMP, timestamp()), flags))
+        }
+    }
+    /// @dev Returns the `nextInitialized` flag set if `quantity` equals 1.
+    function _nextInitializedFlag(uint256 quantity) private pure returns (uint256 result) {
+        assembly {
+            result := shl(_BITPOS_NEXT_INITIALIZED, eq(quantity, 1))
+        }
+    }
+    /// @dev Gives permission to `to` to transfer `tokenId` token to another account.
+    /// The approval is cleared when the token is transferred.
+    /// Only a single account can be approved at a time, so approving the
+    /// zero address clears previous approvals.
+    /// Requirements:
+    /// - The caller must own the token or be an approved operator.
+    /// - `tokenId` must exist.
+    /// Emits an {Approval} event.
+    function approve(address to, uint256 tokenId) public payable override virtual {
+        address owner = ownerOf(tokenId);
+        if(_msgSenderERC721A() != owner) {
+        if(!isApprovedForAll(owner, _msgSenderERC721A())) {
+            revert ApprovalCallerNotOwnerNorApproved();
+        }
+        }
+        _tokenApprovals[tokenId].value = to;
+        emit Approval(owner, to, tokenId);
+    }
+    /// @dev Returns the account approved for `tokenId` token.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    function getApproved(uint256 tokenId) public view override virtual returns (address) {
+        if(!_exists(tokenId)) {
+        revert ApprovalQueryForNonexistentToken();
+        }
+        return _tokenApprovals[tokenId].value;
+    }
+    /// @dev Approve or remove `operator` as an operator for the caller.
+    /// Operators can call {transferFrom} or {safeTransferFrom}
+    /// for any token owned by the caller.
+    /// Requirements:
+    /// - The `operator` cannot be the caller.
+    /// Emits an {ApprovalForAll} event.
+    function setApprovalForAll(address operator, bool approved) public override virtual {
+        _operatorApprovals[_msgSenderERC721A()][operator] = approved;
+        emit ApprovalForAll(_msgSenderERC721A(), operator, approved);
+    }
+    /// @dev Returns if the `operator` is allowed to manage all of the assets of `owner`.
+    /// See {setApprovalForAll}.
+    function isApprovedForAll(address owner, address operator) public view override virtual returns (bool) {
+        return _operatorApprovals[owner][operator];
+    }
+    /// @dev Returns whether `tokenId` exists.
+    /// Tokens can be managed by their owner or approved accounts via {approve} or {setApprovalForAll}.
+    /// Tokens start existing when they are minted. See {_mint}.
+    function _exists(uint256 tokenId) internal view virtual returns (bool) {
+        return _startTokenId() <= tokenId && tokenId < _currentIndex && _packedOwnerships[tokenId] & _BITMASK_BURNED == 0;
+    }
+    /// @dev Returns whether `msgSender` is equal to `approvedAddress` or `owner`.
+    function _isSenderApprovedOrOwner(address approvedAddress, address owner, address msgSender) private pure returns (bool result) {
+        assembly {
+            owner := and(owner, _BITMASK_ADDRESS)
+            msgSender := and(msgSender, _BITMASK_ADDRESS)
+            result := or(eq(msgSender, owner), eq(msgSender, approvedAddress))
+        }
+    }
+    /// @dev Returns the storage slot and value for the approved address of `tokenId`.
+    function _getApprovedSlotAndAddress(uint256 tokenId) private view returns (uint256 approvedAddressSlot, address approvedAddress) {
+        TokenApprovalRef storage tokenApproval = _tokenApprovals[tokenId];
+        assembly {
+            approvedAddressSlot := tokenApproval.slot
+            approvedAddress := sload(approvedAddressSlot)
+        }
+    }
+    /// @dev Transfers `tokenId` from `from` to `to`.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must be owned by `from`.
+    /// - If the caller is not `from`, it must be approved to move this token
+    /// by either {approve} or {setApprovalForAll}.
+    /// Emits a {Transfer} event.
+    function transferFrom(address from, address to, uint256 tokenId) public payable override virtual {
+        uint256 prevOwnershipPacked = _packedOwnershipOf(tokenId);
+        if(address(uint160(prevOwnershipPacked)) != from) {
+        revert TransferFromIncorrectOwner();
+        }
+        (uint256 approvedAddressSlot, address approvedAddress) = _getApprovedSlotAndAddress(tokenId);
+        if(!_isSenderApprovedOrOwner(approvedAddress, from, _msgSenderERC721A())) {
+        if(!isApprovedForAll(from, _msgSenderERC721A())) {
+        revert TransferCallerNotOwnerNorApproved();
+        }
+        }
+        if(to == address(0)) {
+        revert TransferToZeroAddress();
+        }
+        _beforeTokenTransfers(from, to, tokenId, 1);
+        assembly {
+            if approvedAddress{
+                sstore(approvedAddressSlot, 0)
+            }
+        }
+        unchecked {
+            --_packedAddressData[from];
+            ++_packedAddressData[to];
+            _packedOwnerships[tokenId] = _packOwnershipData(to, _BITMASK_NEXT_INITIALIZED | _nextExtraData(from, to, prevOwnershipPacked));
+            if(prevOwnershipPacked & _BITMASK_NEXT_INITIALIZED == 0) {
+                uint256 nextTokenId = tokenId + 1;
+                if(_packedOwnerships[nextTokenId] == 0) {
+                    if(nextTokenId != _currentIndex) {
+                        _packedOwnerships[nextTokenId] = prevOwnershipPacked;
+                    }
+                }
+            }
+        }
+        emit Transfer(from, to, tokenId);
+        _afterTokenTransfers(from, to, tokenId, 1);
+    }
+    /// @dev Equivalent to `safeTransferFrom(from, to, tokenId, '')`.
+    function safeTransferFrom(address from, address to, uint256 tokenId) public payable override virtual {
+        safeTransferFrom(from, to, tokenId, "");
+    }
+    /// @dev Safely transfers `tokenId` token from `from` to `to`.
+    /// Requirements:
+    /// - `from` cannot be the zero address.
+    /// - `to` cannot be the zero address.
+    /// - `tokenId` token must exist and be owned by `from`.
+    /// - If the caller is not `from`, it must be approved to move this token
+    /// by either {approve} or {setApprovalForAll}.
+    /// - If `to` refers to a smart contract, it must implement
+    /// {IERC721Receiver-onERC721Received}, which is called upon a safe transfer.
+    /// Emits a {Transfer} event.
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes memory _data) public payable override virtual {
+        transferFrom(from, to, tokenId);
+        if(to.code.length != 0) {
+        if(!_checkContractOnERC721Received(from, to, tokenId, _data)) {
+            revert TransferToNonERC721ReceiverImplementer();
+        }
+        }
+    }
+    /// @dev Hook that is called before a set of serially-ordered token IDs
+    /// are about to be transferred. This includes minting.
+    /// And also called before burning one token.
+    /// `startTokenId` - the first token ID to be transferred.
+    /// `quantity` - the amount to be transferred.
+    /// Calling conditions:
+    /// - When `from` and `to` are both non-zero, `from`'s `tokenId` will be
+    /// transferred to `to`.
+    /// - When `from` is zero, `tokenId` will be minted for `to`.
+    /// - When `to` is zero, `tokenId` will be burned by `from`.
+    /// - `from` and `to` are never both zero.
+    function _beforeTokenTransfers(address from, address to, uint256 startTokenId, uint256 quantity) internal virtual {
+
+    }
+    /// @dev Hook that is called after a set of serially-ordered token IDs
+    /// have been transferred. This includes minting.
+    /// And also called after one token has been burned.
+    /// `startTokenId` - the first token ID to be transferred.
+    /// `quantity` - the amount to be transferred.
+    /// Calling conditions:
+    /// - When `from` and `to` are both non-zero, `from`'s `tokenId` has been
+    /// transferred to `to`.
+    /// - When `from` is zero, `tokenId` has been minted for `to`.
+    /// - When `to` is zero, `tokenId` has been burned by `from`.
+    /// - `from` and `to` are never both zero.
+    function _afterTokenTransfers(address from, address to, uint256 startTokenId, uint256 quantity) internal virtual {
+
+    }
+    /// @dev Private function to invoke {IERC721Receiver-onERC721Received} on a target contract.
+    /// `from` - Previous owner of the given token ID.
+    /// `to` - Target address that will receive the token.
+    /// `tokenId` - Token ID to be transferred.
+    /// `_data` - Optional data to send along with the call.
+    /// Returns whether the call correctly returned the expected magic value.
+    function _checkContractOnERC721Received(address from, address to, uint256 tokenId, bytes memory _data) private returns (bool) {
+        try ERC721A__IERC721Receiver(to).onERC721Received(_msgSenderERC721A(), from, tokenId, _data) returns (bytes4 retval) {
+            return retval == ERC721A__IERC721Receiver(to).onERC721Received.selector;
+        } catch (bytes memory reason) {
+            if(reason.length == 0) {
+                revert TransferToNonERC721ReceiverImplementer();
+            } else {
+                assembly {
+                    revert(add(32, reason), mload(reason))
+                }
+            }
+        }
+    }
+    /// @dev Mints `quantity` tokens and transfers them to `to`.
+    /// Requirements:
+    /// - `to` cannot be the zero address.
+    /// - `quantity` must be greater than 0.
+    /// Emits a {Transfer} event for each mint.
+    function _mint(address to, uint256 quantity) internal virtual {
+        uint256 startTokenId = _currentIndex;
+        if(quantity == 0) {
+        revert MintZeroQuantity();
+        }
+        _beforeTokenTransfers(address(0), to, startTokenId, quantity);
+        unchecked {
+            _packedAddressData[to] += quantity * ((1 << _BITPOS_NUMBER_MINTED) | 1);
+            _packedOwnerships[startTokenId] = _packOwnershipData(to, _nextInitializedFlag(quantity) | _nextExtraData(address(0), to, 0));
+            uint256 toMasked;
+            uint256 end = startTokenId + quantity;
+            assembly {
+                toMasked := and(to, _BITMASK_ADDRESS)
+                log4(0, 0, _TRANSFER_EVENT_SIGNATURE, 0, toMasked, startTokenId)
+                for {
+                    let tokenId := add(startTokenId, 1)
+                } iszero(eq(tokenId, end)) {
+                    tokenId := add(tokenId, 1)
+                } {
+                    log4(0, 0, _TRANSFER_EVENT_SIGNATURE, 0, toMasked, tokenId)
+                }
+            }
+            if(toMasked == 0) {
+            revert MintToZeroAddress();
+            }
+            _currentIndex = end;
+        }
+        _afterTokenTransfers(address(0), to, startTokenId, quantity);
+    }
+    /// @dev Mints `quantity` tokens and transfers them to `to`.
+    /// This function is intended for efficient minting only during contract creation.
+    /// It emits only one {ConsecutiveTransfer} as defined in
+    /// [ERC2309](https://eips.ethereum.org/EIPS/eip-2309),
+    /// instead of a sequence of {Transfer} event(s).
+    /// Calling this function outside of contract creation WILL make your contract
+    /// non-compliant with the ERC721 standard.
+    /// For full ERC721 compliance, substituting ERC721 {Transfer} event(s) with the ERC2309
+    /// {ConsecutiveTransfer} event is only permissible during contract creation.
+    /// Requirements:
+    /// - `to` cannot be the zero address.
+    /// - `quantity` must be greater than 0.
+    /// Emits a {ConsecutiveTransfer} event.
+    function _mintERC2309(address to, uint256 quantity) internal virtual {
+        uint256 startTokenId = _currentIndex;
+        if(to == address(0)) {
+        revert MintToZeroAddress();
+        }
+        if(quantity == 0) {
+        revert MintZeroQuantity();
+        }
+        if(quantity > _MAX_MINT_ERC2309_QUANTITY_LIMIT) {
+        revert MintERC2309QuantityExceedsLimit();
+        }
+        _beforeTokenTransfers(address(0), to, startTokenId, quantity);
+        unchecked {
+            _packedAddressData[to] += quantity * ((1 << _BITPOS_NUMBER_MINTED) | 1);
+            _packedOwnerships[startTokenId] = _packOwnershipData(to, _nextInitializedFlag(quantity) | _nextExtraData(address(0), to, 0));
+            emit ConsecutiveTransfer(startTokenId, startTokenId + quantity - 1, address(0), to);
+            _currentIndex = startTokenId + quantity;
+        }
+        _afterTokenTransfers(address(0), to, startTokenId, quantity);
+    }
+    /// @dev Safely mints `quantity` tokens and transfers them to `to`.
+    /// Requirements:
+    /// - If `to` refers to a smart contract, it must implement
+    /// {IERC721Receiver-onERC721Received}, which is called for each safe transfer.
+    /// - `quantity` must be greater than 0.
+    /// See {_mint}.
+    /// Emits a {Transfer} event for each mint.
+    function _safeMint(address to, uint256 quantity, bytes memory _data) internal virtual {
+        _mint(to, quantity);
+        unchecked {
+            if(to.code.length != 0) {
+                uint256 end = _currentIndex;
+                uint256 index = end - quantity;
+                do {
+                    if(!_checkContractOnERC721Received(address(0), to, index++, _data)) {
+                        revert TransferToNonERC721ReceiverImplementer();
+                    }
+                } while (index < end);
+                if(_currentIndex != end) {
+                    revert();
+                }
+            }
+        }
+    }
+    /// @dev Equivalent to `_safeMint(to, quantity, '')`.
+    function _safeMint(address to, uint256 quantity) internal virtual {
+        _safeMint(to, quantity, "");
+    }
+    /// @dev Equivalent to `_burn(tokenId, false)`.
+    function _burn(uint256 tokenId) internal virtual {
+        _burn(tokenId, false);
+    }
+    /// @dev Destroys `tokenId`.
+    /// The approval is cleared when the token is burned.
+    /// Requirements:
+    /// - `tokenId` must exist.
+    /// Emits a {Transfer} event.
+    function _burn(uint256 tokenId, bool approvalCheck) internal virtual {
+        uint256 prevOwnershipPacked = _packedOwnershipOf(tokenId);
+        address from = address(uint160(prevOwnershipPacked));
+        (uint256 approvedAddressSlot, address approvedAddress) = _getApprovedSlotAndAddress(tokenId);
+        if(approvalCheck) {
+            if(!_isSenderApprovedOrOwner(approvedAddress, from, _msgSenderERC721A())) {
+            if(!isApprovedForAll(from, _msgSenderERC721A())) {
+            revert TransferCallerNotOwnerNorApproved();
+            }
+            }
+        }
+        _beforeTokenTransfers(from, address(0), tokenId, 1);
+        assembly {
+            if approvedAddress{
+                sstore(approvedAddressSlot, 0)
+            }
+        }
+        unchecked {
+            _packedAddressData[from] += (1 << _BITPOS_NUMBER_BURNED) - 1;
+            _packedOwnerships[tokenId] = _packOwnershipData(from, (_BITMASK_BURNED | _BITMASK_NEXT_INITIALIZED) | _nextExtraData(from, address(0), prevOwnershipPacked));
+            if(prevOwnershipPacked & _BITMASK_NEXT_INITIALIZED == 0) {
+                uint256 nextTokenId = tokenId + 1;
+                if(_packedOwnerships[nextTokenId] == 0) {
+                    if(nextTokenId != _currentIndex) {
+                        _packedOwnerships[nextTokenId] = prevOwnershipPacked;
+                    }
+                }
+            }
+        }
+        emit Transfer(from, address(0), tokenId);
+        _afterTokenTransfers(from, address(0), tokenId, 1);
+        unchecked {
+            _burnCounter++;
+        }
+    }
+    /// @dev Directly sets the extra data for the ownership data `index`.
+    function _setExtraDataAt(uint256 index, uint24 extraData) internal virtual {
+        uint256 packed = _packedOwnerships[index];
+        if(packed == 0) {
+        revert OwnershipNotInitializedForExtraData();
+        }
+        uint256 extraDataCasted;
+        assembly {
+            extraDataCasted := extraData
+        }
+        packed = (packed & _BITMASK_EXTRA_DATA_COMPLEMENT) | (extraDataCasted << _BITPOS_EXTRA_DATA);
+        _packedOwnerships[index] = packed;
+    }
+    /// @dev Called during each token transfer to set the 24bit `extraData` field.
+    /// Intended to be overridden by the cosumer contract.
+    /// `previousExtraData` - the value of `extraData` before transfer.
+    /// Calling conditions:
+    /// - When `from` and `to` are both non-zero, `from`'s `tokenId` will be
+    /// transferred to `to`.
+    /// - When `from` is zero, `tokenId` will be minted for `to`.
+    /// - When `to` is zero, `tokenId` will be burned by `from`.
+    /// - `from` and `to` are never both zero.
+    function _extraData(address from, address to, uint24 previousExtraData) internal view virtual returns (uint24) {
+
+    }
+    /// @dev Returns the next extra data for the packed ownership data.
+    /// The returned result is shifted into position.
+    function _nextExtraData(address from, address to, uint256 prevOwnershipPacked) private view returns (uint256) {
+        uint24 extraData = uint24(prevOwnershipPacked >> _BITPOS_EXTRA_DATA);
+        return uint256(_extraData(from, to, extraData)) << _BITPOS_EXTRA_DATA;
+    }
+    /// @dev Returns the message sender (defaults to `msg.sender`).
+    /// If you are writing GSN compatible contracts, you need to override this function.
+    function _msgSenderERC721A() internal view virtual returns (address) {
+        return msg.sender;
+    }
+    /// @dev Converts a uint256 to its ASCII string decimal representation.
+    function _toString(uint256 value) internal pure virtual returns (string memory str) {
+        assembly {
+            let m := add(mload(0x40), 0xa0)
+            mstore(0x40, m)
+            str := sub(m, 0x20)
+            mstore(str, 0)
+            let end := str
+            for {
+                let temp := value
+            } 1 {
+
+            } {
+                str := sub(str, 1)
+                mstore8(str, add(48, mod(temp, 10)))
+                temp := div(temp, 10)
+                if iszero(temp){
+                    break
+                }
+            }
+            let length := sub(end, str)
+            str := sub(str, 0x20)
+            mstore(str, length)
+        }
+    }
+}
+pragma solidity ^ 0.8.18;
+contract TheHornsWTF is ERC721A, DefaultOperatorFilterer, Ownable {
+    using Strings for uint256;
+    string baseURI;
+    string public notRevealedUri;
+    string public baseExtension = ".json";
+    uint256 public constant MAX_SUPPLY = 5555;
+    uint256 public mintPrice = 0.001 ether;
+    uint256 public maxBalance = 100;
+    uint256 public maxMint = 5;
+    bool public _isSaleActive = false;
+    bool public _revealed = true;
+    mapping (uint256 => string) private _tokenURIs;
+    constructor(string memory initBaseURI, string memory initNotRevealedUri) ERC721A("TheHornsWTF", "HW") public {
+        setBaseURI(initBaseURI);
+        setNotRevealedURI(initNotRevealedUri);
+    }
+    function mintPublic(uint256 tokenQuantity) public payable {
+        require(_isSaleActive, "Sale must be active to mint NFT");
+        require(tokenQuantity <= maxMint, "Mint too many tokens at a time");
+        require(balanceOf(msg.sender) + tokenQuantity <= maxBalance, "Sale would exceed max balance");
+        require(totalSupply() + tokenQuantity <= MAX_SUPPLY, "Sale would exceed max supply");
+        if(balanceOf(msg.sender) > 0) {
+            require(tokenQuantity * mintPrice <= msg.value, "Not enough ether");
+        } else {
+            require((tokenQuantity - 1) * mintPrice <= msg.value, "Not enough ether");
+        }
+        _safeMint(msg.sender, tokenQuantity);
+    }
+    function mintOwner(uint256 tokenQuantity) public onlyOwner {
+        _safeMint(msg.sender, tokenQuantity);
+    }
+    function tokenURI(uint256 tokenId) public view override virtual returns (string memory) {
+        require(_exists(tokenId), "URI query for nonexistent token");
+        if(_revealed == false) {
+            return notRevealedUri;
+        }
+        string memory _tokenURI = _tokenURIs[tokenId];
+        string memory base = _baseURI();
+        if(bytes(base).length == 0) {
+            return _tokenURI;
+        }
+        if(bytes(_tokenURI).length > 0) {
+            return string(abi.encodePacked(base, _tokenURI));
+        }
+        return string(abi.encodePacked(base, tokenId.toString(), baseExtension));
+    }
+    function _baseURI() internal view override virtual returns (string memory) {
+        return baseURI;
+    }
+    function setBaseURI(string memory _newBaseURI) public onlyOwner {
+        baseURI = _newBaseURI;
+    }
+    function flipSaleActive() public onlyOwner {
+        _isSaleActive = !_isSaleActive;
+    }
+    function flipReveal() public onlyOwner {
+        _revealed = !_revealed;
+    }
+    function setMintPrice(uint256 _mintPrice) public onlyOwner {
+        mintPrice = _mintPrice;
+    }
+    function setNotRevealedURI(string memory _notRevealedURI) public onlyOwner {
+        notRevealedUri = _notRevealedURI;
+    }
+    function setBaseExtension(string memory _newBaseExtension) public onlyOwner {
+        baseExtension = _newBaseExtension;
+    }
+    function setMaxBalance(uint256 _maxBalance) public onlyOwner {
+        maxBalance = _maxBalance;
+    }
+    function setMaxMint(uint256 _maxMint) public onlyOwner {
+        maxMint = _maxMint;
+    }
+    function withdraw(address to) public onlyOwner {
+        uint256 balance = address(this).balance;
+        payable(to).transfer(balance);
+    }
+    function setApprovalForAll(address operator, bool approved) public onlyAllowedOperatorApproval(operator) override {
+        super.setApprovalForAll(operator, approved);
+    }
+    function approve(address operator, uint256 tokenId) public onlyAllowedOperatorApproval(operator) payable override {
+        super.approve(operator, tokenId);
+    }
+    function transferFrom(address from, address to, uint256 tokenId) public onlyAllowedOperator(from) payable override {
+        super.transferFrom(from, to, tokenId);
+    }
+    function safeTransferFrom(address from, address to, uint256 tokenId) public onlyAllowedOperator(from) payable override {
+        super.safeTransferFrom(from, to, tokenId);
+    }
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes memory data) public onlyAllowedOperator(from) payable override {
+        super.safeTransferFrom(from, to, tokenId, data);
+    }
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	v04 "github.com/geistwelt/taintguard/src/v0.4"
+	v05 "github.com/geistwelt/taintguard/src/v0.5"
+	v06 "github.com/geistwelt/taintguard/src/v0.6"
+	v08 "github.com/geistwelt/taintguard/src/v0.8"
+	"github.com/spf13/cobra"
+)
+
+var detectorsFormat string
+
+var detectorsCmd = &cobra.Command{
+	Use:   "detectors",
+	Short: "Inspect the registered vulnerability detectors.",
+	Args:  cobra.NoArgs,
+}
+
+var detectorsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the id, severity, confidence and supported solidity versions of every detector.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if detectorsFormat != "text" && detectorsFormat != "json" {
+			return src.Errorf(src.InputError, "unknown format [%s], expected text or json", detectorsFormat)
+		}
+
+		infos := knownDetectors()
+		out := cmd.OutOrStdout()
+		if detectorsFormat == "json" {
+			bz, err := json.MarshalIndent(infos, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal detectors: [%v]", err)
+			}
+			fmt.Fprintln(out, string(bz))
+			return nil
+		}
+
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSEVERITY\tCONFIDENCE\tVERSIONS\tDESCRIPTION")
+		for _, info := range infos {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", info.ID, info.Severity, info.Confidence, strings.Join(info.Versions, ","), info.Description)
+		}
+		return w.Flush()
+	},
+}
+
+// knownDetectors 汇总各个版本注册的检测器、基于中间表示的检测器以及已加载的自定义规则，同一个 id 只保留一项，并记录实现了该检测器的版本。
+func knownDetectors() []*src.DetectorInfo {
+	infos := make(map[string]*src.DetectorInfo)
+	add := func(version string, d *src.DetectorInfo) {
+		info, ok := infos[d.ID]
+		if !ok {
+			info = &src.DetectorInfo{ID: d.ID, Description: d.Description, Severity: d.Severity, Confidence: d.Confidence}
+			infos[d.ID] = info
+		}
+		info.Versions = append(info.Versions, version)
+	}
+	for _, d := range v04.Detectors() {
+		add("0.4", d.Info())
+	}
+	for _, d := range v05.Detectors() {
+		add("0.5", d.Info())
+	}
+	for _, d := range v06.Detectors() {
+		add("0.6", d.Info())
+	}
+	for _, d := range v08.Detectors() {
+		add("0.7-0.8", d.Info())
+	}
+
+	for _, info := range analysis.Detectors() {
+		infos[info.ID] = info
+	}
+
+	for _, r := range rules {
+		if _, ok := infos[r.ID]; !ok {
+			infos[r.ID] = r.Info()
+		}
+	}
+
+	list := make([]*src.DetectorInfo, 0, len(infos))
+	for _, info := range infos {
+		list = append(list, info)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+func detectorCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var ids []string
+	for _, info := range knownDetectors() {
+		ids = append(ids, info.ID)
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	detectorsListCmd.Flags().StringVar(&detectorsFormat, "format", "text", "Format of the detector list printed to stdout, text or json.")
+	detectorsListCmd.RegisterFlagCompletionFunc("format", fixedCompletion("text", "json"))
+	detectorsCmd.AddCommand(detectorsListCmd)
+}
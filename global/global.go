@@ -1,8 +1,20 @@
 package global
 
 var (
-	Variables []string
-	Input     string
-	Output    string
-	Cg        bool
+	Variables  []string
+	ConfigFile string
+	Input      string
+	Output     string
+	Cg         bool
+	Strict     bool
+	FailOn     string
+
+	Detectors        []string
+	ExcludeDetectors []string
+	Rules            []string
+
+	LogLevel  string
+	LogFormat string
+	LogFile   string
+	Quiet     bool
 )
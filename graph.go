@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geistwelt/taintguard/global"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/graph"
+	"github.com/spf13/cobra"
+)
+
+var (
+	graphKinds  []string
+	graphSource string
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Generate call graphs, control flow graphs or the inheritance graph of the contract.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, kind := range graphKinds {
+			if kind != "call" && kind != "cfg" && kind != "inheritance" {
+				return src.Errorf(src.InputError, "unknown graph kind [%s], expected one of call, cfg and inheritance", kind)
+			}
+		}
+
+		if err := checkOutput(); err != nil {
+			return err
+		}
+		in, err := loadInput()
+		if err != nil {
+			return err
+		}
+
+		for _, kind := range graphKinds {
+			switch kind {
+			case "call":
+				if _, _, err = analyze(in, true, global.Output); err != nil {
+					return err
+				}
+			case "cfg":
+				source := readSource()
+				dir := filepath.Join(global.Output, "control-flow-graph", in.solFileName)
+				for _, g := range graph.CFG(in.source, source) {
+					if err = graph.Render(g, dir, fmt.Sprintf("%d", g.ID), logger); err != nil {
+						return src.WrapError(src.AnalysisError, err)
+					}
+				}
+			case "inheritance":
+				dir := filepath.Join(global.Output, "inheritance-graph", in.solFileName)
+				if err = graph.Render(graph.Inheritance(in.source), dir, "inheritance", logger); err != nil {
+					return src.WrapError(src.AnalysisError, err)
+				}
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	addOutputFlag(graphCmd)
+	graphCmd.Flags().StringSliceVar(&graphKinds, "kind", []string{"call"}, "Kinds of graphs to generate, any of call, cfg and inheritance.")
+	graphCmd.Flags().StringVar(&graphSource, "source", "", "Path to the solidity source file used to label the control flow graph, default is the input path without the _json.ast suffix.")
+	graphCmd.RegisterFlagCompletionFunc("kind", fixedCompletion("call", "cfg", "inheritance"))
+	graphCmd.MarkFlagFilename("source", "sol")
+}
+
+// readSource 读取合约源码，用于给控制流图的节点加上源码片段；读取失败时节点只显示类型。
+func readSource() []byte {
+	path := graphSource
+	if path == "" {
+		if path = strings.TrimSuffix(global.Input, "_json.ast"); path == global.Input {
+			return nil
+		}
+	}
+	source, err := os.ReadFile(path)
+	if err != nil {
+		logger.Debugf("Failed to read source file [%s], the control flow graph will be labeled with node types: [%v].", path, err)
+		return nil
+	}
+	return source
+}
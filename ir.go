@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/spf13/cobra"
+)
+
+var irFunction string
+
+var irCmd = &cobra.Command{
+	Use:   "ir",
+	Short: "Print the SSA intermediate representation of every function and modifier.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		in, err := loadInput()
+		if err != nil {
+			return err
+		}
+
+		program := ir.Build(in.source)
+		out := cmd.OutOrStdout()
+		if irFunction == "" {
+			fmt.Fprint(out, program)
+			return nil
+		}
+
+		found := false
+		for _, c := range program.Contracts {
+			for _, f := range c.Functions {
+				if f.Name == irFunction || c.Name+"."+f.Name == irFunction {
+					if found {
+						fmt.Fprintln(out)
+					}
+					fmt.Fprint(out, f)
+					found = true
+				}
+			}
+		}
+		if !found {
+			return src.Errorf(src.InputError, "function [%s] is not found or has no body", irFunction)
+		}
+		return nil
+	},
+}
+
+func init() {
+	irCmd.Flags().StringVar(&irFunction, "function", "", "Only print the functions or modifiers with this name, either name or Contract.name.")
+}
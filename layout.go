@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/layout"
+	"github.com/spf13/cobra"
+)
+
+var layoutFormat string
+
+var layoutCmd = &cobra.Command{
+	Use:   "layout",
+	Short: "Print the storage layout of every contract, including the inherited state variables.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if layoutFormat != "text" && layoutFormat != "json" {
+			return src.Errorf(src.InputError, "unknown format [%s], expected text or json", layoutFormat)
+		}
+
+		in, err := loadInput()
+		if err != nil {
+			return err
+		}
+		layouts, err := layout.Compute(in.source)
+		if err != nil {
+			return src.WrapError(src.AnalysisError, err)
+		}
+
+		out := cmd.OutOrStdout()
+		if layoutFormat == "json" {
+			if layouts == nil {
+				layouts = []*layout.Layout{}
+			}
+			bz, err := json.MarshalIndent(layouts, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal storage layout: [%v]", err)
+			}
+			fmt.Fprintln(out, string(bz))
+			return nil
+		}
+
+		for i, l := range layouts {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			fmt.Fprintf(out, "contract %s\n", l.Contract)
+			w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "  slot\toffset\tsize\tname\ttype\tdeclared in")
+			for _, v := range l.Variables {
+				fmt.Fprintf(w, "  %d\t%d\t%d\t%s\t%s\t%s\n", v.Slot, v.Offset, v.Size, v.Name, v.Type, v.Contract)
+			}
+			w.Flush()
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	layoutCmd.Flags().StringVar(&layoutFormat, "format", "text", "Format of the storage layout printed to stdout, text or json.")
+	layoutCmd.RegisterFlagCompletionFunc("format", fixedCompletion("text", "json"))
+}
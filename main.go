@@ -10,6 +10,10 @@ import (
 	"github.com/geistwelt/logging"
 	"github.com/geistwelt/taintguard/global"
 	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/rule"
 	v04 "github.com/geistwelt/taintguard/src/v0.4"
 	v05 "github.com/geistwelt/taintguard/src/v0.5"
 	v06 "github.com/geistwelt/taintguard/src/v0.6"
@@ -18,14 +22,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var opt = logging.Option{
-	Module:         "TaintGuard",
-	FilterLevel:    logging.DebugLevel,
-	Spec:           "%{color}[%{time}] [%{module}] %{location}%{color:reset} => %{message}",
-	FormatSelector: "terminal",
-	Writer:         os.Stdout,
-}
-var logger = logging.MustNewLogger(opt)
+var (
+	logger logging.Logger
+	conf   *config.Config
+	rules  []*rule.Rule
+)
 
 func main() {
 	execute()
@@ -35,218 +36,402 @@ var (
 	rootCmd = &cobra.Command{
 		Use:   "tguard",
 		Short: "Tguard is a smart contract vulnerability detection and patching tool.",
-		Long: `Tguard is an automated tool that detects the existence of vulnerabilities in 
-		solidity smart contracts due to delegatecall and can automatically patch contract 
+		Long: `Tguard is an automated tool that detects the existence of vulnerabilities in
+		solidity smart contracts due to delegatecall and can automatically patch contract
 		vulnerabilities using code instrumentating technology.`,
-		Run: func(cmd *cobra.Command, args []string) {
-			jsonBytes := src.MustReadFile(global.Input)
-			if global.Output[len(global.Output)-1] != '\\' {
-				global.Output = fmt.Sprintf("%s/", global.Output)
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			l, closeFn, err := src.NewLogger(global.LogLevel, global.LogFormat, global.LogFile, global.Quiet)
+			if err != nil {
+				return src.WrapError(src.InputError, err)
+			}
+			logger = l
+			cobra.OnFinalize(func() { closeFn() })
+			if err = loadConfig(cmd); err != nil {
+				return src.WrapError(src.InputError, err)
 			}
-			source := jsoniter.Get(jsonBytes)
-			absolutePath := source.Get("absolutePath").ToString()
-
-			var solFileName string
-			absolutePaths := strings.Split(absolutePath, "/")
-			solFileName = absolutePaths[len(absolutePaths)-1]
-
-			relativePath := "contracts"
-			sourceUnit := source.Get("nodeType")
-			if sourceUnit.ToString() != "SourceUnit" {
-				fmt.Printf("Expected SourceUnit, but got [%s].\n", sourceUnit.ToString())
-				os.Exit(1)
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return patch(global.Cg)
+		},
+	}
+)
+
+// loadConfig 读取 --config 指定的配置文件，没有指定时从当前目录开始向上查找 .tguard.yaml；
+// 命令行中显式指定的参数优先于配置文件。
+func loadConfig(cmd *cobra.Command) error {
+	file := global.ConfigFile
+	if file == "" {
+		var err error
+		if file, err = config.Discover("."); err != nil {
+			return err
+		}
+	}
+
+	conf = config.Default()
+	if file != "" {
+		c, err := config.Load(file)
+		if err != nil {
+			return err
+		}
+		conf = c
+		logger.Infof("Load configuration from [%s].", file)
+	}
+
+	if cmd.Flags().Changed("variables") || file == "" {
+		conf.Variables = global.Variables
+	}
+	// 命令行中的检测器选择覆盖配置文件中全局以及针对合约的选择。
+	if cmd.Flags().Changed("detectors") {
+		conf.Detectors.Enable = global.Detectors
+		for i := range conf.Contracts {
+			conf.Contracts[i].Detectors.Enable = nil
+		}
+	}
+	if cmd.Flags().Changed("exclude-detectors") {
+		conf.Detectors.Disable = global.ExcludeDetectors
+		for i := range conf.Contracts {
+			conf.Contracts[i].Detectors.Disable = nil
+		}
+	}
+	if cmd.Flags().Changed("rules") {
+		conf.Rules = global.Rules
+	}
+	if err := loadRules(conf.Rules); err != nil {
+		return err
+	}
+	if err := src.ValidateDetectorPatterns(append(append([]string{}, global.Detectors...), global.ExcludeDetectors...), knownDetectors()); err != nil {
+		return err
+	}
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+	// 各个命令的 --fail-on 共用 global.FailOn 但默认值不同，没有显式指定时使用当前命令的默认值。
+	if flag := cmd.Flags().Lookup("fail-on"); flag != nil && !flag.Changed {
+		global.FailOn = flag.DefValue
+	}
+	values := map[string]string{
+		"input":   conf.Input,
+		"output":  conf.Output.Dir,
+		"fail-on": conf.Output.FailOn,
+	}
+	if cmd == analyzeCmd || cmd == layoutCmd {
+		values["format"] = conf.Output.Format
+	}
+	if conf.Output.CallGraph {
+		values["call-graph"] = "true"
+	}
+	for name, value := range values {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed || value == "" {
+			continue
+		}
+		if err := flag.Value.Set(value); err != nil {
+			return fmt.Errorf("invalid value [%s] of [%s] in config file: [%v]", value, name, err)
+		}
+	}
+
+	return nil
+}
+
+// loadRules 加载自定义规则，规则的 id 不能与内置的检测器重复。
+func loadRules(files []string) error {
+	rules = nil
+	builtin := make(map[string]bool)
+	for _, info := range knownDetectors() {
+		builtin[info.ID] = true
+	}
+	ids := make(map[string]string)
+	for _, file := range files {
+		rs, err := rule.Load(file)
+		if err != nil {
+			return err
+		}
+		for _, r := range rs {
+			if builtin[r.ID] {
+				return fmt.Errorf("rule [%s] in [%s] conflicts with a builtin detector", r.ID, file)
 			}
-			sourceUnitNodes := source.Get("nodes")
-			if sourceUnitNodes.Size() < 1 {
-				fmt.Println("Invalid source file, there should be more than zero ast node in SourceUnit.")
-				os.Exit(1)
+			if previous, ok := ids[r.ID]; ok {
+				return fmt.Errorf("rule [%s] in [%s] is already defined in [%s]", r.ID, file, previous)
 			}
+			ids[r.ID] = file
+		}
+		rules = append(rules, rs...)
+		logger.Infof("Load [%d] rule(s) from [%s].", len(rs), file)
+	}
+	return nil
+}
 
-			var pragmaDirective jsoniter.Any
+// input 是所有子命令共享的输入。
+type input struct {
+	source      jsoniter.Any
+	solFileName string
+	version     float64
+}
 
-			for i := 0; i < sourceUnitNodes.Size(); i++ {
-				sourceUnitNode := sourceUnitNodes.Get(i)
-				if sourceUnitNode.Size() > 0 {
-					sourceUnitNodeNodeType := sourceUnitNode.Get("nodeType").ToString()
-					if sourceUnitNodeNodeType == "PragmaDirective" {
-						pragmaDirective = sourceUnitNode
-					}
-				}
+// loadInput 读取 --input 指定的语法树文件，并推断合约使用的 solidity 版本。
+func loadInput() (*input, error) {
+	jsonBytes, err := os.ReadFile(global.Input)
+	if err != nil {
+		return nil, src.Errorf(src.InputError, "failed to read [%s]: [%v]", global.Input, err)
+	}
+	source, err := src.Preload(jsoniter.Get(jsonBytes))
+	if err != nil {
+		return nil, src.Errorf(src.InputError, "failed to parse [%s]: [%v]", global.Input, err)
+	}
+	absolutePath := source.Get("absolutePath").ToString()
+
+	var solFileName string
+	absolutePaths := strings.Split(absolutePath, "/")
+	solFileName = absolutePaths[len(absolutePaths)-1]
+
+	sourceUnit := source.Get("nodeType")
+	if sourceUnit.ToString() != "SourceUnit" {
+		return nil, src.Errorf(src.InputError, "expected SourceUnit, but got [%s]", sourceUnit.ToString())
+	}
+	sourceUnitNodes := source.Get("nodes")
+	if sourceUnitNodes.Size() < 1 {
+		return nil, src.Errorf(src.InputError, "invalid source file, there should be more than zero ast node in SourceUnit")
+	}
+
+	version, err := parseVersion(sourceUnitNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &input{source: source, solFileName: solFileName, version: version}, nil
+}
+
+// analyze 根据 solidity 版本调用对应的检测与插桩逻辑，isCg 为 true 时在 dirName 下生成函数调用图。
+// 构建中间表示、过程间分析与自定义规则中出现的 panic 与 vXX.Run 一样被转换为分析错误。
+func analyze(in *input, isCg bool, dirName string) (node src.SourceCoder, findings []*src.Finding, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Failed to analyze [%s]: [%v].", in.solFileName, r)
+			node, findings, err = nil, nil, src.Errorf(src.AnalysisError, "failed to analyze [%s]: [%v]", in.solFileName, r)
+		}
+	}()
+
+	// 中间表示与过程间分析对每个文件只构建一次，插桩与基于中间表示的检测器共用。
+	reachability := analysis.New(ir.Build(in.source))
+	switch in.version {
+	case 0.7, 0.8:
+		node, findings, err = v08.Run(in.source, reachability, isCg, logger, in.solFileName, dirName, conf, global.Strict)
+	case 0.6:
+		node, findings, err = v06.Run(in.source, reachability, isCg, logger, in.solFileName, dirName, conf, global.Strict)
+	case 0.5:
+		node, findings, err = v05.Run(in.source, reachability, isCg, logger, in.solFileName, dirName, conf, global.Strict)
+	case 0.4:
+		node, findings, err = v04.Run(in.source, reachability, isCg, logger, in.solFileName, dirName, conf, global.Strict)
+	default:
+		return nil, nil, src.Errorf(src.UnsupportedVersionError, "solidity version [%.1f] is not supported", in.version)
+	}
+	if err != nil {
+		return nil, nil, src.WrapError(src.AnalysisError, err)
+	}
+
+	findings = append(findings, analysis.Run(reachability, conf)...)
+	findings = append(findings, rule.Run(rules, in.source, conf)...)
+
+	for _, finding := range findings {
+		logger.Warnf("Finding: %s", finding)
+	}
+	logger.Infof("Found [%d] finding(s) in [%s].", len(findings), in.solFileName)
+
+	return node, findings, nil
+}
+
+// failOn 根据 --fail-on 判断检测结果是否应当让命令以非零状态码退出。
+func failOn(findings []*src.Finding) error {
+	threshold, err := src.ParseSeverity(global.FailOn)
+	if err != nil {
+		return src.WrapError(src.InputError, err)
+	}
+	if src.ShouldFail(findings, threshold) {
+		return fmt.Errorf("%w: [%s]", src.ErrFindings, threshold)
+	}
+	return nil
+}
+
+// versionDir 返回 solidity 版本对应的源码目录名。
+func versionDir(version float64) (string, error) {
+	switch version {
+	case 0.7, 0.8:
+		return "v0.8", nil
+	case 0.6:
+		return "v0.6", nil
+	case 0.5:
+		return "v0.5", nil
+	case 0.4:
+		return "v0.4", nil
+	default:
+		return "", src.Errorf(src.UnsupportedVersionError, "solidity version [%.1f] is not supported", version)
+	}
+}
+
+// parseVersion 根据 PragmaDirective 推断合约使用的 solidity 版本。
+func parseVersion(sourceUnitNodes jsoniter.Any) (float64, error) {
+	var pragmaDirective jsoniter.Any
+
+	for i := 0; i < sourceUnitNodes.Size(); i++ {
+		sourceUnitNode := sourceUnitNodes.Get(i)
+		if sourceUnitNode.Size() > 0 {
+			sourceUnitNodeNodeType := sourceUnitNode.Get("nodeType").ToString()
+			if sourceUnitNodeNodeType == "PragmaDirective" {
+				pragmaDirective = sourceUnitNode
 			}
+		}
+	}
+
+	if pragmaDirective == nil {
+		return 0, src.Errorf(src.UnsupportedVersionError, "there is no pragma directive in SourceUnit")
+	}
 
-			literals := pragmaDirective.Get("literals").ToString()
-			literals_list := strings.Split(literals, ",")
-			var upper, lower, version float64
-			var err error
-			if strings.Contains(literals, ">=") || strings.Contains(literals, ">") || strings.Contains(literals, "<=") || strings.Contains(literals, "<") {
-				if strings.Contains(literals, ">=") {
-					for index, word := range literals_list {
-						word = src.Trim(word)
-						if word == ">=" {
-							lower_string := src.Trim(literals_list[index+1])
-							lower, err = strconv.ParseFloat(lower_string, 64)
-							if err != nil {
-								fmt.Printf("1: Failed parse solidity version: [%v], [%s].\n", err, lower_string)
-								os.Exit(1)
-							}
-							upper = lower
-						}
+	literals := pragmaDirective.Get("literals").ToString()
+	literals_list := strings.Split(literals, ",")
+	var upper, lower, version float64
+	var err error
+	if strings.Contains(literals, ">=") || strings.Contains(literals, ">") || strings.Contains(literals, "<=") || strings.Contains(literals, "<") {
+		if strings.Contains(literals, ">=") {
+			for index, word := range literals_list {
+				word = src.Trim(word)
+				if word == ">=" && index+1 < len(literals_list) {
+					lower_string := src.Trim(literals_list[index+1])
+					lower, err = strconv.ParseFloat(lower_string, 64)
+					if err != nil {
+						return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version [%s]: [%v]", lower_string, err)
 					}
+					upper = lower
 				}
-				if strings.Contains(literals, ">") {
-					for index, word := range literals_list {
-						word = src.Trim(word)
-						if word == ">" {
-							lower_string := src.Trim(literals_list[index+1])
-							lower, err = strconv.ParseFloat(lower_string, 64)
-							if err != nil {
-								fmt.Printf("2: Failed parse solidity version: [%v], [%s].\n", err, lower_string)
-								os.Exit(1)
-							}
-							lower += 0.1
-							upper = lower
-						}
+			}
+		}
+		if strings.Contains(literals, ">") {
+			for index, word := range literals_list {
+				word = src.Trim(word)
+				if word == ">" && index+1 < len(literals_list) {
+					lower_string := src.Trim(literals_list[index+1])
+					lower, err = strconv.ParseFloat(lower_string, 64)
+					if err != nil {
+						return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version [%s]: [%v]", lower_string, err)
 					}
+					lower += 0.1
+					upper = lower
 				}
-				if strings.Contains(literals, "<=") {
-					for index, word := range literals_list {
-						word = src.Trim(word)
-						if word == "<=" {
-							upper_string := src.Trim(literals_list[index+1])
-							upper, err = strconv.ParseFloat(upper_string, 64)
-							if err != nil {
-								fmt.Printf("3: Failed parse solidity version: [%v], [%s].\n", err, upper_string)
-								os.Exit(1)
-							}
-							if lower == 0.0 {
-								lower = upper
-							}
-						}
+			}
+		}
+		if strings.Contains(literals, "<=") {
+			for index, word := range literals_list {
+				word = src.Trim(word)
+				if word == "<=" && index+1 < len(literals_list) {
+					upper_string := src.Trim(literals_list[index+1])
+					upper, err = strconv.ParseFloat(upper_string, 64)
+					if err != nil {
+						return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version [%s]: [%v]", upper_string, err)
 					}
-				}
-				if strings.Contains(literals, "<") {
-					for index, word := range literals_list {
-						word = src.Trim(word)
-						if word == "<" {
-							upper_string := src.Trim(literals_list[index+1])
-							upper_string = src.Trim(upper_string)
-							upper, err = strconv.ParseFloat(upper_string, 64)
-							if err != nil {
-								fmt.Printf("4: Failed parse solidity version: [%v], [%s].\n", err, upper_string)
-								os.Exit(1)
-							}
-							upper -= 0.1
-							if lower == 0.0 {
-								lower = upper
-							}
-						}
+					if lower == 0.0 {
+						lower = upper
 					}
 				}
-			} else {
-				reNum := regexp.MustCompile(`0\.\d*`)
-				for _, word := range literals_list {
-					if reNum.Match([]byte(word)) {
-						word = src.Trim(word)
-						version, err = strconv.ParseFloat(word, 64)
-						if err != nil {
-							fmt.Printf("5: Failed parse solidity version: [%v], [%s].\n", err, word)
-							os.Exit(1)
-						}
+			}
+		}
+		if strings.Contains(literals, "<") {
+			for index, word := range literals_list {
+				word = src.Trim(word)
+				if word == "<" && index+1 < len(literals_list) {
+					upper_string := src.Trim(literals_list[index+1])
+					upper, err = strconv.ParseFloat(upper_string, 64)
+					if err != nil {
+						return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version [%s]: [%v]", upper_string, err)
+					}
+					upper -= 0.1
+					if lower == 0.0 {
+						lower = upper
 					}
 				}
 			}
-
-			if version == 0.0 {
-				if upper < lower {
-					fmt.Printf("6: Failed parse solidity version: [upper(%.1f) < lower(%.1f)].\n", upper, lower)
-					os.Exit(1)
+		}
+	} else {
+		reNum := regexp.MustCompile(`0\.\d*`)
+		for _, word := range literals_list {
+			if reNum.Match([]byte(word)) {
+				word = src.Trim(word)
+				version, err = strconv.ParseFloat(word, 64)
+				if err != nil {
+					return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version [%s]: [%v]", word, err)
 				}
-				version = lower
-			}
-
-			if err = src.EnsureDir(fmt.Sprintf("%s%s", global.Output, relativePath)); err != nil {
-				fmt.Fprintln(os.Stderr, err)
-				os.Exit(1)
 			}
+		}
+	}
 
-			switch version {
-			case 0.7, 0.8:
-				node, err := v08.Run(jsonBytes, global.Cg, logger, solFileName, global.Output, global.Variables)
-				if err != nil {
-					os.Exit(1)
-				}
-				code := node.SourceCode(false, false, "", logger)
-				f, err := os.OpenFile(fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666)
-				if err != nil {
-					fmt.Printf("Failed to open %s: [%v].\n", fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), err)
-					os.Exit(1)
-				}
+	if version == 0.0 {
+		if upper < lower {
+			return 0, src.Errorf(src.UnsupportedVersionError, "failed to parse solidity version: [upper(%.1f) < lower(%.1f)]", upper, lower)
+		}
+		version = lower
+	}
 
-				f.Write([]byte(code))
+	return version, nil
+}
 
-				f.Close()
-			case 0.6:
-				node, err := v06.Run(jsonBytes, global.Cg, logger, solFileName, global.Output, global.Variables)
-				if err != nil {
-					os.Exit(1)
-				}
-				code := node.SourceCode(false, false, "", logger)
-				f, err := os.OpenFile(fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666)
-				if err != nil {
-					fmt.Printf("Failed to open %s: [%v].\n", fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), err)
-					os.Exit(1)
-				}
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&global.Variables, "variables", []string{"owner", "_owner", "owner_"}, "Variables to store permission information, default is [owner]")
+	rootCmd.PersistentFlags().StringVar(&global.ConfigFile, "config", "", "Path to the config file, default is the first .tguard.yaml found from the current directory upwards.")
+	rootCmd.PersistentFlags().StringVar(&global.Input, "input", "contracts/v0.8/1.sol_json.ast", "Path to the abstract syntax tree file of the smart contract to be analyzed")
+	rootCmd.PersistentFlags().StringVar(&global.LogLevel, "log-level", "info", "Minimum level of the logs to print, one of debug, info, warn and error.")
+	rootCmd.PersistentFlags().StringVar(&global.LogFormat, "log-format", "text", "Format of the logs, json or text.")
+	rootCmd.PersistentFlags().StringVar(&global.LogFile, "log-file", "", "Path to the file where the logs are appended, default is stderr.")
+	rootCmd.PersistentFlags().BoolVar(&global.Quiet, "quiet", false, "Whether to print only error logs, default is false.")
+	rootCmd.PersistentFlags().BoolVar(&global.Strict, "strict", false, "Whether to fail instead of skipping unknown or unsupported ast nodes, default is false.")
+	rootCmd.PersistentFlags().StringSliceVar(&global.Detectors, "detectors", nil, "Ids of the detectors to run, wildcards are supported, default is all detectors.")
+	rootCmd.PersistentFlags().StringSliceVar(&global.ExcludeDetectors, "exclude-detectors", nil, "Ids of the detectors not to run, wildcards are supported.")
+	rootCmd.PersistentFlags().StringSliceVar(&global.Rules, "rules", nil, "Paths to the yaml files of custom rules, whose matches are reported as findings.")
+	rootCmd.MarkPersistentFlagFilename("config", "yaml", "yml")
+	rootCmd.MarkPersistentFlagFilename("rules", "yaml", "yml")
+	rootCmd.MarkPersistentFlagFilename("input", "ast", "json")
+	rootCmd.MarkPersistentFlagFilename("log-file")
+	rootCmd.RegisterFlagCompletionFunc("log-level", fixedCompletion("debug", "info", "warn", "error"))
+	rootCmd.RegisterFlagCompletionFunc("log-format", fixedCompletion("text", "json"))
+	rootCmd.RegisterFlagCompletionFunc("detectors", detectorCompletion)
+	rootCmd.RegisterFlagCompletionFunc("exclude-detectors", detectorCompletion)
 
-				f.Write([]byte(code))
+	// 不带子命令时保持原来的行为：检测、插桩，并按需生成函数调用图。
+	addOutputFlag(rootCmd)
+	addFailOnFlag(rootCmd, "none")
+	rootCmd.Flags().BoolVar(&global.Cg, "call-graph", false, "Whether to generate a function call relationship graph within the contract, default is false.")
 
-				f.Close()
-			case 0.5:
-				node, err := v05.Run(jsonBytes, global.Cg, logger, solFileName, global.Output, global.Variables)
-				if err != nil {
-					os.Exit(1)
-				}
-				code := node.SourceCode(false, false, "", logger)
-				f, err := os.OpenFile(fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666)
-				if err != nil {
-					fmt.Printf("Failed to open %s: [%v].\n", fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), err)
-					os.Exit(1)
-				}
+	rootCmd.AddCommand(analyzeCmd, patchCmd, graphCmd, astCmd, layoutCmd, irCmd, detectorsCmd)
+}
 
-				f.Write([]byte(code))
+func addOutputFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&global.Output, "output", "test", "The path to the folder where the analysis results are stored.")
+	cmd.MarkFlagDirname("output")
+}
 
-				f.Close()
-			case 0.4:
-				node, err := v04.Run(jsonBytes, global.Cg, logger, solFileName, global.Output, global.Variables)
-				if err != nil {
-					os.Exit(1)
-				}
-				code := node.SourceCode(false, false, "", logger)
-				f, err := os.OpenFile(fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666)
-				if err != nil {
-					fmt.Printf("Failed to open %s: [%v].\n", fmt.Sprintf("%s%s/%s", global.Output, relativePath, solFileName), err)
-					os.Exit(1)
-				}
+// checkOutput 检查 --output 不为空，输出的路径都由它拼接而成，为空时会写到文件系统的根目录下。
+func checkOutput() error {
+	if global.Output == "" {
+		return src.Errorf(src.InputError, "--output must not be empty")
+	}
+	return nil
+}
 
-				f.Write([]byte(code))
+// addFailOnFlag 添加 --fail-on，patch 与不带子命令时默认为 none，保持原来插桩成功即返回 0 的行为。
+func addFailOnFlag(cmd *cobra.Command, value string) {
+	cmd.Flags().StringVar(&global.FailOn, "fail-on", value, "Exit with a non-zero code when there are findings at or above this severity, one of none, info, low, medium and high.")
+	cmd.RegisterFlagCompletionFunc("fail-on", fixedCompletion("none", "info", "low", "medium", "high"))
+}
 
-				f.Close()
-			default:
-				fmt.Println(version)
-			}
-		},
+func fixedCompletion(values ...string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveNoFileComp
 	}
-)
-
-func init() {
-	rootCmd.PersistentFlags().StringSliceVar(&global.Variables, "variables", []string{"owner", "_owner", "owner_"}, "Variables to store permission information, default is [owner]")
-	rootCmd.PersistentFlags().StringVar(&global.Input, "input", "contracts/v0.8/1.sol_json.ast", "Path to the abstract syntax tree file of the smart contract to be analyzed")
-	rootCmd.PersistentFlags().StringVar(&global.Output, "output", "test", "The path to the folder where the analysis results are stored.")
-	rootCmd.PersistentFlags().BoolVar(&global.Cg, "call-graph", false, "Whether to generate a function call relationship graph within the contract, default is false.")
 }
 
 func execute() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(src.ExitCode(err))
 	}
 }
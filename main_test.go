@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/global"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+)
+
+func TestLoadInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		version float64
+		kind    src.ErrorKind
+	}{
+		{"pragma", `{"nodeType":"SourceUnit","absolutePath":"a/b.sol","nodes":[{"nodeType":"PragmaDirective","literals":["solidity","^","0.8",".17"]}]}`, 0.8, 0},
+		{"object nodes", `{"nodeType":"SourceUnit","nodes":{"":""}}`, 0, src.UnsupportedVersionError},
+		{"string nodes", `{"nodeType":"SourceUnit","nodes":["",1]}`, 0, src.UnsupportedVersionError},
+		{"not a source unit", `{"nodeType":"Block","nodes":[]}`, 0, src.InputError},
+		{"not json", `{"nodeType"`, 0, src.InputError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			global.Input = filepath.Join(t.TempDir(), "input.json")
+			if err := os.WriteFile(global.Input, []byte(test.input), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			in, err := loadInput()
+			if test.kind != 0 {
+				var e *src.Error
+				if !errors.As(err, &e) || e.Kind != test.kind {
+					t.Fatalf("expected a [%v] error, got [%v]", test.kind, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if in.version != test.version || in.solFileName != "b.sol" {
+				t.Fatalf("expected version [%v] of [b.sol], got [%v] of [%s]", test.version, in.version, in.solFileName)
+			}
+		})
+	}
+}
+
+// TestAnalyzePanic 检查在 vXX.Run 之外构建中间表示时出现的 panic 被转换为分析错误，而不是让命令崩溃。
+func TestAnalyzePanic(t *testing.T) {
+	logger, conf = src.SilentLogger, config.Default()
+
+	// source 为空时 ir.Build 访问语法树会 panic。
+	_, _, err := analyze(&input{solFileName: "b.sol", version: 0.8}, false, t.TempDir())
+	var e *src.Error
+	if !errors.As(err, &e) || e.Kind != src.AnalysisError {
+		t.Fatalf("expected an [%v] error, got [%v]", src.AnalysisError, err)
+	}
+}
+
+// TestPatchOutput 检查 --output 为空时返回输入错误，带有结尾分隔符的目录与不带的一样写入 <output>/contracts。
+func TestPatchOutput(t *testing.T) {
+	logger, conf = src.SilentLogger, config.Default()
+	global.Input = filepath.Join("contracts", "v0.8", "13.sol_json.ast")
+	defer func() { global.Output = "test" }()
+
+	global.Output = ""
+	var e *src.Error
+	if err := patch(false); !errors.As(err, &e) || e.Kind != src.InputError {
+		t.Fatalf("expected an [%v] error, got [%v]", src.InputError, err)
+	}
+
+	dir := t.TempDir()
+	global.Output = dir + string(filepath.Separator)
+	if err := patch(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "contracts", "13.sol")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// FuzzLoadInput 检查任意输入只会让 loadInput 返回带类型的错误，不会 panic。
+func FuzzLoadInput(f *testing.F) {
+	f.Add([]byte(`{"nodeType":"SourceUnit","absolutePath":"a/b.sol","nodes":[{"nodeType":"PragmaDirective","literals":["solidity","^","0.8",".17"]}]}`))
+	f.Add([]byte(`{"nodeType":"SourceUnit","absolutePath":"b.sol","nodes":[{"nodeType":"PragmaDirective","literals":["solidity",">=","0.4",".22","<","0.6",".0"]}]}`))
+	f.Add([]byte(`{"nodeType":"SourceUnit","absolutePath":"b.sol","nodes":[{"nodeType":"PragmaDirective","literals":["solidity",">","0.5",".0","<=","0.7",".0"]}]}`))
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":[{"nodeType":"PragmaDirective","literals":"0.x"}]}`))
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":{"":""}}`))
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":["",1]}`))
+
+	global.Input = filepath.Join(f.TempDir(), "input.json")
+	f.Fuzz(func(t *testing.T, jsonBytes []byte) {
+		if err := os.WriteFile(global.Input, jsonBytes, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := loadInput(); err != nil {
+			var e *src.Error
+			if !errors.As(err, &e) {
+				t.Fatalf("expected a typed error, got [%v]", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/geistwelt/taintguard/global"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/spf13/cobra"
+)
+
+var patchCmd = &cobra.Command{
+	Use:   "patch",
+	Short: "Instrument the contract and write the patched source code to the output folder.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return patch(false)
+	},
+}
+
+func init() {
+	addOutputFlag(patchCmd)
+	addFailOnFlag(patchCmd, "none")
+}
+
+// patch 检测并插桩，将插桩后的合约写入 <output>/contracts 目录。
+func patch(isCg bool) error {
+	if err := checkOutput(); err != nil {
+		return err
+	}
+	in, err := loadInput()
+	if err != nil {
+		return err
+	}
+
+	outputDir := filepath.Join(global.Output, "contracts")
+	if err = src.EnsureDir(outputDir); err != nil {
+		return src.WrapError(src.InstrumentationError, err)
+	}
+
+	node, findings, err := analyze(in, isCg, global.Output)
+	if err != nil {
+		return err
+	}
+
+	code, err := src.PrintSourceCode(node, global.Strict, logger)
+	if err != nil {
+		return err
+	}
+	outputFile := filepath.Join(outputDir, in.solFileName)
+	if err = os.WriteFile(outputFile, []byte(code), 0666); err != nil {
+		return src.Errorf(src.InstrumentationError, "failed to write [%s]: [%v]", outputFile, err)
+	}
+	logger.Infof("Successfully write the patched contract to [%s].", outputFile)
+
+	return failOn(findings)
+}
@@ -0,0 +1,356 @@
+// Package analysis 在 ir 的基础上做与 solidity 版本无关的过程间分析：函数之间的调用关系、外部入口、
+// 对调用者身份的检查，以及 delegatecall 目标地址的可能取值。
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/types"
+)
+
+// maxDepth 是过程间追踪的最大调用深度。
+const maxDepth = 8
+
+// Analysis 保存一个 Program 上的调用关系以及分析过程中的缓存。
+type Analysis struct {
+	Program *ir.Program
+
+	functions []*ir.Function
+	callers   map[int][]*ir.Instr
+	checks    map[string]bool
+	storages  map[string]*Source
+	graphs    map[string]*graph
+}
+
+// New 建立 p 中函数与修饰器之间的调用关系。
+func New(p *ir.Program) *Analysis {
+	a := &Analysis{Program: p, callers: make(map[int][]*ir.Instr), checks: make(map[string]bool), storages: make(map[string]*Source), graphs: make(map[string]*graph)}
+	for _, c := range p.Contracts {
+		for _, f := range c.Functions {
+			a.functions = append(a.functions, f)
+			for _, in := range f.Instrs() {
+				if (in.Op == ir.OpCall || in.Op == ir.OpModifier) && in.Callee != 0 {
+					a.callers[in.Callee] = append(a.callers[in.Callee], in)
+				}
+			}
+		}
+	}
+	return a
+}
+
+// Functions 按照合约与声明的顺序返回所有有函数体的函数与修饰器。
+func (a *Analysis) Functions() []*ir.Function {
+	return a.functions
+}
+
+// Callers 返回调用 f 的 OpCall 或 OpModifier 指令。
+func (a *Analysis) Callers(f *ir.Function) []*ir.Instr {
+	return a.callers[f.ID]
+}
+
+// IsEntry 判断 f 是否可以被外部直接调用：public、external 函数以及 fallback、receive；构造函数单独处理。
+func IsEntry(f *ir.Function) bool {
+	switch f.Kind {
+	case "fallback", "receive":
+		return true
+	case "constructor", "modifier":
+		return false
+	}
+	return f.Visibility == "public" || f.Visibility == "external"
+}
+
+// Entry 是从一个入口到某个函数的调用链。
+type Entry struct {
+	Function *ir.Function   // 入口函数，构造函数也作为入口
+	Path     []*ir.Function // 从入口到目标函数的调用链，包括两端
+	Guarded  bool           // 调用链上的某个函数或修饰器检查了调用者的身份
+	Guards   []string       // 调用链上检查调用者身份的修饰器、函数或者语句
+}
+
+func (e *Entry) String() string {
+	names := make([]string, len(e.Path))
+	for i, f := range e.Path {
+		names[i] = f.Contract + "." + f.Name
+	}
+	state := "unguarded"
+	switch {
+	case e.Function.Kind == "constructor":
+		state = "constructor"
+	case e.Guarded && len(e.Guards) > 0:
+		state = "guarded by " + strings.Join(e.Guards, ", ")
+	case e.Guarded:
+		state = "guarded"
+	}
+	return fmt.Sprintf("%s (%s)", strings.Join(names, " -> "), state)
+}
+
+// Entries 返回所有能够到达 f 的入口，每个入口只保留一条调用链，存在没有权限检查的调用链时优先保留它。
+// 入口在 f 返回之前一定通过了对调用者身份的检查时为 guarded。
+func (a *Analysis) Entries(f *ir.Function) []*Entry {
+	return a.EntriesAt(f, nil)
+}
+
+// EntriesAt 返回所有能够到达 f 中的指令 in 的入口，in 为 nil 时与 Entries 相同。调用链上的某一层中，
+// in（或者调用下一层的指令）被对调用者身份的检查支配时，该入口为 guarded。
+func (a *Analysis) EntriesAt(f *ir.Function, in *ir.Instr) []*Entry {
+	type state struct {
+		function *ir.Function
+		guarded  bool
+	}
+	parent := make(map[state]*state)
+	guards := make(map[state][]string)
+	start := state{f, false}
+	if guard := a.intra(f, in); guard.State == Guarded {
+		start.guarded = true
+		guards[start] = guard.Guards
+	}
+	visited := map[state]bool{start: true}
+	queue := []state{start}
+	entries := make(map[*ir.Function]*Entry)
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		if IsEntry(s.function) || s.function.Kind == "constructor" {
+			if e, ok := entries[s.function]; !ok || e.Guarded && !s.guarded {
+				entry := &Entry{Function: s.function, Guarded: s.guarded}
+				for p := &s; p != nil; p = parent[*p] {
+					entry.Path = append(entry.Path, p.function)
+					entry.Guards = append(entry.Guards, guards[*p]...)
+				}
+				entry.Guards = unique(entry.Guards)
+				entries[s.function] = entry
+			}
+		}
+		for _, call := range a.callers[s.function.ID] {
+			caller := call.Block.Function
+			next := state{caller, s.guarded}
+			guard := a.intra(caller, call)
+			if guard.State == Guarded {
+				next.guarded = true
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			if guard.State == Guarded {
+				guards[next] = guard.Guards
+			}
+			current := s
+			parent[next] = &current
+			queue = append(queue, next)
+		}
+	}
+
+	list := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Function.Signature < list[j].Function.Signature })
+	return list
+}
+
+// EntriesOf 返回能够到达 id 对应的函数的入口，函数不存在或者没有函数体时返回 nil。
+func (a *Analysis) EntriesOf(id int) []*Entry {
+	f := a.Program.Function(id)
+	if f == nil {
+		return nil
+	}
+	return a.Entries(f)
+}
+
+// Annotate 在检测结果中记录能够到达它的入口与沿途的权限检查；没有任何入口时将其标记为不可达，并把严重程度降为 info。
+func Annotate(finding *src.Finding, entries []*Entry) {
+	if len(entries) == 0 {
+		finding.Unreachable = true
+		finding.Severity = src.SeverityInfo
+		return
+	}
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		finding.Entries = append(finding.Entries, e.String())
+		for _, guard := range e.Guards {
+			if !seen[guard] {
+				seen[guard] = true
+				finding.Guards = append(finding.Guards, guard)
+			}
+		}
+	}
+}
+
+// Checks 判断 f（包括它的修饰器以及它调用的函数）是否根据调用者的身份决定是否继续执行，
+// 如 require(msg.sender == owner)、onlyOwner、_checkRole(role, msg.sender)。
+func (a *Analysis) Checks(f *ir.Function) bool {
+	return a.checksWith(f, nil, 0)
+}
+
+// checksWith 在 f 的第 i 个参数来自调用者（senders[i] 为 true）的前提下判断 f 是否检查调用者的身份。
+func (a *Analysis) checksWith(f *ir.Function, senders []bool, depth int) bool {
+	key := fmt.Sprintf("%d%v", f.ID, senders)
+	if result, ok := a.checks[key]; ok || depth > maxDepth {
+		return result
+	}
+	// 递归调用时先认为没有检查。
+	a.checks[key] = false
+	result := false
+	for _, in := range f.Instrs() {
+		switch in.Op {
+		case ir.OpBranch:
+			result = a.isCheck(in.Args[0], senders, depth, make(map[*ir.Value]bool))
+		case ir.OpCall, ir.OpModifier:
+			callee := a.Program.Function(in.Callee)
+			if callee == nil {
+				continue
+			}
+			args := make([]bool, len(in.Args))
+			for i, arg := range in.Args {
+				args[i] = a.isSender(arg, senders, depth, make(map[*ir.Value]bool))
+			}
+			result = a.checksWith(callee, args, depth+1)
+		}
+		if result {
+			break
+		}
+	}
+	a.checks[key] = result
+	return result
+}
+
+// isSender 判断 v 是否就是调用者的地址：msg.sender、tx.origin、来自调用者的参数，或者返回它们的函数（如 _msgSender()）。
+func (a *Analysis) isSender(v *ir.Value, senders []bool, depth int, visited map[*ir.Value]bool) bool {
+	if v == nil || v.Def == nil || visited[v] {
+		return false
+	}
+	visited[v] = true
+	in := v.Def
+	switch in.Op {
+	case ir.OpEnv:
+		return in.Name == "msg.sender" || in.Name == "tx.origin"
+	case ir.OpParam:
+		return in.Index < len(senders) && senders[in.Index]
+	case ir.OpConvert:
+		return a.isSender(in.Args[0], senders, depth, visited)
+	case ir.OpPhi:
+		for _, arg := range in.Args {
+			if !a.isSender(arg, senders, depth, visited) {
+				return false
+			}
+		}
+		return true
+	case ir.OpCall:
+		callee := a.Program.Function(in.Callee)
+		if callee == nil || depth > maxDepth {
+			return false
+		}
+		args := make([]bool, len(in.Args))
+		for i, arg := range in.Args {
+			args[i] = a.isSender(arg, senders, depth, make(map[*ir.Value]bool))
+		}
+		returns := false
+		for _, r := range callee.Instrs() {
+			if r.Op != ir.OpReturn || len(r.Args) == 0 {
+				continue
+			}
+			if !a.isSender(r.Args[0], args, depth+1, make(map[*ir.Value]bool)) {
+				return false
+			}
+			returns = true
+		}
+		return returns
+	}
+	return false
+}
+
+// isCheck 判断条件 v 是否是对调用者身份的检查：调用者与某个地址比较是否相等、以调用者为键读取的 bool 值，
+// 或者返回这类检查结果的内部函数调用（如 hasRole(role, msg.sender)）。
+func (a *Analysis) isCheck(v *ir.Value, senders []bool, depth int, visited map[*ir.Value]bool) bool {
+	if v == nil || v.Def == nil || visited[v] {
+		return false
+	}
+	visited[v] = true
+	in := v.Def
+	switch in.Op {
+	case ir.OpBinary:
+		switch in.Name {
+		case "==", "!=":
+			left := a.isSender(in.Args[0], senders, depth, make(map[*ir.Value]bool)) || a.senderKeyed(in.Args[0], senders, depth)
+			right := a.isSender(in.Args[1], senders, depth, make(map[*ir.Value]bool)) || a.senderKeyed(in.Args[1], senders, depth)
+			if left == right {
+				return false
+			}
+			other := in.Args[1]
+			if right {
+				other = in.Args[0]
+			}
+			return isPrivileged(other, make(map[*ir.Value]bool))
+		case "&&", "||":
+			return a.isCheck(in.Args[0], senders, depth, visited) || a.isCheck(in.Args[1], senders, depth, visited)
+		}
+	case ir.OpUnary:
+		return in.Name == "!" && a.isCheck(in.Args[0], senders, depth, visited)
+	case ir.OpPhi:
+		for _, arg := range in.Args {
+			if a.isCheck(arg, senders, depth, visited) {
+				return true
+			}
+		}
+	case ir.OpSLoad:
+		return v.Type != nil && v.Type.Category == types.Bool && a.senderKeyed(v, senders, depth)
+	case ir.OpCall:
+		callee := a.Program.Function(in.Callee)
+		if callee == nil || depth > maxDepth {
+			return false
+		}
+		args := make([]bool, len(in.Args))
+		for i, arg := range in.Args {
+			args[i] = a.isSender(arg, senders, depth, make(map[*ir.Value]bool))
+		}
+		for _, r := range callee.Instrs() {
+			if r.Op == ir.OpReturn && len(r.Args) > 0 && a.isCheck(r.Args[0], args, depth+1, make(map[*ir.Value]bool)) {
+				return true
+			}
+		}
+		return a.checksWith(callee, args, depth+1)
+	}
+	return false
+}
+
+// senderKeyed 判断 v 是否是以调用者为键（直接或者间接）从 storage 中读取的值，如 admins[msg.sender]、
+// roles[operators[msg.sender].role].privileges[sig]。
+func (a *Analysis) senderKeyed(v *ir.Value, senders []bool, depth int) bool {
+	if v == nil || v.Def == nil || v.Def.Op != ir.OpSLoad {
+		return false
+	}
+	for _, access := range v.Def.Path {
+		if access.Index != nil && (a.isSender(access.Index, senders, depth, make(map[*ir.Value]bool)) || a.senderKeyed(access.Index, senders, depth)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivileged 判断与调用者比较的另一方是否来自合约自身的状态（storage、constant、immutable、常量或者调用结果），
+// 而不是调用者传入的参数，后者如 require(to != msg.sender) 并不是权限检查。
+func isPrivileged(v *ir.Value, visited map[*ir.Value]bool) bool {
+	if v == nil || v.Def == nil {
+		return false
+	}
+	if visited[v] {
+		return true
+	}
+	visited[v] = true
+	switch v.Def.Op {
+	case ir.OpParam, ir.OpBinary, ir.OpUnary, ir.OpMLoad, ir.OpCalldataLoad:
+		return false
+	case ir.OpConvert, ir.OpPhi:
+		for _, arg := range v.Def.Args {
+			if !isPrivileged(arg, visited) {
+				return false
+			}
+		}
+	}
+	return true
+}
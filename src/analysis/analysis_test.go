@@ -0,0 +1,362 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/layout"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 以下函数用来手写测试用的语法树。
+func identifier(name string, id int, typ string) map[string]interface{} {
+	return map[string]interface{}{"nodeType": "Identifier", "name": name, "referencedDeclaration": id, "typeDescriptions": map[string]interface{}{"typeIdentifier": typ}}
+}
+
+func sender() map[string]interface{} {
+	return map[string]interface{}{"nodeType": "MemberAccess", "memberName": "sender", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_address"},
+		"expression": identifier("msg", -15, "t_magic_message")}
+}
+
+func assign(left map[string]interface{}, right map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "Assignment", "operator": "=", "leftHandSide": left, "rightHandSide": right}}
+}
+
+func delegatecall(target map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{
+		"nodeType": "FunctionCall", "kind": "functionCall", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_tuple$_t_bool_$_t_bytes_memory_ptr_$"},
+		"expression": map[string]interface{}{"nodeType": "MemberAccess", "memberName": "delegatecall", "expression": target,
+			"typeDescriptions": map[string]interface{}{"typeIdentifier": "t_function_baredelegatecall_nonpayable$_t_bytes_memory_ptr_$returns$_t_bool_$_t_bytes_memory_ptr_$"}},
+		"arguments": []interface{}{map[string]interface{}{"nodeType": "MemberAccess", "memberName": "data", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_bytes_calldata_ptr"},
+			"expression": identifier("msg", -15, "t_magic_message")}}}}
+}
+
+// requireOwner 对应 require(msg.sender == owner)，owner 的声明 id 为 2。
+func requireOwner() map[string]interface{} {
+	return map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall",
+		"expression": identifier("require", -18, "t_function_require_pure$_t_bool_$returns$__$"),
+		"arguments": []interface{}{map[string]interface{}{"nodeType": "BinaryOperation", "operator": "==", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_bool"},
+			"leftExpression": sender(), "rightExpression": identifier("owner", 2, "t_address")}}}}
+}
+
+func function(id int, name string, visibility string, params []interface{}, modifiers []interface{}, statements ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"nodeType": "FunctionDefinition", "id": id, "name": name, "kind": "function", "visibility": visibility,
+		"parameters": map[string]interface{}{"parameters": params}, "returnParameters": map[string]interface{}{"parameters": []interface{}{}},
+		"modifiers": modifiers, "body": map[string]interface{}{"nodeType": "Block", "statements": statements}}
+}
+
+func parameter(id int, name string) map[string]interface{} {
+	return map[string]interface{}{"nodeType": "VariableDeclaration", "id": id, "name": name,
+		"typeName": map[string]interface{}{"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_address"}}
+}
+
+// 对应的源码：
+//
+//	contract Proxy {
+//	    address owner;
+//	    address implementation;
+//	    address constant LIBRARY = 0x00000000000000000000000000000000000000aa;
+//	    modifier onlyOwner() { require(msg.sender == owner); _; }
+//	    function upgrade(address impl) public onlyOwner { _setImplementation(impl); }
+//	    function _setImplementation(address impl) internal { implementation = impl; }
+//	    function claim(address newOwner) public { owner = newOwner; }
+//	    function forward() public { implementation.delegatecall(msg.data); }
+//	    function forwardOwner() public { owner.delegatecall(msg.data); }
+//	    function forwardLibrary() public { LIBRARY.delegatecall(msg.data); }
+//	    function exec(address to) public { _exec(to); }
+//	    function _exec(address to) internal { to.delegatecall(msg.data); }
+//	    function forwardSlot() public { assembly { let ok := delegatecall(gas(), sload(0x01), 0, 0, 0, 0) } }
+//	    function setSlot(address impl) public { assembly { sstore(0x01, impl) } }
+//	    function _unused() internal { implementation.delegatecall(msg.data); }
+//	    function partial(bool flag) public { if (flag) { require(msg.sender == owner); } implementation.delegatecall(msg.data); }
+//	    function inline() public { if (msg.sender != owner) { revert(); } implementation.delegatecall(msg.data); }
+//	    function admin() public onlyOwner { _admin(); }
+//	    function _admin() internal { implementation.delegatecall(msg.data); }
+//	}
+func proxy() jsoniter.Any {
+	address := "t_address"
+	return jsoniter.Wrap(map[string]interface{}{"nodeType": "SourceUnit", "nodes": []interface{}{map[string]interface{}{
+		"nodeType": "ContractDefinition", "id": 1, "name": "Proxy", "contractKind": "contract", "linearizedBaseContracts": []interface{}{1},
+		"nodes": []interface{}{
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 2, "name": "owner", "stateVariable": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": address}},
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 3, "name": "implementation", "stateVariable": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": address}},
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 4, "name": "LIBRARY", "stateVariable": true, "constant": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": address}},
+			map[string]interface{}{"nodeType": "ModifierDefinition", "id": 5, "name": "onlyOwner", "parameters": map[string]interface{}{"parameters": []interface{}{}},
+				"body": map[string]interface{}{"nodeType": "Block", "statements": []interface{}{
+					map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall",
+						"expression": identifier("require", -18, "t_function_require_pure$_t_bool_$returns$__$"),
+						"arguments": []interface{}{map[string]interface{}{"nodeType": "BinaryOperation", "operator": "==", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_bool"},
+							"leftExpression": sender(), "rightExpression": identifier("owner", 2, address)}}}},
+					map[string]interface{}{"nodeType": "PlaceholderStatement"}}}},
+			function(6, "upgrade", "public", []interface{}{parameter(7, "impl")},
+				[]interface{}{map[string]interface{}{"nodeType": "ModifierInvocation", "modifierName": map[string]interface{}{"name": "onlyOwner", "referencedDeclaration": 5}}},
+				map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall",
+					"expression": identifier("_setImplementation", 8, "t_function_internal_nonpayable$_t_address_$returns$__$"),
+					"arguments":  []interface{}{identifier("impl", 7, address)}}}),
+			function(8, "_setImplementation", "internal", []interface{}{parameter(9, "impl")}, nil, assign(identifier("implementation", 3, address), identifier("impl", 9, address))),
+			function(10, "claim", "public", []interface{}{parameter(11, "newOwner")}, nil, assign(identifier("owner", 2, address), identifier("newOwner", 11, address))),
+			function(12, "forward", "public", nil, nil, delegatecall(identifier("implementation", 3, address))),
+			function(13, "forwardOwner", "public", nil, nil, delegatecall(identifier("owner", 2, address))),
+			function(14, "forwardLibrary", "public", nil, nil, delegatecall(identifier("LIBRARY", 4, address))),
+			function(15, "exec", "public", []interface{}{parameter(16, "to")}, nil,
+				map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall",
+					"expression": identifier("_exec", 17, "t_function_internal_nonpayable$_t_address_$returns$__$"),
+					"arguments":  []interface{}{identifier("to", 16, address)}}}),
+			function(17, "_exec", "internal", []interface{}{parameter(18, "to")}, nil, delegatecall(identifier("to", 18, address))),
+			function(19, "forwardSlot", "public", nil, nil, map[string]interface{}{"nodeType": "InlineAssembly", "externalReferences": []interface{}{},
+				"operations": "{ let ok := delegatecall(gas(), sload(0x01), 0, 0, 0, 0) }"}),
+			function(20, "setSlot", "public", []interface{}{parameter(21, "impl")}, nil, map[string]interface{}{"nodeType": "InlineAssembly",
+				"externalReferences": []interface{}{map[string]interface{}{"impl": map[string]interface{}{"declaration": 21, "isOffset": false, "isSlot": false}}},
+				"operations":         "{ sstore(0x01, impl) }"}),
+			function(22, "_unused", "internal", nil, nil, delegatecall(identifier("implementation", 3, address))),
+			function(23, "partial", "public", []interface{}{parameter(24, "flag")}, nil,
+				map[string]interface{}{"nodeType": "IfStatement", "condition": identifier("flag", 24, "t_bool"),
+					"trueBody": map[string]interface{}{"nodeType": "Block", "statements": []interface{}{requireOwner()}}},
+				delegatecall(identifier("implementation", 3, address))),
+			function(25, "inline", "public", nil, nil,
+				map[string]interface{}{"nodeType": "IfStatement", "condition": map[string]interface{}{"nodeType": "BinaryOperation", "operator": "!=",
+					"typeDescriptions": map[string]interface{}{"typeIdentifier": "t_bool"}, "leftExpression": sender(), "rightExpression": identifier("owner", 2, address)},
+					"trueBody": map[string]interface{}{"nodeType": "Block", "statements": []interface{}{map[string]interface{}{"nodeType": "ExpressionStatement",
+						"expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall", "arguments": []interface{}{},
+							"expression": identifier("revert", -19, "t_function_revert_pure$__$returns$__$")}}}}},
+				delegatecall(identifier("implementation", 3, address))),
+			function(26, "admin", "public", nil,
+				[]interface{}{map[string]interface{}{"nodeType": "ModifierInvocation", "modifierName": map[string]interface{}{"name": "onlyOwner", "referencedDeclaration": 5}}},
+				map[string]interface{}{"nodeType": "ExpressionStatement", "expression": map[string]interface{}{"nodeType": "FunctionCall", "kind": "functionCall",
+					"expression": identifier("_admin", 27, "t_function_internal_nonpayable$__$returns$__$"), "arguments": []interface{}{}}}),
+			function(27, "_admin", "internal", nil, nil, delegatecall(identifier("implementation", 3, address))),
+		}}}})
+}
+
+func TestTargets(t *testing.T) {
+	program := ir.Build(proxy())
+	a := New(program)
+
+	kinds := make(map[string]string)
+	for _, target := range a.Targets() {
+		kinds[target.Function.Name] = target.Kind.String()
+	}
+	expected := map[string]string{
+		"forward":        "owner-settable",
+		"forwardOwner":   "anyone-settable",
+		"forwardLibrary": "constant",
+		"_exec":          "parameter-controlled",
+		"forwardSlot":    "anyone-settable",
+	}
+	for name, kind := range expected {
+		if kinds[name] != kind {
+			t.Errorf("delegatecall target in %s is [%s], expected [%s]", name, kinds[name], kind)
+		}
+	}
+
+	entries := a.Entries(program.Function(8))
+	if len(entries) != 1 || entries[0].String() != "Proxy.upgrade -> Proxy._setImplementation (guarded by Proxy.onlyOwner)" {
+		t.Errorf("unexpected entries of _setImplementation: %v", entries)
+	}
+	if !a.Checks(program.Function(6)) || a.Checks(program.Function(10)) {
+		t.Error("unexpected result of Checks")
+	}
+}
+
+func TestDelegatecallWriter(t *testing.T) {
+	var messages []string
+	for _, f := range Run(New(ir.Build(proxy())), config.Default()) {
+		if f.Detector == "delegatecall-target-writer" {
+			messages = append(messages, f.Function+": "+f.Message)
+		}
+	}
+	expected := []string{
+		"Proxy.claim(address newOwner): storage Proxy.owner can be overwritten by any caller, redirecting the delegatecall in Proxy.forwardOwner: Proxy.claim (unguarded)",
+		"Proxy.setSlot(address impl): slot 0x01 can be overwritten by any caller, redirecting the delegatecall in Proxy.forwardSlot: Proxy.setSlot (unguarded)",
+	}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected findings:\n%s\nexpected:\n%s", strings.Join(messages, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestReachability(t *testing.T) {
+	findings := make(map[string]*src.Finding)
+	for _, f := range Run(New(ir.Build(proxy())), config.Default()) {
+		if f.Detector == "delegatecall-target" {
+			findings[f.Function] = f
+		}
+	}
+	exec := findings["Proxy._exec(address to)"]
+	if exec == nil || exec.Unreachable || exec.Severity != src.SeverityHigh || strings.Join(exec.Entries, "; ") != "Proxy.exec -> Proxy._exec (unguarded)" {
+		t.Errorf("unexpected finding in _exec: %+v", exec)
+	}
+	forward := findings["Proxy.forward()"]
+	if forward == nil || strings.Join(forward.Entries, "; ") != "Proxy.forward (unguarded)" || len(forward.Guards) != 0 {
+		t.Errorf("unexpected finding in forward: %+v", forward)
+	}
+	a := New(ir.Build(proxy()))
+	finding := &src.Finding{Severity: src.SeverityHigh}
+	Annotate(finding, a.EntriesOf(8))
+	if strings.Join(finding.Guards, ", ") != "Proxy.onlyOwner" || finding.Severity != src.SeverityHigh {
+		t.Errorf("unexpected guards of _setImplementation: %+v", finding)
+	}
+
+	// 没有任何入口能够到达 _unused，严重程度被降为 info。
+	unused := findings["Proxy._unused()"]
+	if unused == nil || !unused.Unreachable || unused.Severity != src.SeverityInfo || len(unused.Entries) != 0 {
+		t.Errorf("unexpected finding in _unused: %+v", unused)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	a := New(ir.Build(proxy()))
+	guards := make(map[string]string)
+	for _, target := range a.Targets() {
+		guards[target.Function.Name] = a.Guard(target.Function, target.Instr).String()
+	}
+	expected := map[string]string{
+		"forward": "unguarded",
+		"partial": "partially guarded by check in Proxy.partial, escaping paths: Proxy.partial:b0 -> Proxy.partial:b4",
+		"inline":  "guarded by check in Proxy.inline",
+		"_admin":  "guarded by Proxy.onlyOwner",
+		"_exec":   "unguarded",
+	}
+	for name, guard := range expected {
+		if guards[name] != guard {
+			t.Errorf("delegatecall in %s is [%s], expected [%s]", name, guards[name], guard)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	a := New(ir.Build(proxy()))
+	layouts := []*layout.Layout{{Contract: "Proxy", Variables: []*layout.Variable{
+		{Contract: "Proxy", Name: "owner", Type: "address", Slot: 0, Size: 20},
+		{Contract: "Proxy", Name: "implementation", Type: "address", Slot: 1, Size: 20},
+	}}}
+	expected := map[int]string{
+		12: "  target: owner-settable from storage Proxy.implementation\n  calldata: msg.data forwarded from the caller\n  slots: slot 0: address Proxy.owner; slot 1: address Proxy.implementation",
+		17: "  target: parameter-controlled from parameter to of Proxy.exec(address to)\n  calldata: msg.data forwarded from the caller\n  slots: slot 0: address Proxy.owner",
+		19: "  target: anyone-settable from slot 0x01\n  calldata: memory prepared in assembly\n  slots: slot 0: address Proxy.owner; slot 0x01 holding the target",
+	}
+	for id, explanation := range expected {
+		if e := a.Explain(id, "Proxy", layouts, []string{"owner"}).String(); e != explanation {
+			t.Errorf("unexpected explanation of function %d:\n%s\nexpected:\n%s", id, e, explanation)
+		}
+	}
+}
+
+// TestGuardFixtures 检查测试合约中通过修饰器以及修饰器调用的函数保护的 delegatecall。
+func TestGuardFixtures(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		function string
+		guard    string
+	}{
+		{"v0.5/6", "Proxy.upgradeTarget", "guarded by Ownable.requireMaster"},
+		{"v0.5/6", "Proxy.fallback", "unguarded"},
+		{"v0.6/1", "TokenManager.setCircuitBreaker", "guarded by ManagerSlot._isBreaker"},
+		{"v0.6/3", "Core.delegateCall", "guarded by Core.onlyProxy, OperableCore.onlyProxyOp"},
+	}
+	for _, c := range cases {
+		bz, err := os.ReadFile(filepath.Join("..", "..", "contracts", c.fixture+".sol_json.ast"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := New(ir.Build(jsoniter.Get(bz)))
+		var got []string
+		for _, target := range a.Targets() {
+			if target.Function.Contract+"."+target.Function.Name == c.function {
+				got = append(got, a.Guard(target.Function, target.Instr).String())
+			}
+		}
+		if len(got) == 0 || got[0] != c.guard {
+			t.Errorf("%s: delegatecalls in %s are %v, expected [%s]", c.fixture, c.function, got, c.guard)
+		}
+	}
+}
+
+// TestTargetsFixtures 检查测试合约中几个典型的 delegatecall 的分类。
+func TestTargetsFixtures(t *testing.T) {
+	cases := []struct {
+		fixture  string
+		function string
+		kind     string
+		source   string
+	}{
+		{"v0.4/1", "RiskSharingToken.startVoting", "owner-settable", "storage RiskSharingToken.votingController"},
+		{"v0.5/6", "Proxy.fallback", "owner-settable", "slot Proxy.targetPosition"},
+		{"v0.6/3", "Core.delegateCall", "owner-settable", "storage Storage.delegates"},
+		{"v0.8/11", "Hack.call", "constant", "storage Hack.lib"},
+		{"v0.8/9", "CrossAssetSwap.buyNftForEth", "parameter-controlled", "parameter addrs"},
+		{"v0.8/1", "AcrossImpl.swapAndBridge", "unknown", "result of external call getRoute"},
+	}
+	for _, c := range cases {
+		bz, err := os.ReadFile(filepath.Join("..", "..", "contracts", c.fixture+".sol_json.ast"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 一个函数中可能有多个 delegatecall，只要其中一个符合即可。
+		var got []string
+		matched := false
+		for _, target := range New(ir.Build(jsoniter.Get(bz))).Targets() {
+			if target.Function.Contract+"."+target.Function.Name != c.function {
+				continue
+			}
+			var sources []string
+			for _, s := range target.Sources {
+				sources = append(sources, s.String())
+			}
+			got = append(got, target.Kind.String()+" from "+strings.Join(sources, "; "))
+			matched = matched || target.Kind.String() == c.kind && strings.Contains(strings.Join(sources, "; "), c.source)
+		}
+		if !matched {
+			t.Errorf("%s: delegatecall targets in %s are %v, expected [%s] from [%s]", c.fixture, c.function, got, c.kind, c.source)
+		}
+	}
+}
+
+// TestBranchGuardedWriter 检查只在某个分支中检查调用者、却在分支之外写入目标地址的入口：
+//
+//	contract Proxy {
+//	    address owner;
+//	    address implementation;
+//	    uint256 counter;
+//	    function setImpl(address impl) public { if (msg.sender == owner) { counter = 1; } implementation = impl; }
+//	    function forward() public { implementation.delegatecall(msg.data); }
+//	}
+func TestBranchGuardedWriter(t *testing.T) {
+	address := "t_address"
+	unit := jsoniter.Wrap(map[string]interface{}{"nodeType": "SourceUnit", "nodes": []interface{}{map[string]interface{}{
+		"nodeType": "ContractDefinition", "id": 1, "name": "Proxy", "contractKind": "contract", "linearizedBaseContracts": []interface{}{1},
+		"nodes": []interface{}{
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 2, "name": "owner", "stateVariable": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": address}},
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 3, "name": "implementation", "stateVariable": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": address}},
+			map[string]interface{}{"nodeType": "VariableDeclaration", "id": 4, "name": "counter", "stateVariable": true, "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_uint256"}},
+			function(5, "setImpl", "public", []interface{}{parameter(6, "impl")}, nil,
+				map[string]interface{}{"nodeType": "IfStatement", "condition": map[string]interface{}{"nodeType": "BinaryOperation", "operator": "==",
+					"typeDescriptions": map[string]interface{}{"typeIdentifier": "t_bool"}, "leftExpression": sender(), "rightExpression": identifier("owner", 2, address)},
+					"trueBody": map[string]interface{}{"nodeType": "Block", "statements": []interface{}{assign(identifier("counter", 4, "t_uint256"),
+						map[string]interface{}{"nodeType": "Literal", "kind": "number", "value": "1", "typeDescriptions": map[string]interface{}{"typeIdentifier": "t_rational_1_by_1"}})}}},
+				assign(identifier("implementation", 3, address), identifier("impl", 6, address))),
+			function(7, "forward", "public", nil, nil, delegatecall(identifier("implementation", 3, address))),
+		}}}})
+
+	a := New(ir.Build(unit))
+	targets := a.Targets()
+	if len(targets) != 1 || targets[0].Kind != TargetAnyoneSettable || targets[0].Sources[0].String() != "storage Proxy.implementation written by Proxy.setImpl (unguarded)" {
+		t.Fatalf("unexpected targets: %+v", targets)
+	}
+
+	findings := make(map[string]*src.Finding)
+	for _, f := range Run(a, config.Default()) {
+		findings[f.Detector] = f
+	}
+	writer := findings["delegatecall-target-writer"]
+	if writer == nil || writer.Function != "Proxy.setImpl(address impl)" || writer.Severity != src.SeverityHigh {
+		t.Errorf("unexpected delegatecall-target-writer finding: %+v", writer)
+	}
+	if guard := findings["delegatecall-guard"]; guard == nil || guard.Severity != src.SeverityHigh {
+		t.Errorf("unexpected delegatecall-guard finding: %+v", guard)
+	}
+}
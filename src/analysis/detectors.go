@@ -0,0 +1,175 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/ir"
+)
+
+// Detector 是基于中间表示、与 solidity 版本无关的检测器。
+type Detector = src.Detector[*Context]
+
+var registry src.Registry[*Context]
+
+func init() {
+	registry.Register(delegatecallTarget{})
+	registry.Register(delegatecallWriter{})
+	registry.Register(delegatecallGuard{})
+}
+
+// Detectors 返回所有基于中间表示的检测器的描述。
+func Detectors() []*src.DetectorInfo {
+	detectors := registry.Detectors()
+	infos := make([]*src.DetectorInfo, len(detectors))
+	for i, d := range detectors {
+		infos[i] = d.Info()
+	}
+	return infos
+}
+
+// Context 是检测器运行时的上下文。
+type Context struct {
+	*Analysis
+
+	conf     *config.Config
+	findings []*src.Finding
+}
+
+// Report 记录函数 f 中指令 in（为 nil 时为整个函数）处的一条检测结果及能够到达它的入口，
+// 被配置排除的函数或者在其合约中禁用了的检测器不会被记录。
+func (ctx *Context) Report(d Detector, f *ir.Function, in *ir.Instr, severity src.Severity, message string) {
+	info := d.Info()
+	if !ctx.conf.Included(f.Contract, f.Name) || !ctx.conf.For(f.Contract).DetectorEnabled(info.ID) {
+		return
+	}
+	finding := &src.Finding{
+		Detector:   info.ID,
+		Severity:   severity,
+		Confidence: info.Confidence,
+		Contract:   f.Contract,
+		Function:   f.Signature,
+		Message:    message,
+	}
+	Annotate(finding, ctx.EntriesAt(f, in))
+	ctx.findings = append(ctx.findings, finding)
+}
+
+// Run 在过程间分析 a 上执行所有基于中间表示的检测器。
+func Run(a *Analysis, conf *config.Config) []*src.Finding {
+	ctx := &Context{Analysis: a, conf: conf}
+	for _, d := range registry.Detectors() {
+		d.Run(ctx)
+	}
+	return ctx.findings
+}
+
+// delegatecallTarget 解析每个 delegatecall 的目标地址的可能取值，并按照谁能决定它进行分类。
+type delegatecallTarget struct{}
+
+func (delegatecallTarget) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-target",
+		Description: "Classify the target of every delegatecall as constant, owner-settable, anyone-settable or parameter-controlled.",
+		Severity:    src.SeverityHigh,
+		Confidence:  src.ConfidenceMedium,
+		Versions:    []string{"ir"},
+	}
+}
+
+// targetSeverity 是各个分类对应的严重程度。
+var targetSeverity = map[TargetKind]src.Severity{
+	TargetConstant:       src.SeverityInfo,
+	TargetOwnerSettable:  src.SeverityLow,
+	TargetUnknown:        src.SeverityMedium,
+	TargetAnyoneSettable: src.SeverityHigh,
+	TargetParameter:      src.SeverityHigh,
+}
+
+func (d delegatecallTarget) Run(ctx *Context) {
+	for _, t := range ctx.Targets() {
+		sources := make([]string, len(t.Sources))
+		for i, s := range t.Sources {
+			sources[i] = s.String()
+		}
+		message := fmt.Sprintf("%s target of %s is %s: %s", t.Instr.Name, t.Function.Contract+"."+t.Function.Name, t.Kind, strings.Join(sources, "; "))
+		ctx.Report(d, t.Function, t.Instr, targetSeverity[t.Kind], message)
+	}
+}
+
+// delegatecallWriter 找出能够修改 delegatecall 目标地址所在的状态变量或 slot、却没有检查调用者身份的外部入口，
+// 包括经过内部函数以及汇编 sstore 的写入；任何人都可以借此将 delegatecall 重定向到自己的合约。
+type delegatecallWriter struct{}
+
+func (delegatecallWriter) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-target-writer",
+		Description: "Report external entries that can overwrite the target of a delegatecall without checking the caller.",
+		Severity:    src.SeverityHigh,
+		Confidence:  src.ConfidenceMedium,
+		Versions:    []string{"ir"},
+	}
+}
+
+func (d delegatecallWriter) Run(ctx *Context) {
+	reported := make(map[string]bool)
+	for _, t := range ctx.Targets() {
+		site := t.Function.Contract + "." + t.Function.Name
+		for _, s := range t.Sources {
+			if s.Kind != TargetAnyoneSettable {
+				continue
+			}
+			for _, e := range s.Writers {
+				if e.Guarded || e.Function.Kind == "constructor" {
+					continue
+				}
+				key := e.Function.Signature + "|" + s.Description + "|" + site
+				if reported[key] {
+					continue
+				}
+				reported[key] = true
+				message := fmt.Sprintf("%s can be overwritten by any caller, redirecting the %s in %s: %s", s.Description, t.Instr.Name, site, e)
+				ctx.Report(d, e.Function, nil, src.SeverityHigh, message)
+			}
+		}
+	}
+}
+
+// delegatecallGuard 在内联了修饰器的控制流图上检查每个 delegatecall 是否被对调用者身份的检查支配。
+type delegatecallGuard struct{}
+
+func (delegatecallGuard) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-guard",
+		Description: "Check whether every delegatecall is dominated by an access check on the caller, with modifiers inlined.",
+		Severity:    src.SeverityHigh,
+		Confidence:  src.ConfidenceMedium,
+		Versions:    []string{"ir"},
+	}
+}
+
+// guardSeverity 是各个保护程度对应的严重程度，最终的严重程度不超过目标地址的分类对应的严重程度，
+// 例如目标地址为常量的 delegatecall 不需要保护。
+var guardSeverity = map[GuardState]src.Severity{
+	Guarded:          src.SeverityInfo,
+	PartiallyGuarded: src.SeverityMedium,
+	Unguarded:        src.SeverityHigh,
+}
+
+func (d delegatecallGuard) Run(ctx *Context) {
+	for _, t := range ctx.Targets() {
+		guard := ctx.Guard(t.Function, t.Instr)
+		severity := guardSeverity[guard.State]
+		if targetSeverity[t.Kind] < severity {
+			severity = targetSeverity[t.Kind]
+		}
+		// 构造函数只在部署时由部署者执行一次。
+		if t.Function.Kind == "constructor" {
+			severity = src.SeverityInfo
+		}
+		message := fmt.Sprintf("%s in %s is %s", t.Instr.Name, t.Function.Contract+"."+t.Function.Name, guard)
+		ctx.Report(d, t.Function, t.Instr, severity, message)
+	}
+}
@@ -0,0 +1,117 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/layout"
+)
+
+// Explain 说明函数 id 中的 delegatecall：目标地址与 calldata 的来源，以及调用者中可能被覆盖的 storage 槽，
+// 包括名字在 protected 中的状态变量与保存目标地址的状态变量或 slot；入口、调用链与插入的检查由调用者补充。
+func (a *Analysis) Explain(id int, contract string, layouts []*layout.Layout, protected []string) *src.Explanation {
+	e := &src.Explanation{}
+	var targets, calldata []string
+	variables := make(map[string]bool)
+	for _, name := range protected {
+		variables[name] = true
+	}
+	var slots []string
+	for _, t := range a.Targets() {
+		if t.Function.ID != id {
+			continue
+		}
+		sources := make([]string, len(t.Sources))
+		for i, s := range t.Sources {
+			sources[i] = s.Description
+			switch {
+			case s.Variable != nil:
+				variables[s.Variable.Contract+"."+s.Variable.Name] = true
+			case s.Slot != "":
+				slots = append(slots, s.Description+" holding the target")
+			}
+		}
+		targets = append(targets, fmt.Sprintf("%s from %s", t.Kind, strings.Join(sources, ", ")))
+		calldata = append(calldata, a.calldata(t))
+	}
+	e.Target = strings.Join(unique(targets), "; ")
+	e.Calldata = strings.Join(unique(calldata), "; ")
+
+	for _, l := range layouts {
+		if l.Contract != contract {
+			continue
+		}
+		for _, v := range l.Variables {
+			if !variables[v.Name] && !variables[v.Contract+"."+v.Name] {
+				continue
+			}
+			slot := fmt.Sprintf("slot %d", v.Slot)
+			if v.Offset > 0 {
+				slot += fmt.Sprintf(" offset %d", v.Offset)
+			}
+			e.Slots = append(e.Slots, fmt.Sprintf("%s: %s %s.%s", slot, v.Type, v.Contract, v.Name))
+		}
+	}
+	e.Slots = append(e.Slots, slots...)
+	return e
+}
+
+// calldata 返回 delegatecall 的 calldata 的来源。汇编中的 delegatecall 的参数依次为 gas、in、insize、out、outsize，
+// 此时 calldata 是 memory 中的一段，通常由 calldatacopy 从 msg.data 复制而来。
+func (a *Analysis) calldata(t *Target) string {
+	args := t.Instr.Args[:len(t.Instr.Args)-len(t.Instr.Options)]
+	if len(args) == 6 {
+		for _, in := range t.Function.Instrs() {
+			if in.Op == ir.OpBuiltin && in.Name == "calldatacopy" {
+				return "msg.data copied to memory by calldatacopy"
+			}
+		}
+		return "memory prepared in assembly"
+	}
+	if len(args) < 2 {
+		return "empty calldata"
+	}
+	return derive(args[1], 0)
+}
+
+// derive 返回 calldata 的可读来源，如 msg.data、参数 data、abi.encodeWithSelector(...)。
+func derive(v *ir.Value, depth int) string {
+	if v == nil || v.Def == nil || depth > maxDepth {
+		return "unknown value"
+	}
+	in := v.Def
+	switch in.Op {
+	case ir.OpEnv:
+		if in.Name == "msg.data" {
+			return "msg.data forwarded from the caller"
+		}
+		return in.Name
+	case ir.OpParam:
+		return "parameter " + v.Name
+	case ir.OpConst:
+		return "literal " + in.Name
+	case ir.OpConvert:
+		return derive(in.Args[0], depth+1)
+	case ir.OpPhi:
+		var sources []string
+		for _, arg := range in.Args {
+			sources = append(sources, derive(arg, depth+1))
+		}
+		return strings.Join(unique(sources), " or ")
+	case ir.OpMLoad, ir.OpCalldataLoad:
+		return derive(in.Args[0], depth+1)
+	case ir.OpSLoad:
+		if in.Variable != nil {
+			return fmt.Sprintf("storage %s.%s", in.Variable.Contract, in.Variable.Name)
+		}
+	case ir.OpBuiltin:
+		args := make([]string, len(in.Args))
+		for i, arg := range in.Args {
+			args[i] = derive(arg, depth+1)
+		}
+		return fmt.Sprintf("%s(%s)", in.Name, strings.Join(args, ", "))
+	}
+	return describe(v)
+}
@@ -0,0 +1,125 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/layout"
+)
+
+// FunctionContext 是各个版本的检测器分析单个函数时与语法树无关的上下文，各个版本的 AnalysisContext 内嵌它，
+// 并加上该版本语法树中的函数与合约。
+type FunctionContext struct {
+	Settings    *config.Settings
+	SolFileName string
+	Logger      logging.Logger
+	// Signature 为被分析的函数的签名。
+	Signature string
+
+	// UnknownDelegatecall 表示函数（包括它调用的函数）中存在目标地址未知的 delegatecall。
+	UnknownDelegatecall bool
+	// KnownDelegatecall 为 delegatecall 调用的已知合约的声明 id，没有时为 0。
+	KnownDelegatecall int
+	// Entries 为能够到达函数的外部入口（包括构造函数）及其调用链，为空时函数不可达。
+	Entries []*Entry
+	// Explanation 为函数中 delegatecall 的说明，每条检测结果得到它的一个副本，插入的检查由检测器补充。
+	Explanation *src.Explanation
+	// Analysis 为整个文件的过程间分析，Layouts 为各个合约的 storage 布局，用来找出 owner 变量所有的修改。
+	Analysis *Analysis
+	Layouts  []*layout.Layout
+
+	findings []*src.Finding
+}
+
+// Report 以检测器 info 的名义上报一条检测结果并返回它，函数不可达时严重程度被降为 info。
+func (ctx *FunctionContext) Report(info *src.DetectorInfo, contract string, message string) *src.Finding {
+	finding := &src.Finding{
+		Detector:   info.ID,
+		Severity:   info.Severity,
+		Confidence: info.Confidence,
+		Contract:   contract,
+		Function:   ctx.Signature,
+		Message:    message,
+	}
+	Annotate(finding, ctx.Entries)
+	finding.Explanation = &src.Explanation{}
+	if ctx.Explanation != nil {
+		explanation := *ctx.Explanation
+		finding.Explanation = &explanation
+	}
+	ctx.findings = append(ctx.findings, finding)
+	return finding
+}
+
+// Reachable 判断是否存在能够到达函数的入口，不可达的函数只上报检测结果而不插桩。
+func (ctx *FunctionContext) Reachable() bool {
+	return len(ctx.Entries) > 0
+}
+
+// Protected 返回合约 contract 中除 owner 变量之外需要在 delegatecall 前后保持不变的状态，无法解析的配置只输出警告；
+// owner 为空时（owner-guard 为 snapshot）包括配置的 owner 变量。父合约中 private 的状态变量只有在 storage 布局中能找到它时
+// 才会被保护（快照在汇编中按照 slot 读取），storage 为 nil 时输出警告并忽略。
+func (ctx *FunctionContext) Protected(contract string, owner string, storage *layout.Layout) []*State {
+	if ctx.Analysis == nil {
+		return nil
+	}
+	states, errs := ctx.Analysis.Protected(contract, ctx.Settings.Variables, ctx.Settings.Protected, ctx.Settings.Infer())
+	for _, err := range errs {
+		ctx.Logger.Warnf("Failed to protect the state of contract [%s]: [%v].", contract, err)
+	}
+	protected := make([]*State, 0, len(states))
+	for _, s := range states {
+		if s.Expression == owner {
+			continue
+		}
+		if s.Private && storage.Find(s.Variable.Contract, s.Variable.Name) == nil {
+			ctx.Logger.Warnf("Failed to protect the state of contract [%s]: [[%s] is private in contract [%s] and can only be read through its slot in memory snapshots].", contract, s.Expression, s.Variable.Contract)
+			continue
+		}
+		protected = append(protected, s)
+	}
+	return protected
+}
+
+// Storage 在快照能够保存在内存中时返回合约 contract 的 storage 布局，否则返回 nil。
+func (ctx *FunctionContext) Storage(contract string) *layout.Layout {
+	if !ctx.Settings.Memory() {
+		return nil
+	}
+	for _, l := range ctx.Layouts {
+		if l.Contract == contract {
+			return l
+		}
+	}
+	return nil
+}
+
+// Explain 在 finding 中说明为合约插入的检查：在合约的每个 delegatecall 语句之后断言 owner 没有被修改，
+// 并且 snapshots 中的状态与语句之前保存的快照相同，reason 为插入的原因。
+func (ctx *FunctionContext) Explain(finding *src.Finding, owner string, snapshots []*Snapshot, contract string, reason string) {
+	finding.Explanation.Gas = GasReport(owner, snapshots)
+	if owner == "" && len(snapshots) == 0 {
+		finding.Explanation.Guard = fmt.Sprintf("nothing inserted: contract [%s] has no variable matching the configured owner variables and no protected state", contract)
+		return
+	}
+	var checks []string
+	if owner != "" {
+		checks = append(checks, fmt.Sprintf("assert that [%s] is unchanged", owner))
+	}
+	if len(snapshots) > 0 {
+		protected := make([]string, len(snapshots))
+		for i, s := range snapshots {
+			protected[i] = s.String()
+		}
+		checks = append(checks, fmt.Sprintf("snapshots of [%s] compared", strings.Join(protected, ", ")))
+	}
+	finding.Explanation.Guard = fmt.Sprintf("%s inserted right after every delegatecall statement of contract [%s], because %s", strings.Join(checks, " and "), contract, reason)
+}
+
+// Findings 返回目前为止上报的检测结果。
+func (ctx *FunctionContext) Findings() []*src.Finding {
+	return ctx.findings
+}
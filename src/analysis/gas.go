@@ -0,0 +1,116 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Snapshot 是插入到合约中的一个状态的快照，Memory 表示快照保存在内存中，否则保存在局部变量中。
+type Snapshot struct {
+	State  *State
+	Memory bool
+}
+
+func (s *Snapshot) String() string {
+	if s.Memory {
+		return s.State.Expression + " in memory"
+	}
+	return s.State.Expression
+}
+
+// 估算 gas 开销时使用的价格（EIP-2929、EIP-2200），storage 按照交易中已经访问过（warm）计算。
+const (
+	gasSLoad     = 100
+	gasSStore    = 2900  // 把非零值修改为另一个非零值
+	gasSStoreNew = 22100 // 第一次访问并且把零修改为非零值
+	gasMemory    = 3     // mload、mstore 以及扩展一个字的内存
+	gasKeccak    = 48    // 把键与槽写入内存后求 keccak256
+	gasCheck     = 30    // 比较、调用 guard 以及栈操作
+	// 内存快照在语句之前读写一次空闲内存指针，在语句之后比较一次。
+	gasPointer = 2*gasMemory + 2*gasCheck
+	// track 方式下记录的入口签名一般超过 31 字节，占用保存长度的槽以及两个数据槽。
+	trackSlots = 3
+)
+
+// SnapshotGas 返回在一个 delegatecall 语句前后比较快照增加的 gas：保存在局部变量中的快照读取两次状态（mapping 的每个键求一次哈希），
+// 保存在内存中的快照另外需要一次 mstore、一次 mload 以及扩展一个字的内存，并且每个语句需要分配一次内存。
+func SnapshotGas(snapshots []*Snapshot) int {
+	gas, pointer := 0, 0
+	for _, s := range snapshots {
+		read := gasSLoad + keys(s.State.Expression)*gasKeccak
+		gas += 2*read + gasCheck
+		if s.Memory {
+			gas += 3 * gasMemory
+			pointer = gasPointer
+		}
+	}
+	return gas + pointer
+}
+
+// TrackGas 返回 track 方式保护 owner 时，每个 delegatecall 语句之后的断言增加的 gas，以及每次修改 owner 时记录入口与修改后的值增加的 gas，
+// first 为第一次记录时的开销。
+func TrackGas() (site int, write int, first int) {
+	// 读取入口签名，以签名为键读取记录的值，再通过 xxx_track_func_<owner>() 读取 owner。
+	site = trackSlots*gasSLoad + 2*gasKeccak + gasSLoad + gasSLoad + gasCheck
+	write = (trackSlots+1)*gasSStore + 2*gasKeccak
+	first = (trackSlots+1)*gasSStoreNew + 2*gasKeccak
+	return site, write, first
+}
+
+// GasReport 说明插桩在每个 delegatecall 语句处增加的 gas，owner 不为空时 owner 按照 track 方式保护，同时说明每次修改 owner 的开销。
+func GasReport(owner string, snapshots []*Snapshot) string {
+	site := SnapshotGas(snapshots)
+	var parts []string
+	var write, first int
+	if owner != "" {
+		var assertion int
+		assertion, write, first = TrackGas()
+		site += assertion
+		parts = append(parts, fmt.Sprintf("owner assertion %d", assertion))
+	}
+	memory := 0
+	for _, s := range snapshots {
+		if s.Memory {
+			memory++
+		}
+	}
+	if n := len(snapshots) - memory; n > 0 {
+		parts = append(parts, plural(n, "stack snapshot"))
+	}
+	if memory > 0 {
+		parts = append(parts, plural(memory, "memory snapshot"))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	report := fmt.Sprintf("about %d gas at each delegatecall statement (%s)", site, strings.Join(parts, ", "))
+	if owner != "" {
+		report += fmt.Sprintf(", and about %d gas at each write of [%s] (%d for the first write)", write, owner, first)
+	}
+	return report
+}
+
+// plural 返回 n 个 noun 的英文写法。
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// keys 返回表达式中最外层方括号的个数，即读取状态时经过的 mapping 或者数组的层数。
+func keys(expression string) int {
+	n, depth := 0, 0
+	for _, c := range expression {
+		switch c {
+		case '[':
+			if depth == 0 {
+				n++
+			}
+			depth++
+		case ']':
+			depth--
+		}
+	}
+	return n
+}
@@ -0,0 +1,33 @@
+package analysis
+
+import "testing"
+
+func TestGasReport(t *testing.T) {
+	owner := &Snapshot{State: &State{Expression: "_owner"}}
+	role := &Snapshot{State: &State{Expression: "_roles[DEFAULT_ADMIN_ROLE][admin]"}}
+	paused := &Snapshot{State: &State{Expression: "paused"}, Memory: true}
+
+	// 每个键多求一次哈希，内存中的快照多一次 mstore、mload 与内存扩展，并且每个语句只分配一次内存。
+	if stack, keyed, memory := SnapshotGas([]*Snapshot{owner}), SnapshotGas([]*Snapshot{role}), SnapshotGas([]*Snapshot{paused}); keyed-stack != 4*gasKeccak || memory-stack != 3*gasMemory+gasPointer {
+		t.Errorf("unexpected snapshot gas: stack %d, keyed %d, memory %d", stack, keyed, memory)
+	}
+	if one, two := SnapshotGas([]*Snapshot{paused}), SnapshotGas([]*Snapshot{paused, paused}); two-one != 2*gasSLoad+gasCheck+3*gasMemory {
+		t.Errorf("unexpected snapshot gas: one %d, two %d", one, two)
+	}
+
+	site, write, first := TrackGas()
+	if write <= site || first <= write {
+		t.Errorf("unexpected track gas: site %d, write %d, first %d", site, write, first)
+	}
+
+	expected := "about 535 gas at each delegatecall statement (1 stack snapshot, 1 memory snapshot)"
+	if got := GasReport("", []*Snapshot{owner, paused}); got != expected {
+		t.Errorf("unexpected report [%s], expected [%s]", got, expected)
+	}
+	if got := GasReport("", nil); got != "" {
+		t.Errorf("expected an empty report, got [%s]", got)
+	}
+	if got := GasReport("owner", nil); got == "" {
+		t.Error("expected a report of the owner assertion")
+	}
+}
@@ -0,0 +1,549 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src/ir"
+)
+
+// maxEscapes 是每个位置最多列出的绕过检查的路径数。
+const maxEscapes = 3
+
+// GuardState 是某条指令受调用者身份检查保护的程度。
+type GuardState int
+
+const (
+	Unguarded        GuardState = iota + 1 // 没有任何检查能够到达该指令
+	PartiallyGuarded                       // 部分路径经过检查，但存在绕过所有检查的路径
+	Guarded                                // 每条到达该指令的路径都经过检查
+)
+
+func (s GuardState) String() string {
+	switch s {
+	case Unguarded:
+		return "unguarded"
+	case PartiallyGuarded:
+		return "partially guarded"
+	case Guarded:
+		return "guarded"
+	default:
+		return "none"
+	}
+}
+
+func (s GuardState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Guard 描述某条指令是否被对调用者身份的检查支配。
+type Guard struct {
+	State   GuardState
+	Guards  []string // 支配该指令，或者在部分路径上出现的检查
+	Escapes []string // 绕过所有检查到达该指令的路径，由基本块组成，如 Proxy.forward:b0 -> Proxy.forward:b2
+}
+
+func (g *Guard) String() string {
+	switch {
+	case g.State == Guarded && len(g.Guards) > 0:
+		return "guarded by " + strings.Join(g.Guards, ", ")
+	case g.State == PartiallyGuarded:
+		return fmt.Sprintf("partially guarded by %s, escaping paths: %s", strings.Join(g.Guards, ", "), strings.Join(g.Escapes, "; "))
+	}
+	return g.State.String()
+}
+
+// node 是内联了修饰器之后的控制流图中的节点，对应某个基本块的一段指令；
+// guard 非空的节点是插入在检查通过的边上的空节点，值为检查的描述。
+type node struct {
+	function *ir.Function
+	block    *ir.Block
+	instrs   []*ir.Instr
+	guard    string
+	succs    []*node
+	preds    []*node
+}
+
+func (n *node) label() string {
+	return fmt.Sprintf("%s.%s:b%d", n.function.Contract, n.function.Name, n.block.Index)
+}
+
+func link(from *node, to *node) {
+	from.succs = append(from.succs, to)
+	to.preds = append(to.preds, from)
+}
+
+// graph 是一个函数内联了修饰器之后的控制流图：修饰器中的 _ 处执行内层的修饰器或者函数体，
+// 函数体中的 return 回到 _ 之后继续执行；所有的 return 汇合到 exit，所有的回滚汇合到 revert。
+type graph struct {
+	entry  *node
+	exit   *node
+	revert *node
+	sink   *node // exit 与 revert 的后继，用于计算后支配节点
+	nodes  []*node
+	sites  map[*ir.Instr][]*node // 指令所在的节点，修饰器中有多个 _ 时函数体会出现多次
+	idom   map[*node]*node
+}
+
+// branch 是一个以 cond 为条件的跳转，from 的两个后继分别对应条件为真与为假。
+type branch struct {
+	from     *node
+	instr    *ir.Instr
+	senders  []bool
+	function *ir.Function
+}
+
+type graphBuilder struct {
+	analysis *Analysis
+	depth    int
+	g        *graph
+	branches []branch
+}
+
+func (b *graphBuilder) newNode(f *ir.Function, block *ir.Block) *node {
+	n := &node{function: f, block: block}
+	b.g.nodes = append(b.g.nodes, n)
+	return n
+}
+
+// graph 建立 f 在第 i 个参数来自调用者（senders[i] 为 true）时内联了修饰器的控制流图，正在建立时返回 nil。
+func (a *Analysis) graph(f *ir.Function, senders []bool, depth int) *graph {
+	key := fmt.Sprintf("%d%v", f.ID, senders)
+	if g, ok := a.graphs[key]; ok {
+		return g
+	}
+	a.graphs[key] = nil
+
+	b := &graphBuilder{analysis: a, depth: depth, g: &graph{sites: make(map[*ir.Instr][]*node)}}
+	g := b.g
+	g.exit, g.revert, g.sink = b.newNode(f, f.Entry()), b.newNode(f, f.Entry()), b.newNode(f, f.Entry())
+	link(g.exit, g.sink)
+	link(g.revert, g.sink)
+
+	var modifiers []*ir.Function
+	var modifierSenders [][]bool
+	var invocations []*ir.Instr
+	if f.Kind != "modifier" {
+		for _, in := range f.Instrs() {
+			if in.Op != ir.OpModifier {
+				continue
+			}
+			if m := a.Program.Function(in.Callee); m != nil && m.Kind == "modifier" {
+				modifiers = append(modifiers, m)
+				modifierSenders = append(modifierSenders, a.sendersOf(in.Args, senders, depth))
+				invocations = append(invocations, in)
+			}
+		}
+	}
+	heads := make([][]*node, len(modifiers))
+	var layer func(i int, ret *node) *node
+	layer = func(i int, ret *node) *node {
+		if i == len(modifiers) {
+			return b.instance(f, senders, nil, ret)
+		}
+		head := b.instance(modifiers[i], modifierSenders[i], func(next *node) *node { return layer(i+1, next) }, ret)
+		heads[i] = append(heads[i], head)
+		return head
+	}
+	g.entry = layer(0, g.exit)
+	// 修饰器的调用指令位于函数体的开头，但修饰器在外层的修饰器执行到 _ 时才开始执行。
+	for i, in := range invocations {
+		g.sites[in] = heads[i]
+	}
+
+	// 条件不成立的一侧总是回滚时，检查通过的是另一侧；否则根据条件的形式判断。
+	ipdom := dominators(g.sink, func(n *node) []*node { return n.preds }, func(n *node) []*node { return n.succs })
+	reverts := func(n *node) bool {
+		for d := n; ipdom[d] != nil && d != g.sink; d = ipdom[d] {
+			if d == g.revert {
+				return true
+			}
+		}
+		return false
+	}
+	for _, br := range b.branches {
+		if len(br.from.succs) != 2 || !a.isCheck(br.instr.Args[0], br.senders, depth, make(map[*ir.Value]bool)) {
+			continue
+		}
+		passed := -1
+		switch r0, r1 := reverts(br.from.succs[0]), reverts(br.from.succs[1]); {
+		case r1 && !r0:
+			passed = 0
+		case r0 && !r1:
+			passed = 1
+		default:
+			passed = a.passedBranch(br.instr.Args[0], br.senders, depth)
+		}
+		if passed < 0 {
+			continue
+		}
+		label := "check in " + br.function.Contract + "." + br.function.Name
+		if br.function.Kind == "modifier" {
+			label = br.function.Contract + "." + br.function.Name
+		}
+		to := br.from.succs[passed]
+		guard := b.newNode(br.function, br.from.block)
+		guard.guard = label
+		br.from.succs[passed] = guard
+		guard.preds = []*node{br.from}
+		guard.succs = []*node{to}
+		for i, pred := range to.preds {
+			if pred == br.from {
+				to.preds[i] = guard
+				break
+			}
+		}
+	}
+	g.idom = dominators(g.entry, func(n *node) []*node { return n.succs }, func(n *node) []*node { return n.preds })
+
+	a.graphs[key] = g
+	return g
+}
+
+// instance 为 fn 的一次执行建立节点并返回入口节点，return 之后跳转到 ret；
+// inner 不为 nil 时，修饰器中的 _ 处执行 inner 建立的节点，执行完之后回到 _ 之后。
+func (b *graphBuilder) instance(fn *ir.Function, senders []bool, inner func(next *node) *node, ret *node) *node {
+	heads := make(map[*ir.Block]*node)
+	tails := make(map[*ir.Block]*node)
+	for _, block := range fn.Blocks {
+		n := b.newNode(fn, block)
+		heads[block] = n
+		for _, in := range block.Instrs {
+			n.instrs = append(n.instrs, in)
+			b.g.sites[in] = append(b.g.sites[in], n)
+			switch {
+			case in.Op == ir.OpPlaceholder && inner != nil:
+				next := b.newNode(fn, block)
+				link(n, inner(next))
+				n = next
+			case in.Op == ir.OpCall:
+				// 被调用的函数在返回之前一定检查了调用者的身份，如 _checkOwner()。
+				if label := b.enforced(in, senders); label != "" {
+					guard, next := b.newNode(fn, block), b.newNode(fn, block)
+					guard.guard = label
+					link(n, guard)
+					link(guard, next)
+					n = next
+				}
+			}
+		}
+		tails[block] = n
+	}
+	for _, block := range fn.Blocks {
+		t := block.Terminator()
+		if t == nil {
+			continue
+		}
+		n := tails[block]
+		switch t.Op {
+		case ir.OpReturn:
+			link(n, ret)
+		case ir.OpRevert:
+			link(n, b.g.revert)
+		default:
+			for _, succ := range block.Succs {
+				link(n, heads[succ])
+			}
+			if t.Op == ir.OpBranch {
+				b.branches = append(b.branches, branch{from: n, instr: t, senders: senders, function: fn})
+			}
+		}
+	}
+	return heads[fn.Entry()]
+}
+
+// enforced 判断内部调用 in 返回时是否一定通过了对调用者身份的检查，是时返回被调用函数的名字。
+func (b *graphBuilder) enforced(in *ir.Instr, senders []bool) string {
+	callee := b.analysis.Program.Function(in.Callee)
+	if callee == nil || b.depth >= maxDepth {
+		return ""
+	}
+	g := b.analysis.graph(callee, b.analysis.sendersOf(in.Args, senders, b.depth), b.depth+1)
+	if g == nil {
+		return ""
+	}
+	if guard := g.guard(g.exit); guard.State != Guarded || len(guard.Guards) == 0 {
+		return ""
+	}
+	return callee.Contract + "." + callee.Name
+}
+
+// sendersOf 判断调用的各个参数是否来自调用者。
+func (a *Analysis) sendersOf(args []*ir.Value, senders []bool, depth int) []bool {
+	result := make([]bool, len(args))
+	for i, arg := range args {
+		result[i] = a.isSender(arg, senders, depth, make(map[*ir.Value]bool))
+	}
+	return result
+}
+
+// passedBranch 根据检查条件的形式判断检查通过时的跳转方向：0 为条件为真，1 为条件为假，无法判断时为 -1。
+// 如 msg.sender == owner、admins[msg.sender]、hasRole(role, msg.sender) 为真时通过，msg.sender != owner 为假时通过。
+func (a *Analysis) passedBranch(cond *ir.Value, senders []bool, depth int) int {
+	if cond == nil || cond.Def == nil {
+		return -1
+	}
+	in := cond.Def
+	switch in.Op {
+	case ir.OpBinary:
+		switch in.Name {
+		case "==":
+			return 0
+		case "!=":
+			return 1
+		case "&&", "||":
+			// a && b 为真时 a、b 都为真，a || b 为假时 a、b 都为假。
+			want := 0
+			if in.Name == "||" {
+				want = 1
+			}
+			for _, arg := range in.Args {
+				if a.isCheck(arg, senders, depth, make(map[*ir.Value]bool)) && a.passedBranch(arg, senders, depth) == want {
+					return want
+				}
+			}
+		}
+	case ir.OpUnary:
+		if passed := a.passedBranch(in.Args[0], senders, depth); in.Name == "!" && passed >= 0 {
+			return 1 - passed
+		}
+	case ir.OpSLoad, ir.OpCall:
+		return 0
+	}
+	return -1
+}
+
+// guard 判断节点 n 是否被检查支配：支配 n 的检查节点，或者删除所有检查节点之后 n 不再可达时为 guarded；
+// 存在能够到达 n 的检查节点时为 partially guarded，否则为 unguarded。不可达的节点也视为 guarded。
+func (g *graph) guard(n *node) *Guard {
+	if _, ok := g.idom[n]; !ok {
+		return &Guard{State: Guarded}
+	}
+	var dominating []string
+	for d := n; d != g.entry; d = g.idom[d] {
+		if d.guard != "" {
+			dominating = append([]string{d.guard}, dominating...)
+		}
+	}
+	if len(dominating) > 0 {
+		return &Guard{State: Guarded, Guards: unique(dominating)}
+	}
+
+	// 从 n 出发反向搜索，reach 为不经过检查节点就能到达 n 的节点，同时收集能够到达 n 的检查节点。
+	reach := map[*node]bool{n: true}
+	seen := map[*node]bool{n: true}
+	var guards []string
+	queue := []*node{n}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, pred := range current.preds {
+			if seen[pred] {
+				continue
+			}
+			seen[pred] = true
+			queue = append(queue, pred)
+			if pred.guard != "" {
+				guards = append(guards, pred.guard)
+			}
+		}
+	}
+	queue = []*node{n}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, pred := range current.preds {
+			if !reach[pred] && pred.guard == "" {
+				reach[pred] = true
+				queue = append(queue, pred)
+			}
+		}
+	}
+	guards = unique(guards)
+	switch {
+	case !reach[g.entry]:
+		return &Guard{State: Guarded, Guards: guards}
+	case len(guards) == 0:
+		return &Guard{State: Unguarded, Escapes: g.escapes(n, reach)}
+	default:
+		return &Guard{State: PartiallyGuarded, Guards: guards, Escapes: g.escapes(n, reach)}
+	}
+}
+
+// escapes 在 reach 中列出最多 maxEscapes 条从入口到 n 的简单路径，连续的同一个基本块只出现一次。
+func (g *graph) escapes(n *node, reach map[*node]bool) []string {
+	var paths []string
+	var path []*node
+	onPath := make(map[*node]bool)
+	steps := 0
+	var dfs func(current *node)
+	dfs = func(current *node) {
+		if len(paths) >= maxEscapes || steps > 10000 {
+			return
+		}
+		steps++
+		path = append(path, current)
+		onPath[current] = true
+		if current == n {
+			var labels []string
+			for _, p := range path {
+				if label := p.label(); len(labels) == 0 || labels[len(labels)-1] != label {
+					labels = append(labels, label)
+				}
+			}
+			paths = append(paths, strings.Join(labels, " -> "))
+		} else {
+			for _, succ := range current.succs {
+				if reach[succ] && !onPath[succ] {
+					dfs(succ)
+				}
+			}
+		}
+		onPath[current] = false
+		path = path[:len(path)-1]
+	}
+	dfs(g.entry)
+	return unique(paths)
+}
+
+// Guard 判断函数 f 中的指令 in 是否被对调用者身份的检查支配；in 所在的函数不是外部入口时，
+// 同时考虑调用它的位置是否被检查支配。
+func (a *Analysis) Guard(f *ir.Function, in *ir.Instr) *Guard {
+	return a.guardAt(f, in, 0)
+}
+
+// intra 只在 f 内联了修饰器的控制流图中判断指令 in 是否被检查支配，不考虑调用 f 的位置；
+// in 为 nil 时判断 f 返回之前是否一定通过了检查。
+func (a *Analysis) intra(f *ir.Function, in *ir.Instr) *Guard {
+	g := a.graph(f, nil, 0)
+	if g == nil {
+		return &Guard{State: Unguarded}
+	}
+	if in == nil {
+		return g.guard(g.exit)
+	}
+	// 指令出现多次时取保护程度最低的一次。
+	var intra *Guard
+	for _, n := range g.sites[in] {
+		if guard := g.guard(n); intra == nil || guard.State < intra.State {
+			intra = guard
+		}
+	}
+	if intra == nil {
+		intra = &Guard{State: Guarded}
+	}
+	return intra
+}
+
+func (a *Analysis) guardAt(f *ir.Function, in *ir.Instr, depth int) *Guard {
+	intra := a.intra(f, in)
+	if intra.State == Guarded || IsEntry(f) || f.Kind == "constructor" || f.Kind == "modifier" || depth >= maxDepth {
+		return intra
+	}
+	var callers []*Guard
+	var labels []string
+	for _, call := range a.callers[f.ID] {
+		if call.Op == ir.OpCall {
+			callers = append(callers, a.guardAt(call.Block.Function, call, depth+1))
+			labels = append(labels, call.Block.Function.Contract+"."+call.Block.Function.Name)
+		}
+	}
+	if len(callers) == 0 {
+		return intra
+	}
+
+	// 只有调用位置与 f 中都绕过了检查时才能到达 in。
+	result := &Guard{State: Guarded, Guards: intra.Guards}
+	unguarded := intra.State == Unguarded
+	for i, caller := range callers {
+		result.Guards = append(result.Guards, caller.Guards...)
+		if caller.State == Guarded {
+			unguarded = false
+			continue
+		}
+		result.State = PartiallyGuarded
+		unguarded = unguarded && caller.State == Unguarded
+		escape := labels[i]
+		if len(caller.Escapes) > 0 {
+			escape = caller.Escapes[0]
+		}
+		if len(intra.Escapes) > 0 {
+			escape += " -> " + intra.Escapes[0]
+		}
+		if len(result.Escapes) < maxEscapes {
+			result.Escapes = append(result.Escapes, escape)
+		}
+	}
+	result.Guards = unique(result.Guards)
+	if unguarded {
+		result.State = Unguarded
+	}
+	return result
+}
+
+// dominators 用 Cooper 等人的迭代算法计算从 entry 出发的直接支配节点，next 与 prev 分别给出后继与前驱；
+// 以 sink 为起点、交换后继与前驱时得到的是直接后支配节点。entry 的直接支配节点为自身，不可达的节点不在结果中。
+func dominators(entry *node, next func(n *node) []*node, prev func(n *node) []*node) map[*node]*node {
+	var order []*node
+	visited := make(map[*node]bool)
+	var dfs func(n *node)
+	dfs = func(n *node) {
+		visited[n] = true
+		for _, succ := range next(n) {
+			if !visited[succ] {
+				dfs(succ)
+			}
+		}
+		order = append(order, n)
+	}
+	dfs(entry)
+	number := make(map[*node]int)
+	for i, n := range order {
+		number[n] = i
+	}
+
+	idom := map[*node]*node{entry: entry}
+	for changed := true; changed; {
+		changed = false
+		for i := len(order) - 2; i >= 0; i-- {
+			n := order[i]
+			var dom *node
+			for _, pred := range prev(n) {
+				if idom[pred] == nil {
+					continue
+				}
+				if dom == nil {
+					dom = pred
+					continue
+				}
+				x, y := dom, pred
+				for x != y {
+					for number[x] < number[y] {
+						x = idom[x]
+					}
+					for number[y] < number[x] {
+						y = idom[y]
+					}
+				}
+				dom = x
+			}
+			if idom[n] != dom {
+				idom[n] = dom
+				changed = true
+			}
+		}
+	}
+	return idom
+}
+
+func unique(list []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, s := range list {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,234 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/types"
+)
+
+// State 是需要在 delegatecall 前后保持不变的一个状态：值类型的状态变量，或者 mapping 中某个键对应的值。
+type State struct {
+	Expression string // 插入到合约中的表达式，如 paused、_roles[DEFAULT_ADMIN_ROLE][admin]
+	Type       string // 保存快照的局部变量的类型
+	Variable   *ir.Variable
+	Reason     string
+	// Private 表示状态变量在父合约中声明为 private，合约中不能通过名字访问，只能在汇编中按照 storage 布局中的 slot 读取。
+	Private bool
+}
+
+func (s *State) String() string {
+	return fmt.Sprintf("%s (%s)", s.Expression, s.Reason)
+}
+
+// Protected 返回合约 contract 中需要保护的状态，依次为：names 中存在的值类型的状态变量（如配置的 owner 变量，不存在时忽略），
+// declared 中声明的状态（无法解析时返回错误），以及 infer 为 true 时推断出的特权状态：与调用者比较的状态变量、
+// delegatecall 目标地址的来源，以及部署之后只能被检查了调用者身份的入口修改的状态变量。父合约中 private 的状态变量被标记为 Private。
+func (a *Analysis) Protected(contract string, names []string, declared []string, infer bool) ([]*State, []error) {
+	var states []*State
+	var errs []error
+	seen := make(map[string]bool)
+	add := func(s *State) {
+		if !seen[s.Expression] {
+			seen[s.Expression] = true
+			states = append(states, s)
+		}
+	}
+
+	for _, name := range names {
+		if v := a.stateVariable(contract, name); v != nil && v.Type != nil && typeName(v.Type) != "" {
+			add(&State{Expression: name, Type: typeName(v.Type), Variable: v, Reason: "configured owner variable", Private: private(contract, v)})
+		}
+	}
+	for _, expression := range declared {
+		s, err := a.resolveState(contract, expression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve protected state [%s] in contract [%s]: [%v]", expression, contract, err))
+			continue
+		}
+		add(s)
+	}
+	if !infer {
+		return states, errs
+	}
+
+	variables := a.baseVariables(contract)
+	inherited := make(map[*ir.Variable]bool)
+	for _, v := range variables {
+		inherited[v] = true
+	}
+	reasons := make(map[*ir.Variable]string)
+	for _, f := range a.functions {
+		for _, in := range f.Instrs() {
+			if in.Op != ir.OpBinary || in.Name != "==" && in.Name != "!=" {
+				continue
+			}
+			for i := 0; i < 2; i++ {
+				if !a.isSender(in.Args[i], nil, 0, make(map[*ir.Value]bool)) {
+					continue
+				}
+				if v := a.loaded(in.Args[1-i], 0); v != nil && inherited[v] && reasons[v] == "" {
+					reasons[v] = fmt.Sprintf("compared with the caller in %s.%s", f.Contract, f.Name)
+				}
+			}
+		}
+	}
+	for _, t := range a.Targets() {
+		for _, s := range t.Sources {
+			if s.Variable != nil && inherited[s.Variable] && reasons[s.Variable] == "" {
+				reasons[s.Variable] = fmt.Sprintf("holds the delegatecall target of %s.%s", t.Function.Contract, t.Function.Name)
+			}
+		}
+	}
+	for _, v := range variables {
+		if reasons[v] == "" && a.guardedOnly(v) {
+			reasons[v] = "written only by guarded entries"
+		}
+	}
+	for _, v := range variables {
+		if reasons[v] != "" && typeName(v.Type) != "" {
+			add(&State{Expression: v.Name, Type: typeName(v.Type), Variable: v, Reason: reasons[v], Private: private(contract, v)})
+		}
+	}
+	return states, errs
+}
+
+// resolveState 解析 root[key]... 形式的状态，root 为状态变量，每个键进入 mapping 的值或者数组的元素，最终必须是值类型。
+func (a *Analysis) resolveState(contract string, expression string) (*State, error) {
+	name, keys := expression, ""
+	if i := strings.IndexByte(expression, '['); i >= 0 {
+		name, keys = expression[:i], expression[i:]
+	}
+	v := a.stateVariable(contract, name)
+	if v == nil || v.Type == nil {
+		return nil, fmt.Errorf("no mutable state variable named [%s]", name)
+	}
+	if keys != "" && private(contract, v) {
+		return nil, fmt.Errorf("[%s] is private in contract [%s]", name, v.Contract)
+	}
+	t := v.Type
+	for depth := 0; keys != ""; keys = keys[1:] {
+		switch keys[0] {
+		case '[':
+			if depth == 0 {
+				switch t.Category {
+				case types.Mapping:
+					t = t.Value
+				case types.Array:
+					t = t.Base
+				default:
+					return nil, fmt.Errorf("[%s] is not a mapping or an array", t)
+				}
+			}
+			depth++
+		case ']':
+			depth--
+		}
+	}
+	if typeName(t) == "" {
+		return nil, fmt.Errorf("[%s] is not a value type", t)
+	}
+	return &State{Expression: expression, Type: typeName(t), Variable: v, Reason: "declared in config", Private: private(contract, v)}, nil
+}
+
+// private 判断状态变量 v 是否是合约 contract 的父合约中声明为 private 的状态变量。
+func private(contract string, v *ir.Variable) bool {
+	return v.Private && v.Contract != contract
+}
+
+// baseVariables 按照 storage 布局的顺序（从最基础的父合约开始）返回合约 contract 及其父合约中可以修改的状态变量。
+func (a *Analysis) baseVariables(contract string) []*ir.Variable {
+	contracts := make(map[int]*ir.Contract)
+	var c *ir.Contract
+	for _, candidate := range a.Program.Contracts {
+		contracts[candidate.ID] = candidate
+		if candidate.Name == contract {
+			c = candidate
+		}
+	}
+	if c == nil {
+		return nil
+	}
+	var variables []*ir.Variable
+	for i := len(c.Bases) - 1; i >= 0; i-- {
+		base, ok := contracts[c.Bases[i]]
+		if !ok {
+			continue
+		}
+		for _, v := range base.Variables {
+			if !v.Constant && !v.Immutable {
+				variables = append(variables, v)
+			}
+		}
+	}
+	return variables
+}
+
+// loaded 返回 v 直接读取的状态变量：类型转换之后的 sload，或者返回同一个状态变量的内部函数调用（如 owner()）。
+func (a *Analysis) loaded(v *ir.Value, depth int) *ir.Variable {
+	if v == nil || v.Def == nil || depth > maxDepth {
+		return nil
+	}
+	in := v.Def
+	switch in.Op {
+	case ir.OpSLoad:
+		if len(in.Path) == 0 {
+			return in.Variable
+		}
+	case ir.OpConvert:
+		return a.loaded(in.Args[0], depth)
+	case ir.OpCall:
+		callee := a.Program.Function(in.Callee)
+		if callee == nil {
+			return nil
+		}
+		var result *ir.Variable
+		for _, r := range callee.Instrs() {
+			if r.Op != ir.OpReturn || len(r.Args) == 0 {
+				continue
+			}
+			loaded := a.loaded(r.Args[0], depth+1)
+			if loaded == nil || result != nil && loaded != result {
+				return nil
+			}
+			result = loaded
+		}
+		return result
+	}
+	return nil
+}
+
+// guardedOnly 判断部署之后是否存在修改 v 的入口，并且每个入口中的写入都被对调用者身份的检查支配。
+func (a *Analysis) guardedOnly(v *ir.Variable) bool {
+	deployed := false
+	for _, w := range a.Writes(v, "") {
+		for _, e := range a.EntriesAt(w.Block.Function, w) {
+			if e.Function.Kind == "constructor" {
+				continue
+			}
+			if !e.Guarded {
+				return false
+			}
+			deployed = true
+		}
+	}
+	return deployed
+}
+
+// typeName 返回保存类型为 t 的快照时使用的类型名，不能保存快照的类型（引用类型、函数等）返回空字符串。
+func typeName(t *types.Type) string {
+	if t == nil {
+		return ""
+	}
+	switch t.Category {
+	case types.Address:
+		// address payable 可以隐式转换为 address，二者也可以直接比较。
+		return "address"
+	case types.Bool, types.Integer, types.FixedBytes:
+		return t.String()
+	case types.Contract, types.Enum, types.UserDefinedValueType:
+		return t.Name
+	}
+	return ""
+}
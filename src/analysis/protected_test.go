@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src/ir"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestProtected(t *testing.T) {
+	load := func(fixture string) *Analysis {
+		bz, err := os.ReadFile(filepath.Join("..", "..", "contracts", fixture+".sol_json.ast"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return New(ir.Build(jsoniter.Get(bz)))
+	}
+	expressions := func(states []*State) []string {
+		var list []string
+		for _, s := range states {
+			list = append(list, s.String())
+		}
+		return list
+	}
+
+	a := load("v0.8/1")
+	states, errs := a.Protected("SocketGateway", []string{"owner", "_owner"}, []string{"routes[0]", "controllers[routesCount][1]", "routes", "missing"}, true)
+	expected := []string{
+		"_owner (configured owner variable)",
+		"routes[0] (declared in config)",
+		"_nominee (compared with the caller in Ownable.claimOwner)",
+		"routesCount (written only by guarded entries)",
+		"controllerCount (written only by guarded entries)",
+	}
+	if got := expressions(states); !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected protected state of SocketGateway %v, expected %v", got, expected)
+	}
+	if states[1].Type != "address" || states[3].Type != "uint32" {
+		t.Errorf("unexpected snapshot types [%s] and [%s]", states[1].Type, states[3].Type)
+	}
+	// Ownable 中 private 的 _owner 与 _nominee 在 SocketGateway 中不能通过名字访问。
+	if !states[0].Private || !states[2].Private || states[1].Private || states[3].Private {
+		t.Errorf("only the private variables of Ownable should be marked private: %+v", states)
+	}
+	if _, errs := a.Protected("SocketGateway", nil, []string{"_nominee[0]"}, false); len(errs) != 1 || !strings.Contains(errs[0].Error(), "is private in contract [Ownable]") {
+		t.Errorf("keyed access to a private variable of a base contract should fail, got %v", errs)
+	}
+	// controllers 只有一层 mapping，routes 本身不是值类型，missing 不存在。
+	if len(errs) != 3 {
+		t.Errorf("expected 3 errors, got %v", errs)
+	}
+
+	// 不推断时只保留配置的状态。
+	if states, _ := a.Protected("SocketGateway", []string{"_owner"}, nil, false); len(states) != 1 {
+		t.Errorf("unexpected protected state without inference %v", expressions(states))
+	}
+
+	// 保存 delegatecall 目标地址的状态变量。
+	a = load("v0.4/1")
+	states, _ = a.Protected("RiskSharingToken", nil, nil, true)
+	found := false
+	for _, s := range states {
+		found = found || s.String() == "votingController (holds the delegatecall target of RiskSharingToken.startVoting)" && s.Type == "VotingControllerBase"
+	}
+	if !found {
+		t.Errorf("votingController should be protected, got %v", expressions(states))
+	}
+}
@@ -0,0 +1,382 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src/ir"
+)
+
+// TargetKind 是 delegatecall 目标地址的分类，数值越大越危险。
+type TargetKind int
+
+const (
+	TargetConstant       TargetKind = iota + 1 // 部署之后不会改变：常量、constant、immutable、只在构造函数中赋值的状态变量
+	TargetOwnerSettable                        // 可以被修改，但所有能够修改它的外部入口都检查了调用者的身份
+	TargetUnknown                              // 无法确定来源，如外部调用的返回值或者计算得到的地址
+	TargetAnyoneSettable                       // 任何外部调用者都可以修改
+	TargetParameter                            // 直接由外部调用者传入，包括参数与 msg.sender
+)
+
+func (k TargetKind) String() string {
+	switch k {
+	case TargetConstant:
+		return "constant"
+	case TargetOwnerSettable:
+		return "owner-settable"
+	case TargetUnknown:
+		return "unknown"
+	case TargetAnyoneSettable:
+		return "anyone-settable"
+	case TargetParameter:
+		return "parameter-controlled"
+	default:
+		return "none"
+	}
+}
+
+func (k TargetKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Source 是目标地址的一个可能来源。
+type Source struct {
+	Kind        TargetKind
+	Description string
+	// Variable 与 Slot 在目标地址保存在状态变量或者汇编直接访问的 slot 中时有效，Writers 为能够修改它的入口
+	Variable *ir.Variable
+	Slot     string
+	Writers  []*Entry
+}
+
+func (s *Source) String() string {
+	if len(s.Writers) == 0 {
+		return s.Description
+	}
+	writers := make([]string, len(s.Writers))
+	for i, w := range s.Writers {
+		writers[i] = w.String()
+	}
+	return fmt.Sprintf("%s written by %s", s.Description, strings.Join(writers, ", "))
+}
+
+// Target 是一个 delegatecall（或 callcode）调用点以及它的目标地址的所有可能来源。
+type Target struct {
+	Function *ir.Function
+	Instr    *ir.Instr
+	Kind     TargetKind // 所有来源中最危险的分类
+	Sources  []*Source
+}
+
+// Targets 解析所有 delegatecall 与 callcode 的目标地址。
+func (a *Analysis) Targets() []*Target {
+	var targets []*Target
+	for _, f := range a.functions {
+		for _, in := range f.Instrs() {
+			if in.Op != ir.OpLowLevelCall || (in.Name != "delegatecall" && in.Name != "callcode") {
+				continue
+			}
+			r := &resolver{analysis: a, visited: make(map[*ir.Value]bool), seen: make(map[string]bool)}
+			r.resolve(in.Args[0], &frame{function: f}, 0)
+			t := &Target{Function: f, Instr: in, Sources: r.sources}
+			for _, s := range t.Sources {
+				if s.Kind > t.Kind {
+					t.Kind = s.Kind
+				}
+			}
+			targets = append(targets, t)
+		}
+	}
+	return targets
+}
+
+// frame 是追踪过程中的调用上下文，call 为进入 function 的调用指令，为 nil 时参数可能来自任何调用者。
+type frame struct {
+	function *ir.Function
+	call     *ir.Instr
+	parent   *frame
+}
+
+type resolver struct {
+	analysis *Analysis
+	visited  map[*ir.Value]bool
+	seen     map[string]bool
+	sources  []*Source
+}
+
+func (r *resolver) add(s *Source) {
+	if key := s.Kind.String() + s.Description; !r.seen[key] {
+		r.seen[key] = true
+		r.sources = append(r.sources, s)
+	}
+}
+
+// resolve 沿着 def-use 链向上追踪 v 的定义，跟随类型转换、phi、内部调用的返回值与参数、状态变量以及 slot。
+func (r *resolver) resolve(v *ir.Value, fr *frame, depth int) {
+	if v == nil || v.Def == nil {
+		r.add(&Source{Kind: TargetUnknown, Description: "undefined value"})
+		return
+	}
+	if r.visited[v] {
+		return
+	}
+	r.visited[v] = true
+	defer delete(r.visited, v)
+	if depth > maxDepth {
+		r.add(&Source{Kind: TargetUnknown, Description: "value defined too deep in the call chain"})
+		return
+	}
+
+	in := v.Def
+	switch in.Op {
+	case ir.OpConst:
+		r.add(&Source{Kind: TargetConstant, Description: "literal " + in.Name})
+	case ir.OpNew:
+		r.add(&Source{Kind: TargetConstant, Description: "new " + in.Name})
+	case ir.OpConvert:
+		r.resolve(in.Args[0], fr, depth)
+	case ir.OpPhi:
+		for _, arg := range in.Args {
+			r.resolve(arg, fr, depth)
+		}
+	case ir.OpGlobal:
+		kind := "constant"
+		if in.Variable.Immutable {
+			kind = "immutable"
+		}
+		r.add(&Source{Kind: TargetConstant, Description: fmt.Sprintf("%s %s.%s", kind, in.Variable.Contract, in.Variable.Name), Variable: in.Variable})
+	case ir.OpEnv:
+		switch in.Name {
+		case "this":
+			r.add(&Source{Kind: TargetConstant, Description: "this"})
+		case "msg.sender", "tx.origin":
+			r.add(&Source{Kind: TargetParameter, Description: in.Name})
+		default:
+			r.add(&Source{Kind: TargetUnknown, Description: in.Name})
+		}
+	case ir.OpParam:
+		r.param(in, fr, depth)
+	case ir.OpSLoad:
+		switch {
+		case in.Variable != nil:
+			r.add(r.analysis.storage(in.Variable, "", ""))
+		case in.Name == "slot":
+			if slot := slotKey(in.Args[0]); slot != "" {
+				r.add(r.analysis.storage(nil, slot, slotExpression(in.Args[0], true, 0)))
+			} else {
+				r.add(&Source{Kind: TargetUnknown, Description: "sload of a computed slot"})
+			}
+		default:
+			r.add(&Source{Kind: TargetUnknown, Description: "storage pointer " + in.Args[0].String()})
+		}
+	case ir.OpCall:
+		r.returns(in, 0, fr, depth)
+	case ir.OpExtract:
+		if base := in.Args[0].Def; base != nil && base.Op == ir.OpCall {
+			r.returns(base, in.Index, fr, depth)
+			return
+		}
+		r.add(&Source{Kind: TargetUnknown, Description: "component of " + describe(in.Args[0])})
+	case ir.OpMLoad, ir.OpCalldataLoad:
+		// memory 与 calldata 中的值继承其所在对象的来源，但已知对象中的元素不再是常量。
+		sub := &resolver{analysis: r.analysis, visited: r.visited, seen: make(map[string]bool)}
+		sub.resolve(in.Args[0], fr, depth)
+		for _, s := range sub.sources {
+			if s.Kind == TargetConstant {
+				s = &Source{Kind: TargetUnknown, Description: "element of " + s.Description}
+			}
+			r.add(s)
+		}
+	default:
+		r.add(&Source{Kind: TargetUnknown, Description: describe(v)})
+	}
+}
+
+// param 追踪参数的来源：外部入口的参数由调用者决定，构造函数的参数在部署时确定，内部函数与修饰器的参数来自调用它们的地方。
+func (r *resolver) param(in *ir.Instr, fr *frame, depth int) {
+	f := fr.function
+	name := in.Dest.Name
+	if name == "" {
+		name = fmt.Sprintf("#%d", in.Index)
+	}
+	if fr.call != nil {
+		if in.Index < len(fr.call.Args) {
+			r.resolve(fr.call.Args[in.Index], fr.parent, depth-1)
+		} else {
+			r.add(&Source{Kind: TargetUnknown, Description: "parameter " + name + " of " + f.Signature})
+		}
+		return
+	}
+	switch {
+	case IsEntry(f):
+		r.add(&Source{Kind: TargetParameter, Description: "parameter " + name + " of " + f.Signature})
+		return
+	case f.Kind == "constructor":
+		r.add(&Source{Kind: TargetConstant, Description: "parameter " + name + " of " + f.Signature})
+		return
+	}
+	callers := r.analysis.callers[f.ID]
+	if len(callers) == 0 {
+		r.add(&Source{Kind: TargetUnknown, Description: "parameter " + name + " of " + f.Signature + " which is never called"})
+		return
+	}
+	for _, call := range callers {
+		if in.Index < len(call.Args) {
+			r.resolve(call.Args[in.Index], &frame{function: call.Block.Function}, depth+1)
+		}
+	}
+}
+
+// returns 追踪内部调用 call 的第 index 个返回值，被调用函数中的参数对应 call 的实参。
+func (r *resolver) returns(call *ir.Instr, index int, fr *frame, depth int) {
+	callee := r.analysis.Program.Function(call.Callee)
+	if callee == nil {
+		r.add(&Source{Kind: TargetUnknown, Description: describe(call.Dest)})
+		return
+	}
+	inner := &frame{function: callee, call: call, parent: fr}
+	for _, ret := range callee.Instrs() {
+		if ret.Op == ir.OpReturn && index < len(ret.Args) {
+			r.resolve(ret.Args[index], inner, depth+1)
+		}
+	}
+}
+
+// storage 根据能够修改状态变量 v（或者 slot，name 为描述中使用的名字）的入口对其分类：没有部署之后的写入时为常量，
+// 所有写入的值都是常量时也是常量，否则根据每个写入是否被对调用者身份的检查支配分为 owner-settable 与 anyone-settable。
+func (a *Analysis) storage(v *ir.Variable, slot string, name string) *Source {
+	s := &Source{Variable: v, Slot: slot}
+	key := "slot " + slot
+	if v != nil {
+		s.Description = fmt.Sprintf("storage %s.%s", v.Contract, v.Name)
+		key = s.Description
+	} else {
+		s.Description = "slot " + name
+	}
+	if cached, ok := a.storages[key]; ok {
+		if cached == nil {
+			// 写入的值又来自正在分析的状态变量本身。
+			return &Source{Kind: TargetUnknown, Description: s.Description + " assigned from itself", Variable: v, Slot: slot}
+		}
+		return cached
+	}
+	a.storages[key] = nil
+	defer func() { a.storages[key] = s }()
+
+	constant := true
+	deployed, guarded := false, true
+	entries := make(map[*ir.Function]int)
+	for _, w := range a.Writes(v, slot) {
+		r := &resolver{analysis: a, visited: make(map[*ir.Value]bool), seen: make(map[string]bool)}
+		r.resolve(w.Args[len(w.Args)-1], &frame{function: w.Block.Function}, 1)
+		for _, source := range r.sources {
+			constant = constant && source.Kind == TargetConstant
+		}
+		// 写入语句本身（或者调用它的位置）必须被检查支配，同一个入口存在没有检查的写入时保留该写入。
+		for _, e := range a.EntriesAt(w.Block.Function, w) {
+			if i, ok := entries[e.Function]; ok {
+				if s.Writers[i].Guarded && !e.Guarded {
+					s.Writers[i] = e
+				}
+			} else {
+				entries[e.Function] = len(s.Writers)
+				s.Writers = append(s.Writers, e)
+			}
+			if e.Function.Kind != "constructor" {
+				deployed = true
+				guarded = guarded && e.Guarded
+			}
+		}
+	}
+	switch {
+	case !deployed || constant:
+		s.Kind = TargetConstant
+	case guarded:
+		s.Kind = TargetOwnerSettable
+	default:
+		s.Kind = TargetAnyoneSettable
+	}
+	return s
+}
+
+// Writes 返回所有写入状态变量 v（包括其中的元素）的指令；v 为 nil 时返回汇编中写入 slot 的指令。
+func (a *Analysis) Writes(v *ir.Variable, slot string) []*ir.Instr {
+	var writes []*ir.Instr
+	for _, f := range a.functions {
+		for _, in := range f.Instrs() {
+			if in.Op != ir.OpSStore {
+				continue
+			}
+			if v != nil && in.Variable == v || v == nil && in.Name == "slot" && slotKey(in.Args[0]) == slot {
+				writes = append(writes, in)
+			}
+		}
+	}
+	return writes
+}
+
+// slotKey 返回汇编中直接访问的 slot 的标识：字面值、constant 状态变量（初始值为字面值时取该值），
+// 以及由它们经过 keccak256、类型转换与算术运算得到的表达式；无法确定时返回空字符串。
+func slotKey(v *ir.Value) string {
+	return slotExpression(v, false, 0)
+}
+
+// slotExpression 返回 slot 的表达式，named 为 true 时 constant 状态变量总是使用它的名字。
+func slotExpression(v *ir.Value, named bool, depth int) string {
+	if v == nil || v.Def == nil || depth > maxDepth {
+		return ""
+	}
+	in := v.Def
+	switch in.Op {
+	case ir.OpGlobal:
+		if !named && in.Variable.Value != "" {
+			return in.Variable.Value
+		}
+		return in.Variable.Contract + "." + in.Variable.Name
+	case ir.OpConst:
+		return in.Name
+	case ir.OpConvert:
+		return slotExpression(in.Args[0], named, depth+1)
+	case ir.OpPhi:
+		key := slotExpression(in.Args[0], named, depth+1)
+		for _, arg := range in.Args[1:] {
+			if slotExpression(arg, named, depth+1) != key {
+				return ""
+			}
+		}
+		return key
+	case ir.OpBuiltin, ir.OpBinary:
+		args := make([]string, len(in.Args))
+		for i, arg := range in.Args {
+			if args[i] = slotExpression(arg, named, depth+1); args[i] == "" {
+				return ""
+			}
+		}
+		if in.Op == ir.OpBinary {
+			return "(" + strings.Join(args, " "+in.Name+" ") + ")"
+		}
+		return in.Name + "(" + strings.Join(args, ", ") + ")"
+	}
+	return ""
+}
+
+// describe 返回无法继续追踪的值的简短描述。
+func describe(v *ir.Value) string {
+	if v == nil || v.Def == nil {
+		return "undefined value"
+	}
+	in := v.Def
+	switch in.Op {
+	case ir.OpExternalCall:
+		return "result of external call " + in.Name
+	case ir.OpLowLevelCall:
+		return "result of " + in.Name
+	case ir.OpCall:
+		return "result of call " + in.Name
+	case ir.OpAssembly:
+		return "assembly"
+	case ir.OpBuiltin, ir.OpBinary, ir.OpUnary:
+		return "value computed by " + in.Name
+	}
+	return in.Op.String()
+}
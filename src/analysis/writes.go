@@ -0,0 +1,201 @@
+package analysis
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/layout"
+)
+
+// Write 是外部入口（包括构造函数）中修改受保护的状态变量的一处位置：入口中直接写入它的指令，
+// 或者经过内部函数、修饰器最终写入它的调用。修改被记在入口上，而不是真正执行写入的内部函数上。
+type Write struct {
+	Entry  *ir.Function
+	Site   *ir.Instr   // 入口中的 OpSStore、OpCall 或者 OpModifier
+	Writes []*ir.Instr // Site 直接或者间接执行的写入指令
+}
+
+func (w *Write) String() string {
+	site := w.Site.Op.String()
+	switch w.Site.Op {
+	case ir.OpCall, ir.OpModifier:
+		site += " " + w.Site.Name
+	}
+	functions := make([]string, 0, len(w.Writes))
+	for _, in := range w.Writes {
+		functions = append(functions, in.Block.Function.Contract+"."+in.Block.Function.Name)
+	}
+	return fmt.Sprintf("%s: %s at [src:%s] writes in %v", w.Entry.Signature, site, w.Site.Src, unique(functions))
+}
+
+// WriteSet 返回合约 contract 中名为 name 的状态变量（包括继承来的）的所有修改位置：赋值、元组赋值、delete、
+// 自增自减、经过 storage 指针（包括内部函数的 storage 参数与返回值）的写入、汇编中对其 .slot 或者其所在的 slot 的 sstore，
+// 以及调用了执行这些写入的内部函数与修饰器的地方。layouts 用来确定变量所在的 slot，为空时忽略汇编中直接写入 slot 的指令。
+func (a *Analysis) WriteSet(contract string, name string, layouts []*layout.Layout) []*Write {
+	v := a.stateVariable(contract, name)
+	if v == nil {
+		return nil
+	}
+	slot := -1
+	for _, l := range layouts {
+		if l.Contract != contract {
+			continue
+		}
+		for _, lv := range l.Variables {
+			if lv.Contract == v.Contract && lv.Name == v.Name {
+				slot = lv.Slot
+			}
+		}
+	}
+
+	// sites 为每个函数中直接或者间接修改 v 的指令，writes 为这些指令最终执行的写入。
+	sites := make(map[*ir.Function][]*ir.Instr)
+	writes := make(map[*ir.Instr][]*ir.Instr)
+	var queue []*ir.Function
+	for _, f := range a.functions {
+		for _, in := range f.Instrs() {
+			if in.Op == ir.OpSStore && a.writesTo(in, v, slot) {
+				if len(sites[f]) == 0 {
+					queue = append(queue, f)
+				}
+				sites[f] = append(sites[f], in)
+				writes[in] = []*ir.Instr{in}
+			}
+		}
+	}
+	for len(queue) > 0 {
+		f := queue[0]
+		queue = queue[1:]
+		var performed []*ir.Instr
+		for _, site := range sites[f] {
+			performed = append(performed, writes[site]...)
+		}
+		for _, call := range a.callers[f.ID] {
+			if _, ok := writes[call]; ok {
+				continue
+			}
+			caller := call.Block.Function
+			if len(sites[caller]) == 0 {
+				queue = append(queue, caller)
+			}
+			sites[caller] = append(sites[caller], call)
+			writes[call] = performed
+		}
+	}
+
+	var result []*Write
+	for _, f := range a.functions {
+		if !IsEntry(f) && f.Kind != "constructor" {
+			continue
+		}
+		for _, in := range f.Instrs() {
+			if performed, ok := writes[in]; ok {
+				result = append(result, &Write{Entry: f, Site: in, Writes: performed})
+			}
+		}
+	}
+	return result
+}
+
+// stateVariable 按照 C3 线性化的顺序在合约 contract 及其父合约中查找名为 name 的状态变量。
+func (a *Analysis) stateVariable(contract string, name string) *ir.Variable {
+	contracts := make(map[int]*ir.Contract)
+	var c *ir.Contract
+	for _, candidate := range a.Program.Contracts {
+		contracts[candidate.ID] = candidate
+		if candidate.Name == contract {
+			c = candidate
+		}
+	}
+	if c == nil {
+		return nil
+	}
+	for _, id := range c.Bases {
+		base, ok := contracts[id]
+		if !ok {
+			continue
+		}
+		for _, v := range base.Variables {
+			if v.Name == name && !v.Constant && !v.Immutable {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// writesTo 判断 OpSStore 指令 in 是否修改了状态变量 v，slot 为 v 所在的 slot，未知时为 -1。
+func (a *Analysis) writesTo(in *ir.Instr, v *ir.Variable, slot int) bool {
+	switch {
+	case in.Variable != nil:
+		return in.Variable == v
+	case in.Name == "slot":
+		return slot >= 0 && sameSlot(slotKey(in.Args[0]), slot)
+	}
+	for _, root := range a.storageRoots(in.Args[0], 0, make(map[*ir.Value]bool)) {
+		if root == v {
+			return true
+		}
+	}
+	return false
+}
+
+// storageRoots 返回 storage 指针 p 可能指向的状态变量：跟随 phi、类型转换、内部函数的 storage 参数（来自所有调用它的地方）
+// 以及返回的 storage 指针。
+func (a *Analysis) storageRoots(p *ir.Value, depth int, visited map[*ir.Value]bool) []*ir.Variable {
+	if p == nil || p.Def == nil || visited[p] || depth > maxDepth {
+		return nil
+	}
+	visited[p] = true
+	in := p.Def
+	switch in.Op {
+	case ir.OpSRef:
+		if in.Variable != nil {
+			return []*ir.Variable{in.Variable}
+		}
+		return a.storageRoots(in.Args[0], depth, visited)
+	case ir.OpConvert:
+		return a.storageRoots(in.Args[0], depth, visited)
+	case ir.OpPhi:
+		var roots []*ir.Variable
+		for _, arg := range in.Args {
+			roots = append(roots, a.storageRoots(arg, depth, visited)...)
+		}
+		return roots
+	case ir.OpParam:
+		var roots []*ir.Variable
+		for _, call := range a.callers[in.Block.Function.ID] {
+			if in.Index < len(call.Args) {
+				roots = append(roots, a.storageRoots(call.Args[in.Index], depth+1, visited)...)
+			}
+		}
+		return roots
+	case ir.OpCall, ir.OpExtract:
+		call, index := in, 0
+		if in.Op == ir.OpExtract {
+			call, index = in.Args[0].Def, in.Index
+		}
+		if call == nil || call.Op != ir.OpCall {
+			return nil
+		}
+		callee := a.Program.Function(call.Callee)
+		if callee == nil {
+			return nil
+		}
+		var roots []*ir.Variable
+		for _, ret := range callee.Instrs() {
+			if ret.Op == ir.OpReturn && index < len(ret.Args) {
+				roots = append(roots, a.storageRoots(ret.Args[index], depth+1, visited)...)
+			}
+		}
+		return roots
+	}
+	return nil
+}
+
+// sameSlot 判断汇编中的 slot 标识 key 是否就是第 slot 个槽，只比较字面值。
+func sameSlot(key string, slot int) bool {
+	n, ok := new(big.Int).SetString(key, 0)
+	return ok && n.IsInt64() && n.Int64() == int64(slot)
+}
@@ -0,0 +1,149 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/layout"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 对应的源码：
+//
+//	contract Owned {
+//	    struct Data { address owner; }
+//	    address owner;
+//	    Data data;
+//	    modifier resets() { owner = msg.sender; _; }
+//	    function setTuple(address a) public { (owner, ) = (a, 1); }
+//	    function clear() public { delete owner; }
+//	    function transfer(address a) public { if (a != address(0)) { _transfer(a); } }
+//	    function _transfer(address a) internal { owner = a; }
+//	    function setData(address a) public { _set(data, a); }
+//	    function _set(Data storage d, address a) internal { d.owner = a; }
+//	    function raw(address a) public { assembly { sstore(0, a) } }
+//	    function reset() public resets {}
+//	    function read() public view returns (address) { return owner; }
+//	}
+const owned = `{"nodeType": "SourceUnit", "nodes": [{
+	"nodeType": "ContractDefinition", "id": 1, "name": "Owned", "contractKind": "contract", "linearizedBaseContracts": [1],
+	"nodes": [
+		{"nodeType": "StructDefinition", "id": 2, "name": "Data", "members": [
+			{"nodeType": "VariableDeclaration", "id": 3, "name": "owner", "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+		{"nodeType": "VariableDeclaration", "id": 4, "name": "owner", "stateVariable": true, "typeDescriptions": {"typeIdentifier": "t_address"}},
+		{"nodeType": "VariableDeclaration", "id": 5, "name": "data", "stateVariable": true, "typeDescriptions": {"typeIdentifier": "t_struct$_Data_$2_storage"}},
+		{"nodeType": "ModifierDefinition", "id": 6, "name": "resets", "parameters": {"parameters": []}, "body": {"nodeType": "Block", "statements": [
+			{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "id": 7, "operator": "=",
+				"leftHandSide": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 4, "typeDescriptions": {"typeIdentifier": "t_address"}},
+				"rightHandSide": {"nodeType": "MemberAccess", "memberName": "sender", "typeDescriptions": {"typeIdentifier": "t_address"},
+					"expression": {"nodeType": "Identifier", "name": "msg", "referencedDeclaration": -15, "typeDescriptions": {"typeIdentifier": "t_magic_message"}}}}},
+			{"nodeType": "PlaceholderStatement"}]}},
+		{"nodeType": "FunctionDefinition", "id": 10, "name": "setTuple", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 11, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "id": 12, "operator": "=",
+					"leftHandSide": {"nodeType": "TupleExpression", "components": [
+						{"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 4, "typeDescriptions": {"typeIdentifier": "t_address"}}, null]},
+					"rightHandSide": {"nodeType": "TupleExpression", "components": [
+						{"nodeType": "Identifier", "name": "a", "referencedDeclaration": 11, "typeDescriptions": {"typeIdentifier": "t_address"}},
+						{"nodeType": "Literal", "kind": "number", "value": "1", "typeDescriptions": {"typeIdentifier": "t_rational_1_by_1"}}]}}}]}},
+		{"nodeType": "FunctionDefinition", "id": 13, "name": "clear", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": []}, "returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "UnaryOperation", "id": 14, "operator": "delete", "prefix": true, "typeDescriptions": {"typeIdentifier": "t_tuple$__$"},
+					"subExpression": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 4, "typeDescriptions": {"typeIdentifier": "t_address"}}}}]}},
+		{"nodeType": "FunctionDefinition", "id": 15, "name": "transfer", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 16, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "IfStatement",
+					"condition": {"nodeType": "BinaryOperation", "operator": "!=", "typeDescriptions": {"typeIdentifier": "t_bool"},
+						"leftExpression": {"nodeType": "Identifier", "name": "a", "referencedDeclaration": 16, "typeDescriptions": {"typeIdentifier": "t_address"}},
+						"rightExpression": {"nodeType": "Literal", "kind": "number", "value": "0", "typeDescriptions": {"typeIdentifier": "t_rational_0_by_1"}}},
+					"trueBody": {"nodeType": "Block", "statements": [
+						{"nodeType": "ExpressionStatement", "expression": {"nodeType": "FunctionCall", "id": 17, "kind": "functionCall", "typeDescriptions": {"typeIdentifier": "t_tuple$__$"},
+							"expression": {"nodeType": "Identifier", "name": "_transfer", "referencedDeclaration": 18, "typeDescriptions": {"typeIdentifier": "t_function_internal_nonpayable$_t_address_$returns$__$"}},
+							"arguments": [{"nodeType": "Identifier", "name": "a", "referencedDeclaration": 16, "typeDescriptions": {"typeIdentifier": "t_address"}}]}}]}}]}},
+		{"nodeType": "FunctionDefinition", "id": 18, "name": "_transfer", "kind": "function", "visibility": "internal",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 19, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "id": 20, "operator": "=",
+					"leftHandSide": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 4, "typeDescriptions": {"typeIdentifier": "t_address"}},
+					"rightHandSide": {"nodeType": "Identifier", "name": "a", "referencedDeclaration": 19, "typeDescriptions": {"typeIdentifier": "t_address"}}}}]}},
+		{"nodeType": "FunctionDefinition", "id": 21, "name": "setData", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 22, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "FunctionCall", "id": 23, "kind": "functionCall", "typeDescriptions": {"typeIdentifier": "t_tuple$__$"},
+					"expression": {"nodeType": "Identifier", "name": "_set", "referencedDeclaration": 24, "typeDescriptions": {"typeIdentifier": "t_function_internal_nonpayable$_t_struct$_Data_$2_storage_ptr_$_t_address_$returns$__$"}},
+					"arguments": [
+						{"nodeType": "Identifier", "name": "data", "referencedDeclaration": 5, "typeDescriptions": {"typeIdentifier": "t_struct$_Data_$2_storage"}},
+						{"nodeType": "Identifier", "name": "a", "referencedDeclaration": 22, "typeDescriptions": {"typeIdentifier": "t_address"}}]}}]}},
+		{"nodeType": "FunctionDefinition", "id": 24, "name": "_set", "kind": "function", "visibility": "internal",
+			"parameters": {"parameters": [
+				{"nodeType": "VariableDeclaration", "id": 25, "name": "d", "storageLocation": "storage", "typeDescriptions": {"typeIdentifier": "t_struct$_Data_$2_storage_ptr"}},
+				{"nodeType": "VariableDeclaration", "id": 26, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "id": 27, "operator": "=",
+					"leftHandSide": {"nodeType": "MemberAccess", "memberName": "owner", "referencedDeclaration": 3, "typeDescriptions": {"typeIdentifier": "t_address"},
+						"expression": {"nodeType": "Identifier", "name": "d", "referencedDeclaration": 25, "typeDescriptions": {"typeIdentifier": "t_struct$_Data_$2_storage_ptr"}}},
+					"rightHandSide": {"nodeType": "Identifier", "name": "a", "referencedDeclaration": 26, "typeDescriptions": {"typeIdentifier": "t_address"}}}}]}},
+		{"nodeType": "FunctionDefinition", "id": 28, "name": "raw", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 29, "name": "a", "typeName": {"nodeType": "ElementaryTypeName", "name": "address"}, "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "InlineAssembly", "id": 30, "externalReferences": [{"a": {"declaration": 29, "isOffset": false, "isSlot": false}}],
+					"operations": "{ sstore(0, a) }"}]}},
+		{"nodeType": "FunctionDefinition", "id": 31, "name": "reset", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": []}, "returnParameters": {"parameters": []},
+			"modifiers": [{"nodeType": "ModifierInvocation", "id": 32, "modifierName": {"name": "resets", "referencedDeclaration": 6}}],
+			"body": {"nodeType": "Block", "statements": []}},
+		{"nodeType": "FunctionDefinition", "id": 33, "name": "read", "kind": "function", "visibility": "public", "stateMutability": "view",
+			"parameters": {"parameters": []},
+			"returnParameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 34, "name": "", "typeDescriptions": {"typeIdentifier": "t_address"}}]}, "modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "Return", "expression": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 4, "typeDescriptions": {"typeIdentifier": "t_address"}}}]}}
+	]}]}`
+
+func TestWriteSet(t *testing.T) {
+	a := New(ir.Build(jsoniter.Get([]byte(owned))))
+	layouts := []*layout.Layout{{Contract: "Owned", Variables: []*layout.Variable{
+		{Contract: "Owned", Name: "owner", Type: "address", Slot: 0, Size: 20},
+		{Contract: "Owned", Name: "data", Type: "struct Owned.Data", Slot: 1, Size: 32},
+	}}}
+
+	sites := func(writes []*Write) string {
+		var lines []string
+		for _, w := range writes {
+			lines = append(lines, w.String())
+		}
+		return strings.Join(lines, "\n")
+	}
+	expected := strings.Join([]string{
+		"Owned.setTuple(address a): sstore at [src:] writes in [Owned.setTuple]",
+		"Owned.clear(): sstore at [src:] writes in [Owned.clear]",
+		"Owned.transfer(address a): call _transfer at [src:] writes in [Owned._transfer]",
+		"Owned.raw(address a): sstore at [src:] writes in [Owned.raw]",
+		"Owned.reset(): modifier resets at [src:] writes in [Owned.resets]",
+	}, "\n")
+	if got := sites(a.WriteSet("Owned", "owner", layouts)); got != expected {
+		t.Errorf("unexpected writes of owner:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	// 没有布局时无法确定汇编中写入的 slot。
+	if got := sites(a.WriteSet("Owned", "owner", nil)); strings.Contains(got, "Owned.raw") {
+		t.Errorf("unexpected writes of owner without layouts:\n%s", got)
+	}
+
+	// 通过 storage 参数写入结构体中的成员。
+	if got := sites(a.WriteSet("Owned", "data", layouts)); got != "Owned.setData(address a): call _set at [src:] writes in [Owned._set]" {
+		t.Errorf("unexpected writes of data:\n%s", got)
+	}
+	if writes := a.WriteSet("Owned", "missing", layouts); len(writes) != 0 {
+		t.Errorf("unexpected writes of an unknown variable: %v", writes)
+	}
+}
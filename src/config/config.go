@@ -0,0 +1,323 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName 是项目配置文件的默认名称，tguard 会从当前目录开始逐级向上查找。
+const FileName = ".tguard.yaml"
+
+// Settings 中的配置既可以全局生效，也可以在 contracts 中针对某些合约单独覆盖。
+type Settings struct {
+	Detectors      Detectors `yaml:"detectors"`
+	Variables      []string  `yaml:"variables"`
+	Template       string    `yaml:"template"`
+	TrustedTargets []string  `yaml:"trusted-targets"`
+	// Protected 为除 owner 变量之外需要在 delegatecall 前后保持不变的状态，如 paused、_roles[DEFAULT_ADMIN_ROLE][admin]，
+	// 方括号中的键原样插入到合约中；InferProtected 为 nil 时默认推断其余的特权状态。
+	Protected      []string `yaml:"protected"`
+	InferProtected *bool    `yaml:"infer-protected"`
+	// TrackStorage 为 owner 的追踪信息的保存方式：append（默认）在合约末尾追加状态变量，
+	// namespaced 保存在 EIP-7201 命名空间中的结构体里，不改变合约原有的 storage 布局。
+	TrackStorage string `yaml:"track-storage"`
+	// OwnerGuard 为保护 owner 变量的方式：track（0.7 以下的合约默认）记录最近一次修改 owner 的入口以及修改后的值，在 delegatecall 之后断言；
+	// snapshot（0.7 及以上的合约默认）与其余特权状态一样在每个 delegatecall 前后比较快照，不需要额外的 storage，也不需要在修改 owner 时记录。
+	OwnerGuard string `yaml:"owner-guard"`
+	// Snapshot 为快照的保存位置：auto（默认）把能够确定槽位的状态变量的快照保存在内存中，其余的状态以及 stack 时保存在局部变量中。
+	Snapshot string `yaml:"snapshot"`
+}
+
+// Detectors 按照检测器 id 启用或禁用检测器，支持通配符；enable 为空表示启用全部检测器。
+type Detectors struct {
+	Enable  []string `yaml:"enable"`
+	Disable []string `yaml:"disable"`
+}
+
+// Filter 中的通配符分别匹配合约名和函数名。
+type Filter struct {
+	Contracts []string `yaml:"contracts"`
+	Functions []string `yaml:"functions"`
+}
+
+// Output 对应命令行中与输出相关的参数，命令行显式指定的参数优先。
+type Output struct {
+	Dir       string `yaml:"dir"`
+	Format    string `yaml:"format"`
+	CallGraph bool   `yaml:"call-graph"`
+	FailOn    string `yaml:"fail-on"`
+}
+
+// Override 对名称匹配 Name 的合约覆盖全局的 Settings，只有显式设置的字段才会生效。
+type Override struct {
+	Name     string `yaml:"name"`
+	Settings `yaml:",inline"`
+}
+
+// Config 是 .tguard.yaml 的完整内容。
+type Config struct {
+	Settings  `yaml:",inline"`
+	Input     string     `yaml:"input"`
+	Rules     []string   `yaml:"rules"`
+	Include   Filter     `yaml:"include"`
+	Exclude   Filter     `yaml:"exclude"`
+	Output    Output     `yaml:"output"`
+	Contracts []Override `yaml:"contracts"`
+}
+
+// Default 返回没有配置文件时使用的配置。
+func Default() *Config {
+	return &Config{Settings: Settings{Variables: []string{"owner", "_owner", "owner_"}, Template: "assert"}}
+}
+
+// Discover 从 dir 开始逐级向上查找配置文件，找不到时返回空字符串。
+func Discover(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory [%s]: [%v]", dir, err)
+	}
+	for {
+		file := filepath.Join(dir, FileName)
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("failed to stat [%s]: [%v]", file, err)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Load 读取并校验配置文件，没有出现在配置文件中的字段使用 Default 中的值。
+func Load(file string) (*Config, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file [%s]: [%v]", file, err)
+	}
+	conf, err := Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	// 规则文件的相对路径相对于配置文件所在的目录。
+	for i, rule := range conf.Rules {
+		if !filepath.IsAbs(rule) {
+			conf.Rules[i] = filepath.Join(filepath.Dir(file), rule)
+		}
+	}
+	return conf, nil
+}
+
+// Parse 解析 yaml 格式的配置，未知的字段会导致错误，避免拼写错误被静默忽略。
+func Parse(content []byte) (*Config, error) {
+	conf := Default()
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(conf); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config: [%v]", err)
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// Validate 检查配置中的通配符以及枚举值是否合法。
+func (c *Config) Validate() error {
+	settings := []*Settings{&c.Settings}
+	for i := range c.Contracts {
+		if c.Contracts[i].Name == "" {
+			return fmt.Errorf("contracts[%d]: name is required", i)
+		}
+		settings = append(settings, &c.Contracts[i].Settings)
+	}
+	for _, s := range settings {
+		if s.Template != "" && s.Template != "assert" && s.Template != "require" {
+			return fmt.Errorf("unknown instrumentation template [%s], expected assert or require", s.Template)
+		}
+		if s.TrackStorage != "" && s.TrackStorage != "append" && s.TrackStorage != "namespaced" {
+			return fmt.Errorf("unknown track storage [%s], expected append or namespaced", s.TrackStorage)
+		}
+		if s.OwnerGuard != "" && s.OwnerGuard != "track" && s.OwnerGuard != "snapshot" {
+			return fmt.Errorf("unknown owner guard [%s], expected track or snapshot", s.OwnerGuard)
+		}
+		if s.Snapshot != "" && s.Snapshot != "auto" && s.Snapshot != "stack" {
+			return fmt.Errorf("unknown snapshot location [%s], expected auto or stack", s.Snapshot)
+		}
+		for _, expression := range s.Protected {
+			if err := validExpression(expression); err != nil {
+				return fmt.Errorf("invalid protected state [%s]: [%v]", expression, err)
+			}
+		}
+	}
+
+	var patterns []string
+	patterns = append(patterns, c.Include.Contracts...)
+	patterns = append(patterns, c.Include.Functions...)
+	patterns = append(patterns, c.Exclude.Contracts...)
+	patterns = append(patterns, c.Exclude.Functions...)
+	for _, override := range c.Contracts {
+		patterns = append(patterns, override.Name)
+		patterns = append(patterns, override.Detectors.Enable...)
+		patterns = append(patterns, override.Detectors.Disable...)
+	}
+	patterns = append(patterns, c.Detectors.Enable...)
+	patterns = append(patterns, c.Detectors.Disable...)
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern [%s]: [%v]", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// For 返回合约 contract 实际生效的配置：按照 contracts 中的顺序依次应用名称匹配的覆盖项，后面的覆盖前面的。
+func (c *Config) For(contract string) *Settings {
+	settings := c.Settings
+	for _, override := range c.Contracts {
+		if !match([]string{override.Name}, contract) {
+			continue
+		}
+		if override.Detectors.Enable != nil {
+			settings.Detectors.Enable = override.Detectors.Enable
+		}
+		if override.Detectors.Disable != nil {
+			settings.Detectors.Disable = override.Detectors.Disable
+		}
+		if override.Variables != nil {
+			settings.Variables = override.Variables
+		}
+		if override.Template != "" {
+			settings.Template = override.Template
+		}
+		if override.TrustedTargets != nil {
+			settings.TrustedTargets = override.TrustedTargets
+		}
+		if override.Protected != nil {
+			settings.Protected = override.Protected
+		}
+		if override.InferProtected != nil {
+			settings.InferProtected = override.InferProtected
+		}
+		if override.TrackStorage != "" {
+			settings.TrackStorage = override.TrackStorage
+		}
+		if override.OwnerGuard != "" {
+			settings.OwnerGuard = override.OwnerGuard
+		}
+		if override.Snapshot != "" {
+			settings.Snapshot = override.Snapshot
+		}
+	}
+	if settings.Template == "" {
+		settings.Template = "assert"
+	}
+	return &settings
+}
+
+// Included 判断合约 contract 中的函数 function 是否需要分析；include 为空表示包含全部。
+func (c *Config) Included(contract string, function string) bool {
+	if len(c.Include.Contracts) > 0 && !match(c.Include.Contracts, contract) {
+		return false
+	}
+	if len(c.Include.Functions) > 0 && !match(c.Include.Functions, function) {
+		return false
+	}
+	return !match(c.Exclude.Contracts, contract) && !match(c.Exclude.Functions, function)
+}
+
+// DetectorEnabled 判断检测器 id 是否启用，disable 的优先级高于 enable。
+func (s *Settings) DetectorEnabled(id string) bool {
+	if len(s.Detectors.Enable) > 0 && !match(s.Detectors.Enable, id) {
+		return false
+	}
+	return !match(s.Detectors.Disable, id)
+}
+
+// Trusted 判断被 delegatecall 调用的合约 contract 是否是可信的目标。
+func (s *Settings) Trusted(contract string) bool {
+	return match(s.TrustedTargets, contract)
+}
+
+// Infer 判断是否推断除配置之外的受保护状态，默认推断。
+func (s *Settings) Infer() bool {
+	return s.InferProtected == nil || *s.InferProtected
+}
+
+// Namespaced 判断 owner 的追踪信息是否保存在命名空间中。
+func (s *Settings) Namespaced() bool {
+	return s.TrackStorage == "namespaced"
+}
+
+// SnapshotOwner 判断 owner 变量是否与其余特权状态一样通过快照保护，而不是记录修改它的入口；
+// 没有设置 owner-guard 时返回 byDefault，由各个版本的插桩决定默认的方式。
+func (s *Settings) SnapshotOwner(byDefault bool) bool {
+	if s.OwnerGuard == "" {
+		return byDefault
+	}
+	return s.OwnerGuard == "snapshot"
+}
+
+// Memory 判断状态变量的快照能否保存在内存中：delegatecall 以及重入都在新的调用帧中执行，不能修改当前调用帧的内存。
+func (s *Settings) Memory() bool {
+	return s.Snapshot != "stack"
+}
+
+// validExpression 检查受保护状态的写法：状态变量名之后跟着若干个方括号括起来的非空的键。
+func validExpression(expression string) error {
+	name := expression
+	if i := strings.IndexByte(expression, '['); i >= 0 {
+		name = expression[:i]
+		depth, start := 0, i
+		for j := i; j < len(expression); j++ {
+			switch expression[j] {
+			case '[':
+				depth++
+			case ']':
+				depth--
+				if depth < 0 {
+					return fmt.Errorf("unbalanced ] at %d", j)
+				}
+				if depth == 0 {
+					if strings.TrimSpace(expression[start+1:j]) == "" {
+						return fmt.Errorf("empty key at %d", start)
+					}
+					if j+1 < len(expression) && expression[j+1] != '[' {
+						return fmt.Errorf("unexpected [%s] after key", expression[j+1:])
+					}
+					start = j + 1
+				}
+			}
+		}
+		if depth != 0 {
+			return errors.New("unbalanced [")
+		}
+	}
+	if !identifier.MatchString(name) {
+		return fmt.Errorf("[%s] is not a state variable name", name)
+	}
+	return nil
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func match(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const example = `
+variables: [owner, admin]
+template: require
+detectors:
+  disable: [delegatecall-owner-slot-collision]
+trusted-targets: [Library*]
+protected: [paused, "_roles[DEFAULT_ADMIN_ROLE][admin]"]
+owner-guard: snapshot
+include:
+  contracts: ["*"]
+exclude:
+  functions: [test*]
+output:
+  dir: build
+  format: json
+  fail-on: medium
+contracts:
+  - name: Proxy*
+    variables: [_admin]
+    detectors:
+      disable: []
+  - name: ProxyV2
+    template: assert
+    infer-protected: false
+    track-storage: namespaced
+    owner-guard: track
+    snapshot: stack
+`
+
+func TestParse(t *testing.T) {
+	conf, err := Parse([]byte(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conf.Output.Dir != "build" || conf.Output.Format != "json" || conf.Output.FailOn != "medium" {
+		t.Fatalf("unexpected output config %+v", conf.Output)
+	}
+
+	token := conf.For("Token")
+	if !reflect.DeepEqual(token.Variables, []string{"owner", "admin"}) || token.Template != "require" {
+		t.Fatalf("unexpected settings for Token %+v", token)
+	}
+	if token.DetectorEnabled("delegatecall-owner-slot-collision") || !token.DetectorEnabled("delegatecall-unknown-target") {
+		t.Fatalf("unexpected detectors for Token %+v", token.Detectors)
+	}
+	if !token.Trusted("LibraryV1") || token.Trusted("Token") {
+		t.Fatalf("unexpected trusted targets for Token %+v", token.TrustedTargets)
+	}
+	if !reflect.DeepEqual(token.Protected, []string{"paused", "_roles[DEFAULT_ADMIN_ROLE][admin]"}) || !token.Infer() {
+		t.Fatalf("unexpected protected state for Token %+v", token.Protected)
+	}
+
+	proxy := conf.For("ProxyV2")
+	if !reflect.DeepEqual(proxy.Variables, []string{"_admin"}) || proxy.Template != "assert" {
+		t.Fatalf("unexpected settings for ProxyV2 %+v", proxy)
+	}
+	if !proxy.DetectorEnabled("delegatecall-owner-slot-collision") {
+		t.Fatalf("unexpected detectors for ProxyV2 %+v", proxy.Detectors)
+	}
+	if proxy.Infer() || len(proxy.Protected) != 2 || !proxy.Namespaced() || token.Namespaced() {
+		t.Fatalf("unexpected protected state for ProxyV2 %+v", proxy)
+	}
+
+	if !token.SnapshotOwner(false) || !token.Memory() || proxy.SnapshotOwner(true) || proxy.Memory() {
+		t.Fatalf("unexpected owner guard for Token %+v and ProxyV2 %+v", token, proxy)
+	}
+	if defaults := Default().For("Token"); !defaults.SnapshotOwner(true) || defaults.SnapshotOwner(false) {
+		t.Fatalf("owner guard without owner-guard should follow the default of the version %+v", defaults)
+	}
+
+	if !conf.Included("Token", "transfer") || conf.Included("Token", "testTransfer") {
+		t.Fatal("unexpected include/exclude result")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	inputs := []string{
+		"varibles: [owner]",
+		"template: revert",
+		"contracts:\n  - variables: [owner]",
+		"exclude:\n  functions: ['[']",
+		"protected: ['_roles[admin']",
+		"protected: ['_roles[]']",
+		"protected: ['config.admin']",
+		"track-storage: proxy",
+		"owner-guard: mapping",
+		"snapshot: transient",
+	}
+	for _, input := range inputs {
+		if _, err := Parse([]byte(input)); err == nil {
+			t.Fatalf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	conf, err := Parse(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(conf, Default()) {
+		t.Fatalf("expected the default config, got %+v", conf)
+	}
+	if !conf.Included("A", "f") || !conf.For("A").DetectorEnabled("delegatecall-unknown-target") {
+		t.Fatal("the default config should analyze everything")
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, FileName), []byte(example), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := Discover(nested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file != filepath.Join(root, FileName) {
+		t.Fatalf("expected [%s], got [%s]", filepath.Join(root, FileName), file)
+	}
+}
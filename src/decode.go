@@ -0,0 +1,244 @@
+package src
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Preload 将整棵语法树一次性解析到内存中，之后对子树的 Get 操作以及 UnmarshalAny 都不再需要重新扫描 json 文本；
+// raw 已经经过 Preload 时直接返回。
+func Preload(raw jsoniter.Any) (jsoniter.Any, error) {
+	if t, ok := raw.(tree); ok {
+		return t, nil
+	}
+	var root interface{}
+	if err := jsoniter.UnmarshalFromString(raw.ToString(), &root); err != nil {
+		return nil, fmt.Errorf("failed to parse ast: [%v]", err)
+	}
+	return tree{jsoniter.Wrap(root)}, nil
+}
+
+// Tree 将已经解析到内存中的 raw 包装成与 Preload 结果相同的语法树，raw 已经经过 Preload 时直接返回。
+func Tree(raw jsoniter.Any) jsoniter.Any {
+	if t, ok := raw.(tree); ok {
+		return t
+	}
+	return tree{jsoniter.Wrap(raw.GetInterface())}
+}
+
+// tree 包装 jsoniter.Wrap 的结果：jsoniter 对 map 使用整数下标时会 panic，并且只处理路径中的第一个元素，
+// tree 按照值的类型检查每一级下标，类型不匹配时返回无效值。
+type tree struct {
+	jsoniter.Any
+}
+
+func (t tree) Get(path ...interface{}) jsoniter.Any {
+	if len(path) == 0 {
+		return t
+	}
+	var child jsoniter.Any
+	switch path[0].(type) {
+	case int:
+		if t.ValueType() != jsoniter.ArrayValue {
+			return invalid
+		}
+		child = t.Any.Get(path[0])
+	case string:
+		if t.ValueType() != jsoniter.ObjectValue {
+			return invalid
+		}
+		child = t.Any.Get(path[0])
+	default:
+		return invalid
+	}
+	if child.ValueType() == jsoniter.InvalidValue {
+		return child
+	}
+	return tree{child}.Get(path[1:]...)
+}
+
+// invalid 是路径不存在时返回的无效值。
+var invalid = jsoniter.Wrap(map[string]interface{}{}).Get("")
+
+// UnmarshalAny 将 raw 中的字段按照 json tag 填充到 v 中，语义与 json.Unmarshal 一致；
+// 如果 raw 已经经过 Preload，则只会访问当前节点自身的字段，不会重新序列化整棵子树。
+func UnmarshalAny(raw jsoniter.Any, v interface{}) error {
+	if raw.ValueType() == jsoniter.InvalidValue {
+		return fmt.Errorf("invalid json: [%v]", raw.LastError())
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("expected a non-nil pointer, but got [%T]", v)
+	}
+
+	return decode(raw.GetInterface(), rv.Elem(), "")
+}
+
+type field struct {
+	name  string
+	index int
+}
+
+type structFields struct {
+	exact map[string]field
+	fold  map[string]field
+}
+
+var fieldsCache sync.Map // reflect.Type => *structFields
+
+func fieldsOf(t reflect.Type) *structFields {
+	if fs, ok := fieldsCache.Load(t); ok {
+		return fs.(*structFields)
+	}
+
+	fs := &structFields{exact: make(map[string]field), fold: make(map[string]field)}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("json"); ok {
+			tag = strings.Split(tag, ",")[0]
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		fs.exact[name] = field{name: name, index: i}
+		if _, ok := fs.fold[strings.ToLower(name)]; !ok {
+			fs.fold[strings.ToLower(name)] = field{name: name, index: i}
+		}
+	}
+
+	actual, _ := fieldsCache.LoadOrStore(t, fs)
+	return actual.(*structFields)
+}
+
+func decode(data interface{}, v reflect.Value, path string) error {
+	if data == nil {
+		switch v.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice:
+			v.Set(reflect.Zero(v.Type()))
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return typeError(data, v, path)
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return typeError(data, v, path)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f, ok := data.(float64)
+		if !ok || f != math.Trunc(f) || v.OverflowInt(int64(f)) {
+			return typeError(data, v, path)
+		}
+		v.SetInt(int64(f))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f, ok := data.(float64)
+		if !ok || f < 0 || f != math.Trunc(f) || v.OverflowUint(uint64(f)) {
+			return typeError(data, v, path)
+		}
+		v.SetUint(uint64(f))
+	case reflect.Float32, reflect.Float64:
+		f, ok := data.(float64)
+		if !ok {
+			return typeError(data, v, path)
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return typeError(data, v, path)
+		}
+		v.Set(reflect.ValueOf(data))
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decode(data, v.Elem(), path)
+	case reflect.Slice:
+		array, ok := data.([]interface{})
+		if !ok {
+			return typeError(data, v, path)
+		}
+		slice := reflect.MakeSlice(v.Type(), len(array), len(array))
+		for i, element := range array {
+			if err := decode(element, slice.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	case reflect.Map:
+		object, ok := data.(map[string]interface{})
+		if !ok || v.Type().Key().Kind() != reflect.String {
+			return typeError(data, v, path)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(v.Type(), len(object)))
+		}
+		for key, element := range object {
+			value := reflect.New(v.Type().Elem()).Elem()
+			if err := decode(element, value, path+"."+key); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), value)
+		}
+	case reflect.Struct:
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			return typeError(data, v, path)
+		}
+		fs := fieldsOf(v.Type())
+		for key, element := range object {
+			f, ok := fs.exact[key]
+			if !ok {
+				if f, ok = fs.fold[strings.ToLower(key)]; !ok {
+					continue
+				}
+			}
+			if err := decode(element, v.Field(f.index), strings.TrimPrefix(path+"."+f.name, ".")); err != nil {
+				return err
+			}
+		}
+	default:
+		return typeError(data, v, path)
+	}
+
+	return nil
+}
+
+func typeError(data interface{}, v reflect.Value, path string) error {
+	var kind string
+	switch data.(type) {
+	case string:
+		kind = "string"
+	case bool:
+		kind = "bool"
+	case float64:
+		kind = "number"
+	case []interface{}:
+		kind = "array"
+	case map[string]interface{}:
+		kind = "object"
+	default:
+		kind = fmt.Sprintf("%T", data)
+	}
+	return fmt.Errorf("cannot unmarshal %s into field [%s] of type %s", kind, path, v.Type())
+}
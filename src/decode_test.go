@@ -0,0 +1,147 @@
+package src
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+type decodeNode struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	IsPure           bool   `json:"isPure"`
+	NodeType         string `json:"nodeType"`
+	TypeDescriptions struct {
+		TypeString string `json:"typeString"`
+	} `json:"typeDescriptions"`
+	Names           []string         `json:"names"`
+	ExportedSymbols map[string][]int `json:"exportedSymbols"`
+	ArgumentTypes   []struct {
+		TypeString string `json:"typeString"`
+	} `json:"argumentTypes"`
+	children interface{}
+}
+
+func TestUnmarshalAny(t *testing.T) {
+	inputs := []string{
+		`{"id":3,"name":"owner","isPure":true,"nodeType":"Identifier","typeDescriptions":{"typeString":"address"},"names":["a","b"],"exportedSymbols":{"A":[1,2]},"argumentTypes":[{"typeString":"contract A"}],"children":{"id":4}}`,
+		`{"ID":3,"Name":"owner","names":null,"argumentTypes":null,"unknown":[1,{"x":2}]}`,
+		`{"id":1.5}`,
+		`{"name":1}`,
+		`{"names":"a"}`,
+		`[]`,
+	}
+
+	for _, input := range inputs {
+		var expected, actual decodeNode
+		expectedErr := json.Unmarshal([]byte(input), &expected)
+
+		raw, err := Preload(jsoniter.Get([]byte(input)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		actualErr := UnmarshalAny(raw, &actual)
+
+		if (expectedErr == nil) != (actualErr == nil) {
+			t.Fatalf("%s: expected error [%v], got [%v]", input, expectedErr, actualErr)
+		}
+		if expectedErr == nil && !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("%s: expected %+v, got %+v", input, expected, actual)
+		}
+	}
+}
+
+func TestPreloadGet(t *testing.T) {
+	input := []byte(`{"nodes":[{"nodeType":"PragmaDirective","literals":["solidity","0.8"]}],"map":{"":""},"null":null}`)
+	raw, err := Preload(jsoniter.Get(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := [][]interface{}{
+		{"nodes", 0, "nodeType"},
+		{"nodes", 0, "literals", 1},
+		{"nodes", 1},
+		{"nodes", "0"},
+		{"map", 0},
+		{"map", ""},
+		{"null", 0},
+		{"null", "x"},
+		{0},
+		{"missing", "x"},
+		{"nodes", 0, "literals", 5},
+	}
+	for _, path := range paths {
+		expected, actual := jsoniter.Get(input, path...), raw.Get(path...)
+		if expected.ValueType() != actual.ValueType() || expected.ToString() != actual.ToString() {
+			t.Fatalf("%v: expected [%v] %s, got [%v] %s", path, expected.ValueType(), expected.ToString(), actual.ValueType(), actual.ToString())
+		}
+	}
+
+	// 迭代 map 的下标不能 panic。
+	nodes := raw.Get("map")
+	for i := 0; i < nodes.Size(); i++ {
+		if nodes.Get(i).ValueType() != jsoniter.InvalidValue {
+			t.Fatalf("expected an invalid value for index [%d] of an object", i)
+		}
+	}
+}
+
+// BenchmarkDecode 对比逐个节点重新序列化再 json.Unmarshal 的旧做法（lazy）与 Preload 之后 UnmarshalAny 的做法（preload），
+// 两者都会访问并解码语法树中的每一个对象节点。
+func BenchmarkDecode(b *testing.B) {
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "contracts", "v0.8", "1.sol_json.ast"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("lazy", func(b *testing.B) {
+		b.SetBytes(int64(len(jsonBytes)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			visit(jsoniter.Get(jsonBytes), func(node jsoniter.Any) {
+				var v decodeNode
+				if err := json.Unmarshal([]byte(node.ToString()), &v); err != nil {
+					b.Fatal(err)
+				}
+			})
+		}
+	})
+	b.Run("preload", func(b *testing.B) {
+		b.SetBytes(int64(len(jsonBytes)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			raw, err := Preload(jsoniter.Get(jsonBytes))
+			if err != nil {
+				b.Fatal(err)
+			}
+			visit(raw, func(node jsoniter.Any) {
+				var v decodeNode
+				if err := UnmarshalAny(node, &v); err != nil {
+					b.Fatal(err)
+				}
+			})
+		}
+	})
+}
+
+// visit 按照先序遍历 raw 中所有带 nodeType 的对象节点。
+func visit(raw jsoniter.Any, f func(node jsoniter.Any)) {
+	switch raw.ValueType() {
+	case jsoniter.ObjectValue:
+		if raw.Get("nodeType").ValueType() == jsoniter.StringValue {
+			f(raw)
+		}
+		for _, key := range raw.Keys() {
+			visit(raw.Get(key), f)
+		}
+	case jsoniter.ArrayValue:
+		for i := 0; i < raw.Size(); i++ {
+			visit(raw.Get(i), f)
+		}
+	}
+}
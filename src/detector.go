@@ -0,0 +1,110 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Confidence 表示检测结果的可信程度，数值越大越可信。
+type Confidence int
+
+const (
+	ConfidenceLow Confidence = iota + 1
+	ConfidenceMedium
+	ConfidenceHigh
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+func (c Confidence) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// DetectorInfo 描述一个检测器，Versions 为实现了该检测器的 solidity 版本。
+type DetectorInfo struct {
+	ID          string     `json:"id"`
+	Description string     `json:"description"`
+	Severity    Severity   `json:"severity"`
+	Confidence  Confidence `json:"confidence"`
+	Versions    []string   `json:"versions"`
+}
+
+// Detector 是一个检测器，C 为它运行时的上下文：各个版本的检测器每次分析语法树中的一个函数，
+// 基于中间表示的检测器一次分析整个文件。
+type Detector[C any] interface {
+	Info() *DetectorInfo
+	Run(ctx C)
+}
+
+// Registry 按照注册的顺序保存上下文为 C 的检测器。
+type Registry[C any] struct {
+	mutex     sync.Mutex
+	detectors []Detector[C]
+}
+
+// Register 注册一个检测器，一般在 init 中调用；id 重复时 panic。
+func (r *Registry[C]) Register(d Detector[C]) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, registered := range r.detectors {
+		if registered.Info().ID == d.Info().ID {
+			panic(fmt.Sprintf("detector [%s] is already registered", d.Info().ID))
+		}
+	}
+	r.detectors = append(r.detectors, d)
+}
+
+// Detectors 按照注册的顺序返回所有检测器。
+func (r *Registry[C]) Detectors() []Detector[C] {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]Detector[C](nil), r.detectors...)
+}
+
+// ValidateDetectorPatterns 检查 --detectors、--exclude-detectors 中不带通配符的 id 是否都是已知的检测器。
+func ValidateDetectorPatterns(patterns []string, infos []*DetectorInfo) error {
+	for _, pattern := range patterns {
+		if strings.ContainsAny(pattern, "*?[") {
+			continue
+		}
+		known := false
+		for _, info := range infos {
+			if info.ID == pattern {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown detector [%s], run \"tguard detectors list\" to see all detectors", pattern)
+		}
+	}
+	return nil
+}
+
+// ParseConfidence 解析 low、medium、high。
+func ParseConfidence(confidence string) (Confidence, error) {
+	switch strings.ToLower(confidence) {
+	case "low":
+		return ConfidenceLow, nil
+	case "medium":
+		return ConfidenceMedium, nil
+	case "high":
+		return ConfidenceHigh, nil
+	default:
+		return 0, fmt.Errorf("unknown confidence [%s], expected one of low, medium, high", confidence)
+	}
+}
@@ -0,0 +1,40 @@
+package src
+
+import "testing"
+
+func TestValidateDetectorPatterns(t *testing.T) {
+	infos := []*DetectorInfo{{ID: "delegatecall-unknown-target"}, {ID: "delegatecall-owner-slot-collision"}}
+
+	if err := ValidateDetectorPatterns([]string{"delegatecall-unknown-target", "delegatecall-*"}, infos); err != nil {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+	if err := ValidateDetectorPatterns([]string{"delegatecall-unknown"}, infos); err == nil {
+		t.Errorf("expected an error for unknown detector")
+	}
+}
+
+type testDetector string
+
+func (d testDetector) Info() *DetectorInfo { return &DetectorInfo{ID: string(d)} }
+func (d testDetector) Run(ctx *[]string)   { *ctx = append(*ctx, string(d)) }
+
+func TestRegistry(t *testing.T) {
+	var registry Registry[*[]string]
+	registry.Register(testDetector("b"))
+	registry.Register(testDetector("a"))
+
+	var ran []string
+	for _, d := range registry.Detectors() {
+		d.Run(&ran)
+	}
+	if len(ran) != 2 || ran[0] != "b" || ran[1] != "a" {
+		t.Errorf("detectors should run in the order of registration, got %v", ran)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a duplicate id should panic")
+		}
+	}()
+	registry.Register(testDetector("a"))
+}
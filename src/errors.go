@@ -0,0 +1,104 @@
+package src
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorKind 区分错误的类别，每一类错误对应一个不同的退出码。
+type ErrorKind int
+
+const (
+	InputError ErrorKind = iota + 1
+	UnsupportedVersionError
+	ParseError
+	AnalysisError
+	InstrumentationError
+)
+
+const (
+	ExitOK                 = 0
+	ExitFindings           = 1
+	ExitInput              = 2
+	ExitUnsupportedVersion = 3
+	ExitParse              = 4
+	ExitAnalysis           = 5
+	ExitInstrumentation    = 6
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case InputError:
+		return "input error"
+	case UnsupportedVersionError:
+		return "unsupported version"
+	case ParseError:
+		return "parse error"
+	case AnalysisError:
+		return "analysis error"
+	case InstrumentationError:
+		return "instrumentation error"
+	default:
+		return "unknown error"
+	}
+}
+
+// ErrFindings 表示存在严重程度不低于 --fail-on 的检测结果。
+var ErrFindings = errors.New("found findings at or above the fail-on severity")
+
+// Error 为错误附加类别，main 根据类别决定退出码。
+type Error struct {
+	Kind ErrorKind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errorf 创建一个指定类别的错误。
+func Errorf(kind ErrorKind, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+// WrapError 为 err 附加类别；如果 err 已经带有类别，则保留原来的类别。
+func WrapError(kind ErrorKind, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// ExitCode 返回 err 对应的退出码，没有类别的错误被视为输入错误。
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, ErrFindings) {
+		return ExitFindings
+	}
+	var e *Error
+	if !errors.As(err, &e) {
+		return ExitInput
+	}
+	switch e.Kind {
+	case UnsupportedVersionError:
+		return ExitUnsupportedVersion
+	case ParseError:
+		return ExitParse
+	case AnalysisError:
+		return ExitAnalysis
+	case InstrumentationError:
+		return ExitInstrumentation
+	default:
+		return ExitInput
+	}
+}
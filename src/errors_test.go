@@ -0,0 +1,42 @@
+package src
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{nil, ExitOK},
+		{fmt.Errorf("%w: [high]", ErrFindings), ExitFindings},
+		{errors.New("unknown flag"), ExitInput},
+		{Errorf(UnsupportedVersionError, "0.3"), ExitUnsupportedVersion},
+		{WrapError(ParseError, errors.New("bad json")), ExitParse},
+		{WrapError(AnalysisError, Errorf(ParseError, "strict mode")), ExitParse},
+		{fmt.Errorf("wrapped: %w", Errorf(InstrumentationError, "write")), ExitInstrumentation},
+	}
+
+	for _, c := range cases {
+		if code := ExitCode(c.err); code != c.code {
+			t.Errorf("ExitCode(%v) = %d, expected %d", c.err, code, c.code)
+		}
+	}
+}
+
+func TestShouldFail(t *testing.T) {
+	findings := []*Finding{{Severity: SeverityMedium}}
+
+	for severity, expected := range map[string]bool{"none": false, "low": true, "medium": true, "high": false} {
+		threshold, err := ParseSeverity(severity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ShouldFail(findings, threshold) != expected {
+			t.Errorf("ShouldFail with threshold [%s] should be %v", severity, expected)
+		}
+	}
+}
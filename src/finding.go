@@ -0,0 +1,124 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity 表示检测结果的严重程度，数值越大越严重。
+type Severity int
+
+const (
+	SeverityInfo Severity = iota + 1
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	default:
+		return "none"
+	}
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseSeverity 解析 info、low、medium、high；none 返回 0，表示任何检测结果都不会导致失败。
+func ParseSeverity(severity string) (Severity, error) {
+	switch strings.ToLower(severity) {
+	case "none":
+		return 0, nil
+	case "info":
+		return SeverityInfo, nil
+	case "low":
+		return SeverityLow, nil
+	case "medium":
+		return SeverityMedium, nil
+	case "high":
+		return SeverityHigh, nil
+	default:
+		return 0, fmt.Errorf("unknown severity [%s], expected one of none, info, low, medium, high", severity)
+	}
+}
+
+// Finding 是一条检测结果。
+type Finding struct {
+	Detector   string     `json:"detector"`
+	Severity   Severity   `json:"severity"`
+	Confidence Confidence `json:"confidence"`
+	Contract   string     `json:"contract"`
+	Function   string     `json:"function"`
+	Message    string     `json:"message"`
+	// Entries 为能够到达该函数的外部入口（包括构造函数）及其调用链，Guards 为沿途检查调用者身份的修饰器、函数或语句；
+	// Unreachable 表示没有任何入口能够到达该函数，此时严重程度被降为 info。
+	Entries     []string `json:"entries,omitempty"`
+	Guards      []string `json:"guards,omitempty"`
+	Unreachable bool     `json:"unreachable,omitempty"`
+	// Explanation 说明该结果是如何得出的，只有插桩相关的检测器会填写。
+	Explanation *Explanation `json:"explanation,omitempty"`
+}
+
+// Explanation 说明一条检测结果：从哪个外部入口经过哪些内部调用到达 delegatecall，目标地址与 calldata 从哪里来，
+// 调用者的哪些 storage 槽可能被覆盖，检查被插入在哪里、为什么，以及增加的 gas。
+type Explanation struct {
+	Entry    string   `json:"entry,omitempty"`
+	Calls    []string `json:"calls,omitempty"` // 从入口到 delegatecall 所在函数的调用链，包括两端
+	Target   string   `json:"target,omitempty"`
+	Calldata string   `json:"calldata,omitempty"`
+	Slots    []string `json:"slots,omitempty"`
+	Guard    string   `json:"guard,omitempty"`
+	Gas      string   `json:"gas,omitempty"` // 插入的检查增加的 gas 估计值
+}
+
+// String 返回多行的说明，每行以两个空格缩进，空的字段被省略。
+func (e *Explanation) String() string {
+	var lines []string
+	add := func(name string, value string) {
+		if value != "" {
+			lines = append(lines, fmt.Sprintf("  %s: %s", name, value))
+		}
+	}
+	add("entry", e.Entry)
+	add("calls", strings.Join(e.Calls, " -> "))
+	add("target", e.Target)
+	add("calldata", e.Calldata)
+	add("slots", strings.Join(e.Slots, "; "))
+	add("guard", e.Guard)
+	add("gas", e.Gas)
+	return strings.Join(lines, "\n")
+}
+
+func (f *Finding) String() string {
+	s := fmt.Sprintf("[%s] %s %s: %s", f.Severity, f.Detector, f.Function, f.Message)
+	switch {
+	case f.Unreachable:
+		s += " [unreachable]"
+	case len(f.Entries) > 0:
+		s += fmt.Sprintf(" [entries: %s]", strings.Join(f.Entries, "; "))
+	}
+	return s
+}
+
+// ShouldFail 判断是否存在严重程度不低于 threshold 的检测结果，threshold 为 0 时始终返回 false。
+func ShouldFail(findings []*Finding, threshold Severity) bool {
+	if threshold == 0 {
+		return false
+	}
+	for _, finding := range findings {
+		if finding.Severity >= threshold {
+			return true
+		}
+	}
+	return false
+}
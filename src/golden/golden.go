@@ -0,0 +1,139 @@
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/ir"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// update 为 -update 参数，加上它时重新生成 golden 文件。参数只在引用了本包的测试中注册，因此只能传给这些包：
+//
+//	go test ./src/v0.4 ./src/v0.5 ./src/v0.6 ./src/v0.8/... -update
+var update = flag.Bool("update", false, "regenerate golden files")
+
+// NewLogger 返回一个不带时间戳的日志记录器，所有日志写入 w，用于对检测结果做快照。
+func NewLogger(w io.Writer) logging.Logger {
+	return logging.MustNewLogger(logging.Option{
+		Module:         "Golden",
+		FilterLevel:    logging.InfoLevel,
+		Spec:           "[%{level}] %{message}",
+		FormatSelector: "terminal",
+		Writer:         w,
+	})
+}
+
+// Load 读取语法树文件 path，并像 main 一样返回预加载的语法树以及在其上构建的过程间分析。
+func Load(t *testing.T, path string) (jsoniter.Any, *analysis.Analysis) {
+	t.Helper()
+
+	jsonBytes, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := src.Preload(jsoniter.Get(jsonBytes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source, analysis.New(ir.Build(source))
+}
+
+// Findings 将检测结果（以及其说明）与日志拼接在一起；生成 png 依赖本地是否安装了 graphviz，与之相关的日志被过滤掉。
+func Findings(log []byte, findings []*src.Finding) []byte {
+	var buf bytes.Buffer
+	for _, line := range strings.Split(string(log), "\n") {
+		if line == "" || strings.Contains(line, ".png") {
+			continue
+		}
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	for _, finding := range findings {
+		buf.WriteString(finding.String())
+		buf.WriteString("\n")
+		if finding.Explanation != nil && finding.Explanation.String() != "" {
+			buf.WriteString(finding.Explanation.String())
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes()
+}
+
+// CallGraph 按照函数 id 的顺序拼接 dir 下生成的所有 dot 文件。
+func CallGraph(dir string) ([]byte, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.gv"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dot files: [%v]", err)
+	}
+
+	ids := make([]int, 0, len(files))
+	for _, file := range files {
+		var id int
+		if _, err = fmt.Sscanf(filepath.Base(file), "%d.gv", &id); err != nil {
+			return nil, fmt.Errorf("failed to parse dot file name [%s]: [%v]", file, err)
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var buf bytes.Buffer
+	for _, id := range ids {
+		content, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("%d.gv", id)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read dot file [%d.gv]: [%v]", id, err)
+		}
+		fmt.Fprintf(&buf, "// %d.gv\n", id)
+		buf.Write(content)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Assert 将 actual 与 golden 文件 path 的内容做比较，加上 -update 时直接覆盖 golden 文件。
+func Assert(t *testing.T, path string, actual []byte) {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, actual, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file [%s]: [%v], run with -update to create it", path, err)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("[%s] mismatch at line %d, run with -update if the change is intended", path, firstDiffLine(expected, actual))
+	}
+}
+
+func firstDiffLine(expected []byte, actual []byte) int {
+	e := strings.Split(string(expected), "\n")
+	a := strings.Split(string(actual), "\n")
+	for i := 0; i < len(e) && i < len(a); i++ {
+		if e[i] != a[i] {
+			return i + 1
+		}
+	}
+	if len(e) < len(a) {
+		return len(e) + 1
+	}
+	return len(a) + 1
+}
@@ -0,0 +1,199 @@
+package graph
+
+import (
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	entryNode = 0
+	exitNode  = 1
+)
+
+// pending 表示一条还没有确定终点的边。
+type pending struct {
+	from  int
+	label string
+}
+
+type loop struct {
+	breaks    []pending
+	continues []pending
+}
+
+type cfgBuilder struct {
+	g      *Graph
+	source []byte
+	loops  []*loop
+}
+
+// CFG 为 SourceUnit 中每个有函数体的函数和修饰器构造语句级别的控制流图，source 为原始源码，可以为 nil。
+func CFG(sourceUnit jsoniter.Any, source []byte) []*Graph {
+	var graphs []*Graph
+
+	nodes := sourceUnit.Get("nodes")
+	for i := 0; i < nodes.Size(); i++ {
+		contract := nodes.Get(i)
+		if contract.Get("nodeType").ToString() != "ContractDefinition" {
+			continue
+		}
+		contractNodes := contract.Get("nodes")
+		for j := 0; j < contractNodes.Size(); j++ {
+			node := contractNodes.Get(j)
+			nodeType := node.Get("nodeType").ToString()
+			if nodeType != "FunctionDefinition" && nodeType != "ModifierDefinition" {
+				continue
+			}
+			if isNull(node.Get("body")) {
+				continue
+			}
+			graphs = append(graphs, FunctionCFG(contract.Get("name").ToString(), node, source))
+		}
+	}
+
+	return graphs
+}
+
+// FunctionCFG 构造单个函数或修饰器的控制流图，0 号节点为入口，1 号节点为出口。
+func FunctionCFG(contractName string, function jsoniter.Any, source []byte) *Graph {
+	name := function.Get("name").ToString()
+	if name == "" {
+		if kind := function.Get("kind").ToString(); kind != "" && kind != "function" {
+			name = kind
+		} else if function.Get("isConstructor").ToBool() {
+			name = "constructor"
+		} else {
+			name = "fallback"
+		}
+	}
+
+	b := &cfgBuilder{g: &Graph{ID: function.Get("id").ToInt(), Name: fmt.Sprintf("%s.%s", contractName, name)}, source: source}
+	b.g.addNode("ENTRY")
+	b.g.addNode("EXIT")
+
+	out := b.statement(function.Get("body"), []pending{{from: entryNode}})
+	b.connect(out, exitNode)
+
+	return b.g
+}
+
+func (b *cfgBuilder) add(label string, in []pending) int {
+	id := b.g.addNode(label)
+	b.connect(in, id)
+	return id
+}
+
+func (b *cfgBuilder) connect(in []pending, to int) {
+	for _, p := range in {
+		b.g.addEdge(p.from, to, p.label)
+	}
+}
+
+// statement 将 raw 接到 in 之后，返回从 raw 正常流出的边。
+func (b *cfgBuilder) statement(raw jsoniter.Any, in []pending) []pending {
+	if isNull(raw) {
+		return in
+	}
+
+	switch raw.Get("nodeType").ToString() {
+	case "Block", "UncheckedBlock":
+		statements := raw.Get("statements")
+		for i := 0; i < statements.Size(); i++ {
+			in = b.statement(statements.Get(i), in)
+		}
+		return in
+	case "IfStatement":
+		condition := b.add(fmt.Sprintf("if (%s)", snippet(b.source, raw.Get("condition"))), in)
+		out := b.statement(raw.Get("trueBody"), []pending{{from: condition, label: "true"}})
+		return append(out, b.statement(raw.Get("falseBody"), []pending{{from: condition, label: "false"}})...)
+	case "WhileStatement":
+		condition := b.add(fmt.Sprintf("while (%s)", snippet(b.source, raw.Get("condition"))), in)
+		l := b.enter()
+		b.connect(b.statement(raw.Get("body"), []pending{{from: condition, label: "true"}}), condition)
+		b.leave()
+		b.connect(l.continues, condition)
+		return append([]pending{{from: condition, label: "false"}}, l.breaks...)
+	case "DoWhileStatement":
+		do := b.add("do", in)
+		l := b.enter()
+		out := b.statement(raw.Get("body"), []pending{{from: do}})
+		b.leave()
+		condition := b.add(fmt.Sprintf("while (%s)", snippet(b.source, raw.Get("condition"))), append(out, l.continues...))
+		b.g.addEdge(condition, do, "true")
+		return append([]pending{{from: condition, label: "false"}}, l.breaks...)
+	case "ForStatement":
+		in = b.statement(raw.Get("initializationExpression"), in)
+		label := "for (;;)"
+		if !isNull(raw.Get("condition")) {
+			label = fmt.Sprintf("for (%s)", snippet(b.source, raw.Get("condition")))
+		}
+		condition := b.add(label, in)
+		l := b.enter()
+		out := b.statement(raw.Get("body"), []pending{{from: condition, label: "true"}})
+		b.leave()
+		out = append(out, l.continues...)
+		if loopExpression := raw.Get("loopExpression"); !isNull(loopExpression) {
+			out = []pending{{from: b.add(snippet(b.source, loopExpression), out)}}
+		}
+		b.connect(out, condition)
+		if isNull(raw.Get("condition")) {
+			return l.breaks
+		}
+		return append([]pending{{from: condition, label: "false"}}, l.breaks...)
+	case "Break":
+		node := b.add("break", in)
+		if len(b.loops) > 0 {
+			l := b.loops[len(b.loops)-1]
+			l.breaks = append(l.breaks, pending{from: node})
+		}
+		return nil
+	case "Continue":
+		node := b.add("continue", in)
+		if len(b.loops) > 0 {
+			l := b.loops[len(b.loops)-1]
+			l.continues = append(l.continues, pending{from: node})
+		}
+		return nil
+	case "Return", "Throw", "RevertStatement":
+		b.g.addEdge(b.add(snippet(b.source, raw), in), exitNode, "")
+		return nil
+	case "TryStatement":
+		try := b.add(fmt.Sprintf("try %s", snippet(b.source, raw.Get("externalCall"))), in)
+		var out []pending
+		clauses := raw.Get("clauses")
+		for i := 0; i < clauses.Size(); i++ {
+			label := "success"
+			if i > 0 {
+				label = "catch " + clauses.Get(i).Get("errorName").ToString()
+			}
+			out = append(out, b.statement(clauses.Get(i).Get("block"), []pending{{from: try, label: label}})...)
+		}
+		return out
+	case "ExpressionStatement":
+		node := b.add(snippet(b.source, raw), in)
+		expression := raw.Get("expression")
+		if expression.Get("nodeType").ToString() == "FunctionCall" && expression.Get("expression").Get("nodeType").ToString() == "Identifier" {
+			switch expression.Get("expression").Get("name").ToString() {
+			case "revert":
+				b.g.addEdge(node, exitNode, "revert")
+				return nil
+			case "require", "assert":
+				b.g.addEdge(node, exitNode, "revert")
+			}
+		}
+		return []pending{{from: node}}
+	default:
+		return []pending{{from: b.add(snippet(b.source, raw), in)}}
+	}
+}
+
+func (b *cfgBuilder) enter() *loop {
+	l := &loop{}
+	b.loops = append(b.loops, l)
+	return l
+}
+
+func (b *cfgBuilder) leave() {
+	b.loops = b.loops[:len(b.loops)-1]
+}
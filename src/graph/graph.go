@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/goccy/go-graphviz"
+	"github.com/goccy/go-graphviz/cgraph"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Node 是图中的一个节点，Label 为展示给用户的文本。
+type Node struct {
+	ID    int
+	Label string
+}
+
+// Edge 是图中的一条有向边。
+type Edge struct {
+	From  int
+	To    int
+	Label string
+}
+
+// Graph 与 graphviz 无关，便于测试；Render 负责将其输出为 dot 文件。
+type Graph struct {
+	ID    int
+	Name  string
+	Nodes []*Node
+	Edges []*Edge
+}
+
+func (g *Graph) addNode(label string) int {
+	id := len(g.Nodes)
+	g.Nodes = append(g.Nodes, &Node{ID: id, Label: label})
+	return id
+}
+
+func (g *Graph) addEdge(from int, to int, label string) {
+	g.Edges = append(g.Edges, &Edge{From: from, To: to, Label: label})
+}
+
+// Render 将 g 保存为 dir/name.gv，如果本地安装了 graphviz，再额外生成 dir/name.png。
+func Render(g *Graph, dir string, name string, logger logging.Logger) error {
+	if err := src.EnsureDir(dir); err != nil {
+		logger.Errorf("Failed to create directory [%s]: [%v].", dir, err)
+		return fmt.Errorf("failed to create directory [%s]: [%v]", dir, err)
+	}
+
+	gv := graphviz.New()
+	graph, err := gv.Graph()
+	if err != nil {
+		logger.Errorf("Failed to make graph: [%v].", err)
+		return fmt.Errorf("failed to make graph: [%v]", err)
+	}
+	defer func() {
+		graph.Close()
+		gv.Close()
+	}()
+
+	nodes := make([]*cgraph.Node, len(g.Nodes))
+	for i, node := range g.Nodes {
+		if nodes[i], err = graph.CreateNode(fmt.Sprintf("n%d", node.ID)); err != nil {
+			logger.Errorf("Adding node failed: [%v].", err)
+			return fmt.Errorf("adding node failed: [%v]", err)
+		}
+		nodes[i].SetLabel(node.Label)
+		nodes[i].SetShape(cgraph.BoxShape)
+	}
+	for i, edge := range g.Edges {
+		e, err := graph.CreateEdge(fmt.Sprintf("e%d", i), nodes[edge.From], nodes[edge.To])
+		if err != nil {
+			logger.Errorf("Adding edge failed: [%v].", err)
+			return fmt.Errorf("adding edge failed: [%v]", err)
+		}
+		if edge.Label != "" {
+			e.SetLabel(edge.Label)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = gv.Render(graph, "dot", &buf); err != nil {
+		logger.Errorf("Failed to render graph: [%v].", err)
+		return fmt.Errorf("failed to render graph: [%v]", err)
+	}
+
+	gvFile := filepath.Join(dir, name+".gv")
+	if err = os.WriteFile(gvFile, buf.Bytes(), 0666); err != nil {
+		logger.Errorf("Failed to save dot file [%s]: [%v].", gvFile, err)
+		return fmt.Errorf("failed to save dot file [%s]: [%v]", gvFile, err)
+	}
+
+	pngFile := filepath.Join(dir, name+".png")
+	if err = exec.Command("dot", gvFile, "-T", "png", "-o", pngFile).Run(); err != nil {
+		logger.Warnf("Failed to generate file [%s], is graphviz installed? [%v].", pngFile, err)
+		logger.Infof("Successfully generate graph [%s] => [%s.gv].", g.Name, name)
+		return nil
+	}
+
+	logger.Infof("Successfully generate graph [%s] => [%s.png | %s.gv].", g.Name, name, name)
+
+	return nil
+}
+
+// snippet 根据节点的 src 属性（start:length:file）截取原始源码；没有源码时返回节点类型。
+func snippet(source []byte, raw jsoniter.Any) string {
+	nodeType := raw.Get("nodeType").ToString()
+	if source == nil {
+		return nodeType
+	}
+
+	var start, length, file int
+	if _, err := fmt.Sscanf(raw.Get("src").ToString(), "%d:%d:%d", &start, &length, &file); err != nil || start < 0 || length < 0 || start+length > len(source) {
+		return nodeType
+	}
+
+	text := strings.Join(strings.Fields(string(source[start:start+length])), " ")
+	if len(text) > 80 {
+		text = text[:77] + "..."
+	}
+	return text
+}
+
+func isNull(raw jsoniter.Any) bool {
+	return raw.ValueType() == jsoniter.InvalidValue || raw.ValueType() == jsoniter.NilValue
+}
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func edges(g *Graph) []string {
+	var result []string
+	for _, edge := range g.Edges {
+		result = append(result, fmt.Sprintf("%s -%s-> %s", g.Nodes[edge.From].Label, edge.Label, g.Nodes[edge.To].Label))
+	}
+	return result
+}
+
+func TestFunctionCFG(t *testing.T) {
+	// function f(uint x) { while (x > 0) { if (x == 1) break; x--; } require(x == 0); return; }
+	function := jsoniter.Get([]byte(`{"nodeType":"FunctionDefinition","id":7,"name":"f","body":{"nodeType":"Block","statements":[
+		{"nodeType":"WhileStatement","condition":{"nodeType":"BinaryOperation"},"body":{"nodeType":"Block","statements":[
+			{"nodeType":"IfStatement","condition":{"nodeType":"BinaryOperation"},"trueBody":{"nodeType":"Break"},"falseBody":null},
+			{"nodeType":"ExpressionStatement","expression":{"nodeType":"UnaryOperation"}}]}},
+		{"nodeType":"ExpressionStatement","expression":{"nodeType":"FunctionCall","expression":{"nodeType":"Identifier","name":"require"}}},
+		{"nodeType":"Return"}]}}`))
+
+	g := FunctionCFG("C", function, nil)
+	if g.ID != 7 || g.Name != "C.f" {
+		t.Fatalf("unexpected graph [%d] [%s]", g.ID, g.Name)
+	}
+
+	expected := []string{
+		"ENTRY --> while (BinaryOperation)",
+		"while (BinaryOperation) -true-> if (BinaryOperation)",
+		"if (BinaryOperation) -true-> break",
+		"if (BinaryOperation) -false-> ExpressionStatement",
+		"ExpressionStatement --> while (BinaryOperation)",
+		"while (BinaryOperation) -false-> ExpressionStatement",
+		"break --> ExpressionStatement",
+		"ExpressionStatement -revert-> EXIT",
+		"ExpressionStatement --> Return",
+		"Return --> EXIT",
+	}
+	if actual := edges(g); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	source := []byte("contract C {\n    function f() public {\n        x = 1;\n    }\n}")
+	raw := jsoniter.Get([]byte(`{"nodeType":"FunctionDefinition","src":"17:42:0"}`))
+	if actual := snippet(source, raw); actual != "function f() public { x = 1; }" {
+		t.Fatalf("unexpected snippet [%s]", actual)
+	}
+	raw = jsoniter.Get([]byte(`{"nodeType":"FunctionDefinition","src":"17:450:0"}`))
+	if actual := snippet(source, raw); actual != "FunctionDefinition" {
+		t.Fatalf("unexpected snippet [%s]", actual)
+	}
+}
+
+func TestInheritance(t *testing.T) {
+	sourceUnit := jsoniter.Get([]byte(`{"nodeType":"SourceUnit","nodes":[
+		{"nodeType":"ContractDefinition","id":1,"name":"I","contractKind":"interface","baseContracts":[]},
+		{"nodeType":"ContractDefinition","id":2,"name":"A","contractKind":"contract","abstract":true,"baseContracts":[{"baseName":{"name":"I","referencedDeclaration":1}}]},
+		{"nodeType":"ContractDefinition","id":3,"name":"B","contractKind":"contract","baseContracts":[{"baseName":{"name":"A","referencedDeclaration":2}},{"baseName":{"name":"Missing","referencedDeclaration":9}}]}]}`))
+
+	expected := []string{"abstract A --> interface I", "B --> abstract A", "B --> Missing"}
+	if actual := edges(Inheritance(sourceUnit)); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
@@ -0,0 +1,51 @@
+package graph
+
+import (
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Inheritance 根据 SourceUnit 中所有 ContractDefinition 的 baseContracts 构造继承关系图，边由子合约指向父合约。
+func Inheritance(sourceUnit jsoniter.Any) *Graph {
+	g := &Graph{Name: "inheritance"}
+
+	nodes := sourceUnit.Get("nodes")
+	contracts := make(map[int]int)
+	names := make(map[string]int)
+	for i := 0; i < nodes.Size(); i++ {
+		node := nodes.Get(i)
+		if node.Get("nodeType").ToString() != "ContractDefinition" {
+			continue
+		}
+		label := node.Get("name").ToString()
+		if kind := node.Get("contractKind").ToString(); kind != "" && kind != "contract" {
+			label = kind + " " + label
+		} else if node.Get("abstract").ToBool() {
+			label = "abstract " + label
+		}
+		id := g.addNode(label)
+		contracts[node.Get("id").ToInt()] = id
+		names[node.Get("name").ToString()] = id
+	}
+
+	for i := 0; i < nodes.Size(); i++ {
+		node := nodes.Get(i)
+		if node.Get("nodeType").ToString() != "ContractDefinition" {
+			continue
+		}
+		derived := contracts[node.Get("id").ToInt()]
+		baseContracts := node.Get("baseContracts")
+		for j := 0; j < baseContracts.Size(); j++ {
+			baseName := baseContracts.Get(j).Get("baseName")
+			base, ok := contracts[baseName.Get("referencedDeclaration").ToInt()]
+			if !ok {
+				if base, ok = names[baseName.Get("name").ToString()]; !ok {
+					base = g.addNode(baseName.Get("name").ToString())
+					names[baseName.Get("name").ToString()] = base
+				}
+			}
+			g.addEdge(derived, base, "")
+		}
+	}
+
+	return g
+}
@@ -0,0 +1,1417 @@
+package ir
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/types"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Build 将 sourceUnit 中所有合约的函数与修饰器降低为 SSA 形式的中间表示，没有函数体的函数被忽略。
+// 文件级的自由函数放在一个名字为空的合约中。
+func Build(sourceUnit jsoniter.Any) *Program {
+	// 惰性解析的 Any 每次 Get、Size 都要重新扫描数组，先完整解析一次，避免降低大文件时耗时成倍增长；
+	// src.Tree 在下标与值的类型不匹配时返回无效值，jsoniter.Wrap 对 map 使用整数下标会 panic。
+	sourceUnit = src.Tree(sourceUnit)
+	p := &Program{functions: make(map[int]*Function), variables: make(map[int]*Variable)}
+	d := &declarations{functions: make(map[int]bool), events: make(map[int]bool)}
+
+	nodes := sourceUnit.Get("nodes")
+	free := &Contract{Kind: "file"}
+	for i := 0; i < nodes.Size(); i++ {
+		node := nodes.Get(i)
+		switch node.Get("nodeType").ToString() {
+		case "ContractDefinition":
+			c := &Contract{ID: node.Get("id").ToInt(), Name: node.Get("name").ToString(), Kind: node.Get("contractKind").ToString()}
+			bases := node.Get("linearizedBaseContracts")
+			for j := 0; j < bases.Size(); j++ {
+				c.Bases = append(c.Bases, bases.Get(j).ToInt())
+			}
+			members := node.Get("nodes")
+			for j := 0; j < members.Size(); j++ {
+				member := members.Get(j)
+				switch member.Get("nodeType").ToString() {
+				case "VariableDeclaration":
+					v := &Variable{
+						ID:        member.Get("id").ToInt(),
+						Name:      member.Get("name").ToString(),
+						Contract:  c.Name,
+						Type:      typeOf(member),
+						Constant:  member.Get("constant").ToBool() || member.Get("mutability").ToString() == "constant",
+						Immutable: member.Get("mutability").ToString() == "immutable",
+						Private:   member.Get("visibility").ToString() == "private",
+					}
+					if value := member.Get("value"); v.Constant && value.Get("nodeType").ToString() == "Literal" {
+						v.Value = value.Get("value").ToString()
+					}
+					c.Variables = append(c.Variables, v)
+					p.variables[v.ID] = v
+				case "FunctionDefinition", "ModifierDefinition":
+					d.functions[member.Get("id").ToInt()] = true
+				case "EventDefinition":
+					d.events[member.Get("id").ToInt()] = true
+				}
+			}
+			p.Contracts = append(p.Contracts, c)
+		case "FunctionDefinition":
+			d.functions[node.Get("id").ToInt()] = true
+		case "EventDefinition":
+			d.events[node.Get("id").ToInt()] = true
+		}
+	}
+
+	contracts := 0
+	for i := 0; i < nodes.Size(); i++ {
+		node := nodes.Get(i)
+		switch node.Get("nodeType").ToString() {
+		case "ContractDefinition":
+			c := p.Contracts[contracts]
+			contracts++
+			members := node.Get("nodes")
+			for j := 0; j < members.Size(); j++ {
+				member := members.Get(j)
+				nodeType := member.Get("nodeType").ToString()
+				if (nodeType == "FunctionDefinition" || nodeType == "ModifierDefinition") && !isNull(member.Get("body")) {
+					f := lower(p, d, c.Name, member)
+					c.Functions = append(c.Functions, f)
+					p.functions[f.ID] = f
+				}
+			}
+		case "FunctionDefinition":
+			if !isNull(node.Get("body")) {
+				f := lower(p, d, "", node)
+				free.Functions = append(free.Functions, f)
+				p.functions[f.ID] = f
+			}
+		}
+	}
+	if len(free.Functions) > 0 {
+		p.Contracts = append(p.Contracts, free)
+	}
+
+	return p
+}
+
+// declarations 记录 SourceUnit 中可以被调用的函数、修饰器以及事件的声明 id。
+type declarations struct {
+	functions map[int]bool
+	events    map[int]bool
+}
+
+type local struct {
+	name string
+	typ  *types.Type
+}
+
+type loop struct {
+	breakTo    *Block
+	continueTo *Block
+}
+
+// builder 在降低语法树的同时用 Braun 等人的算法（Simple and Efficient Construction of SSA Form）构造 SSA：
+// 基本块的前驱全部确定之后才被 seal，在此之前读取的变量先生成不完整的 phi，seal 时再补全参数并删除多余的 phi。
+type builder struct {
+	program      *Program
+	declarations *declarations
+	function     *Function
+	current      *Block
+	blocks       int
+	values       int
+
+	locals     map[int]*local
+	returns    []int
+	loops      []*loop
+	defs       map[int]map[*Block]*Value
+	incomplete map[*Block]map[int]*Value
+	replaced   map[*Value]*Value
+}
+
+func lower(p *Program, d *declarations, contract string, node jsoniter.Any) *Function {
+	f := &Function{
+		ID:              node.Get("id").ToInt(),
+		Name:            node.Get("name").ToString(),
+		Contract:        contract,
+		Kind:            node.Get("kind").ToString(),
+		Visibility:      node.Get("visibility").ToString(),
+		StateMutability: node.Get("stateMutability").ToString(),
+	}
+	switch {
+	case node.Get("nodeType").ToString() == "ModifierDefinition":
+		f.Kind = "modifier"
+	case f.Kind == "" && node.Get("isConstructor").ToBool():
+		f.Kind = "constructor"
+	case f.Kind == "" && f.Name == "":
+		f.Kind = "fallback"
+	case f.Kind == "":
+		f.Kind = "function"
+	}
+	if f.Name == "" {
+		f.Name = f.Kind
+	}
+	f.Signature = signature(contract, node)
+
+	b := &builder{
+		program:      p,
+		declarations: d,
+		function:     f,
+		locals:       make(map[int]*local),
+		defs:         make(map[int]map[*Block]*Value),
+		incomplete:   make(map[*Block]map[int]*Value),
+		replaced:     make(map[*Value]*Value),
+	}
+	entry := b.newBlock()
+	b.seal(entry)
+	b.enter(entry)
+
+	parameters := node.Get("parameters").Get("parameters")
+	for i := 0; i < parameters.Size(); i++ {
+		parameter := parameters.Get(i)
+		id := b.declare(parameter)
+		v := b.define(&Instr{Op: OpParam, Index: i}, parameter, parameter.Get("name").ToString(), typeOf(parameter))
+		b.write(id, b.current, v)
+		f.Params = append(f.Params, v)
+	}
+	returns := node.Get("returnParameters").Get("parameters")
+	for i := 0; i < returns.Size(); i++ {
+		parameter := returns.Get(i)
+		id := b.declare(parameter)
+		b.returns = append(b.returns, id)
+		b.write(id, b.current, b.zero(parameter, parameter.Get("name").ToString(), typeOf(parameter)))
+	}
+
+	modifiers := node.Get("modifiers")
+	for i := 0; i < modifiers.Size(); i++ {
+		modifier := modifiers.Get(i)
+		args := b.expressions(modifier.Get("arguments"))
+		name := modifier.Get("modifierName")
+		b.emit(&Instr{Op: OpModifier, Name: name.Get("name").ToString(), Callee: name.Get("referencedDeclaration").ToInt(), Args: args}, modifier)
+	}
+
+	b.statement(node.Get("body"))
+	if b.current != nil {
+		b.emit(&Instr{Op: OpReturn, Args: b.returnValues(jsoniter.Wrap(nil))}, node.Get("body"))
+		b.current = nil
+	}
+
+	// 删除多余的 phi 后值的编号不再连续，按照指令的顺序重新编号。
+	id := 0
+	for _, block := range f.Blocks {
+		for _, in := range block.Instrs {
+			if in.Dest != nil {
+				id++
+				in.Dest.ID = id
+			}
+		}
+	}
+
+	return f
+}
+
+func (b *builder) newBlock() *Block {
+	return &Block{Index: -1, Function: b.function}
+}
+
+// enter 将 block 设为当前基本块，第一次进入时才把它加入函数，没有前驱的基本块（如两个分支都已返回后的汇合点）不会出现在函数中。
+func (b *builder) enter(block *Block) {
+	if block.Index < 0 {
+		block.Index = b.blocks
+		b.blocks++
+		b.function.Blocks = append(b.function.Blocks, block)
+	}
+	b.current = block
+}
+
+// reachable 在 block 有前驱时进入它，否则后续的语句不可达，不再生成指令。
+func (b *builder) reachable(block *Block) {
+	if len(block.Preds) > 0 {
+		b.enter(block)
+	} else {
+		b.current = nil
+	}
+}
+
+func (b *builder) seal(block *Block) {
+	phis := b.incomplete[block]
+	ids := make([]int, 0, len(phis))
+	for id := range phis {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		b.addPhiOperands(id, phis[id])
+	}
+	delete(b.incomplete, block)
+	block.sealed = true
+}
+
+func (b *builder) newValue(name string, typ *types.Type) *Value {
+	b.values++
+	return &Value{ID: b.values, Name: name, Type: typ}
+}
+
+func (b *builder) use(in *Instr, v *Value) {
+	if v == nil {
+		return
+	}
+	for _, u := range v.uses {
+		if u == in {
+			return
+		}
+	}
+	v.uses = append(v.uses, in)
+}
+
+func (b *builder) unuse(in *Instr, v *Value) {
+	if v == nil {
+		return
+	}
+	for i, u := range v.uses {
+		if u == in {
+			v.uses = append(v.uses[:i], v.uses[i+1:]...)
+			return
+		}
+	}
+}
+
+// emit 将 in 追加到当前基本块的末尾。
+func (b *builder) emit(in *Instr, node jsoniter.Any) *Instr {
+	in.Block = b.current
+	in.NodeID = node.Get("id").ToInt()
+	in.Src = node.Get("src").ToString()
+	for _, arg := range in.Args {
+		b.use(in, arg)
+	}
+	b.current.Instrs = append(b.current.Instrs, in)
+	return in
+}
+
+// define 追加一条有结果的指令并返回结果。
+func (b *builder) define(in *Instr, node jsoniter.Any, name string, typ *types.Type) *Value {
+	in.Dest = b.newValue(name, typ)
+	in.Dest.Def = in
+	b.emit(in, node)
+	return in.Dest
+}
+
+// insertFront 将 in 插入 block 中所有 phi 之后的位置。
+func (b *builder) insertFront(block *Block, in *Instr, phi bool) {
+	in.Block = block
+	for _, arg := range in.Args {
+		b.use(in, arg)
+	}
+	i := 0
+	for i < len(block.Instrs) && block.Instrs[i].Op == OpPhi {
+		i++
+	}
+	if !phi {
+		for i < len(block.Instrs) && block.Instrs[i].Op == OpConst && block.Instrs[i].Index < 0 {
+			i++
+		}
+	}
+	block.Instrs = append(block.Instrs, nil)
+	copy(block.Instrs[i+1:], block.Instrs[i:])
+	block.Instrs[i] = in
+}
+
+func (b *builder) jump(to *Block, node jsoniter.Any) {
+	if b.current == nil {
+		return
+	}
+	b.emit(&Instr{Op: OpJump}, node)
+	b.edge(b.current, to)
+	b.current = nil
+}
+
+func (b *builder) branch(cond *Value, then *Block, otherwise *Block, node jsoniter.Any) {
+	b.emit(&Instr{Op: OpBranch, Args: []*Value{cond}}, node)
+	b.edge(b.current, then)
+	b.edge(b.current, otherwise)
+	b.current = nil
+}
+
+func (b *builder) edge(from *Block, to *Block) {
+	from.Succs = append(from.Succs, to)
+	to.Preds = append(to.Preds, from)
+}
+
+func (b *builder) declare(node jsoniter.Any) int {
+	id := node.Get("id").ToInt()
+	b.locals[id] = &local{name: node.Get("name").ToString(), typ: typeOf(node)}
+	return id
+}
+
+func (b *builder) constant(value string, node jsoniter.Any, typ *types.Type) *Value {
+	return b.define(&Instr{Op: OpConst, Name: value}, node, "", typ)
+}
+
+// zero 生成局部变量的默认值。
+func (b *builder) zero(node jsoniter.Any, name string, typ *types.Type) *Value {
+	return b.define(&Instr{Op: OpConst, Name: "0"}, node, name, typ)
+}
+
+func (b *builder) write(id int, block *Block, v *Value) {
+	if b.defs[id] == nil {
+		b.defs[id] = make(map[*Block]*Value)
+	}
+	if v != nil && v.Name == "" && b.locals[id] != nil {
+		v.Name = b.locals[id].name
+	}
+	b.defs[id][block] = v
+}
+
+func (b *builder) read(id int, block *Block) *Value {
+	if v, ok := b.defs[id][block]; ok {
+		return b.resolve(v)
+	}
+	return b.readRecursive(id, block)
+}
+
+func (b *builder) resolve(v *Value) *Value {
+	for {
+		r, ok := b.replaced[v]
+		if !ok {
+			return v
+		}
+		v = r
+	}
+}
+
+func (b *builder) readRecursive(id int, block *Block) *Value {
+	var v *Value
+	switch {
+	case !block.sealed:
+		v = b.newPhi(id, block)
+		if b.incomplete[block] == nil {
+			b.incomplete[block] = make(map[int]*Value)
+		}
+		b.incomplete[block][id] = v
+	case len(block.Preds) == 1:
+		v = b.read(id, block.Preds[0])
+	case len(block.Preds) == 0:
+		v = b.undefined(id, block)
+	default:
+		phi := b.newPhi(id, block)
+		b.write(id, block, phi)
+		v = b.addPhiOperands(id, phi)
+	}
+	b.write(id, block, v)
+	return v
+}
+
+func (b *builder) newPhi(id int, block *Block) *Value {
+	l := b.locals[id]
+	in := &Instr{Op: OpPhi}
+	in.Dest = b.newValue(l.name, l.typ)
+	in.Dest.Def = in
+	b.insertFront(block, in, true)
+	return in.Dest
+}
+
+// undefined 在没有前驱的基本块中读取局部变量时生成它的默认值。
+func (b *builder) undefined(id int, block *Block) *Value {
+	l := b.locals[id]
+	in := &Instr{Op: OpConst, Name: "0", Index: -1}
+	in.Dest = b.newValue(l.name, l.typ)
+	in.Dest.Def = in
+	b.insertFront(block, in, false)
+	return in.Dest
+}
+
+func (b *builder) addPhiOperands(id int, phi *Value) *Value {
+	in := phi.Def
+	for _, pred := range in.Block.Preds {
+		arg := b.read(id, pred)
+		in.Args = append(in.Args, arg)
+		b.use(in, arg)
+	}
+	return b.tryRemoveTrivialPhi(id, phi)
+}
+
+// tryRemoveTrivialPhi 删除只合并了同一个值（以及它自身）的 phi，并用这个值替换 phi 的所有使用。
+func (b *builder) tryRemoveTrivialPhi(id int, phi *Value) *Value {
+	var same *Value
+	for _, arg := range phi.Def.Args {
+		if arg == same || arg == phi {
+			continue
+		}
+		if same != nil {
+			return phi
+		}
+		same = arg
+	}
+	block := phi.Def.Block
+	if same == nil {
+		same = b.undefined(id, block)
+	}
+
+	var users []*Instr
+	for _, u := range phi.uses {
+		if u != phi.Def {
+			users = append(users, u)
+		}
+	}
+	b.replace(phi, same)
+	for i, in := range block.Instrs {
+		if in == phi.Def {
+			block.Instrs = append(block.Instrs[:i], block.Instrs[i+1:]...)
+			break
+		}
+	}
+	phi.Def.Block = nil
+	for _, arg := range phi.Def.Args {
+		b.unuse(phi.Def, arg)
+	}
+
+	for _, u := range users {
+		if u.Op == OpPhi && u.Block != nil && u.Dest != nil {
+			b.tryRemoveTrivialPhi(id, u.Dest)
+		}
+	}
+	return same
+}
+
+func (b *builder) replace(old *Value, v *Value) {
+	b.replaced[old] = v
+	for _, u := range old.uses {
+		for i, arg := range u.Args {
+			if arg == old {
+				u.Args[i] = v
+			}
+		}
+		for i, access := range u.Path {
+			if access.Index == old {
+				u.Path[i].Index = v
+			}
+		}
+		if u != old.Def {
+			b.use(u, v)
+		}
+	}
+	old.uses = nil
+}
+
+// statement 降低一条语句，当前基本块为 nil 时语句不可达，直接忽略。
+func (b *builder) statement(raw jsoniter.Any) {
+	if isNull(raw) || b.current == nil {
+		return
+	}
+
+	switch raw.Get("nodeType").ToString() {
+	case "Block", "UncheckedBlock":
+		statements := raw.Get("statements")
+		for i := 0; i < statements.Size(); i++ {
+			b.statement(statements.Get(i))
+		}
+	case "VariableDeclarationStatement":
+		b.variableDeclaration(raw)
+	case "ExpressionStatement":
+		b.expression(raw.Get("expression"))
+	case "IfStatement":
+		cond := b.expression(raw.Get("condition"))
+		then, merge := b.newBlock(), b.newBlock()
+		otherwise := merge
+		if !isNull(raw.Get("falseBody")) {
+			otherwise = b.newBlock()
+		}
+		b.branch(cond, then, otherwise, raw)
+		b.seal(then)
+		b.enter(then)
+		b.statement(raw.Get("trueBody"))
+		if b.current != nil {
+			b.jump(merge, raw)
+		}
+		if otherwise != merge {
+			b.seal(otherwise)
+			b.enter(otherwise)
+			b.statement(raw.Get("falseBody"))
+			if b.current != nil {
+				b.jump(merge, raw)
+			}
+		}
+		b.seal(merge)
+		b.reachable(merge)
+	case "WhileStatement":
+		header, body, exit := b.newBlock(), b.newBlock(), b.newBlock()
+		b.jump(header, raw)
+		b.enter(header)
+		b.branch(b.expression(raw.Get("condition")), body, exit, raw)
+		b.seal(body)
+		b.loop(raw.Get("body"), body, exit, header)
+		if b.current != nil {
+			b.jump(header, raw)
+		}
+		b.seal(header)
+		b.seal(exit)
+		b.reachable(exit)
+	case "DoWhileStatement":
+		body, condition, exit := b.newBlock(), b.newBlock(), b.newBlock()
+		b.jump(body, raw)
+		b.loop(raw.Get("body"), body, exit, condition)
+		if b.current != nil {
+			b.jump(condition, raw)
+		}
+		b.seal(condition)
+		b.reachable(condition)
+		if b.current != nil {
+			b.branch(b.expression(raw.Get("condition")), body, exit, raw)
+		}
+		b.seal(body)
+		b.seal(exit)
+		b.reachable(exit)
+	case "ForStatement":
+		b.statement(raw.Get("initializationExpression"))
+		if b.current == nil {
+			return
+		}
+		header, body, next, exit := b.newBlock(), b.newBlock(), b.newBlock(), b.newBlock()
+		b.jump(header, raw)
+		b.enter(header)
+		if condition := raw.Get("condition"); !isNull(condition) {
+			b.branch(b.expression(condition), body, exit, raw)
+		} else {
+			b.jump(body, raw)
+		}
+		b.seal(body)
+		b.loop(raw.Get("body"), body, exit, next)
+		if b.current != nil {
+			b.jump(next, raw)
+		}
+		b.seal(next)
+		b.reachable(next)
+		b.statement(raw.Get("loopExpression"))
+		if b.current != nil {
+			b.jump(header, raw)
+		}
+		b.seal(header)
+		b.seal(exit)
+		b.reachable(exit)
+	case "Break":
+		if len(b.loops) > 0 {
+			b.jump(b.loops[len(b.loops)-1].breakTo, raw)
+		}
+	case "Continue":
+		if len(b.loops) > 0 {
+			b.jump(b.loops[len(b.loops)-1].continueTo, raw)
+		}
+	case "Return":
+		b.emit(&Instr{Op: OpReturn, Args: b.returnValues(raw.Get("expression"))}, raw)
+		b.current = nil
+	case "Throw":
+		b.emit(&Instr{Op: OpRevert}, raw)
+		b.current = nil
+	case "RevertStatement":
+		call := raw.Get("errorCall")
+		b.emit(&Instr{Op: OpRevert, Name: calleeName(call.Get("expression")), Args: b.expressions(call.Get("arguments"))}, raw)
+		b.current = nil
+	case "EmitStatement":
+		call := raw.Get("eventCall")
+		b.emit(&Instr{Op: OpEmit, Name: calleeName(call.Get("expression")), Args: b.expressions(call.Get("arguments"))}, raw)
+	case "PlaceholderStatement":
+		b.emit(&Instr{Op: OpPlaceholder}, raw)
+	case "InlineAssembly":
+		b.assembly(raw)
+	case "TryStatement":
+		b.try(raw)
+	default:
+		b.expression(raw)
+	}
+}
+
+// loop 在 body 基本块中降低循环体，break 跳转到 breakTo，continue 跳转到 continueTo。
+func (b *builder) loop(raw jsoniter.Any, body *Block, breakTo *Block, continueTo *Block) {
+	b.loops = append(b.loops, &loop{breakTo: breakTo, continueTo: continueTo})
+	b.enter(body)
+	b.statement(raw)
+	b.loops = b.loops[:len(b.loops)-1]
+}
+
+func (b *builder) variableDeclaration(raw jsoniter.Any) {
+	declarations := raw.Get("declarations")
+	initialValue := raw.Get("initialValue")
+
+	if declarations.Size() == 1 {
+		declaration := declarations.Get(0)
+		id := b.declare(declaration)
+		var v *Value
+		if isNull(initialValue) {
+			v = b.zero(declaration, declaration.Get("name").ToString(), typeOf(declaration))
+		} else {
+			v = b.expression(initialValue)
+		}
+		b.write(id, b.current, v)
+		return
+	}
+
+	values := b.components(initialValue, declarations.Size())
+	for i := 0; i < declarations.Size(); i++ {
+		declaration := declarations.Get(i)
+		if isNull(declaration) {
+			continue
+		}
+		b.write(b.declare(declaration), b.current, values[i])
+	}
+}
+
+// components 将元组表达式或者返回多个值的调用拆分为 n 个值，被省略的元素为 nil。
+func (b *builder) components(raw jsoniter.Any, n int) []*Value {
+	values := make([]*Value, n)
+	if raw.Get("nodeType").ToString() == "TupleExpression" && raw.Get("components").Size() == n {
+		components := raw.Get("components")
+		for i := 0; i < n; i++ {
+			if !isNull(components.Get(i)) {
+				values[i] = b.expression(components.Get(i))
+			}
+		}
+		return values
+	}
+
+	tuple := b.expression(raw)
+	var elements []*types.Type
+	if tuple != nil && tuple.Type != nil {
+		elements = tuple.Type.Components
+	}
+	for i := 0; i < n; i++ {
+		var typ *types.Type
+		if i < len(elements) {
+			typ = elements[i]
+		}
+		values[i] = b.define(&Instr{Op: OpExtract, Index: i, Args: []*Value{tuple}}, raw, "", typ)
+	}
+	return values
+}
+
+func (b *builder) returnValues(raw jsoniter.Any) []*Value {
+	if isNull(raw) {
+		values := make([]*Value, len(b.returns))
+		for i, id := range b.returns {
+			values[i] = b.read(id, b.current)
+		}
+		return values
+	}
+	if len(b.returns) > 1 {
+		return b.components(raw, len(b.returns))
+	}
+	return []*Value{b.expression(raw)}
+}
+
+func (b *builder) try(raw jsoniter.Any) {
+	call := b.expression(raw.Get("externalCall"))
+	b.emit(&Instr{Op: OpTry, Args: []*Value{call}}, raw)
+	from := b.current
+	b.current = nil
+
+	exit := b.newBlock()
+	clauses := raw.Get("clauses")
+	for i := 0; i < clauses.Size(); i++ {
+		clause := clauses.Get(i)
+		block := b.newBlock()
+		b.edge(from, block)
+		b.seal(block)
+		b.enter(block)
+		parameters := clause.Get("parameters").Get("parameters")
+		for j := 0; j < parameters.Size(); j++ {
+			parameter := parameters.Get(j)
+			id := b.declare(parameter)
+			var v *Value
+			switch {
+			case i > 0:
+				// catch 子句的参数来自外部调用回滚时返回的数据。
+				v = b.define(&Instr{Op: OpEnv, Name: "returndata"}, parameter, "", typeOf(parameter))
+			case parameters.Size() == 1:
+				v = call
+			default:
+				v = b.define(&Instr{Op: OpExtract, Index: j, Args: []*Value{call}}, parameter, "", typeOf(parameter))
+			}
+			b.write(id, b.current, v)
+		}
+		b.statement(clause.Get("block"))
+		if b.current != nil {
+			b.jump(exit, clause)
+		}
+	}
+	b.seal(exit)
+	b.reachable(exit)
+}
+
+// assembly 将内联汇编作为一条不透明的指令，汇编中引用的局部变量都可能被修改，因此在其后重新定义。
+// 能够解析的汇编中的 sload、sstore 与底层调用会在这条指令之前单独降低，只在汇编顶层被赋值的局部变量直接用所赋的值重新定义。
+func (b *builder) assembly(raw jsoniter.Any) {
+	var assigned map[int]*Value
+	var nested map[int]bool
+	block, parsed := yulBlock(raw)
+	if parsed {
+		assigned, nested = b.lowerYul(raw, block)
+	}
+
+	var ids []int
+	seen := make(map[int]bool)
+	add := func(reference jsoniter.Any) {
+		id := reference.Get("declaration").ToInt()
+		if _, ok := b.locals[id]; ok && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	references := raw.Get("externalReferences")
+	for i := 0; i < references.Size(); i++ {
+		reference := references.Get(i)
+		if reference.Get("declaration").ValueType() == jsoniter.NumberValue {
+			add(reference)
+			continue
+		}
+		// solidity 0.6 之前的格式为 [{"name": {"declaration": ...}}]。
+		for _, key := range reference.Keys() {
+			add(reference.Get(key))
+		}
+	}
+
+	args := make([]*Value, len(ids))
+	for i, id := range ids {
+		args[i] = b.read(id, b.current)
+	}
+	in := &Instr{Op: OpAssembly, Args: args}
+	opaque := false
+	for _, id := range ids {
+		opaque = opaque || !parsed || nested[id]
+	}
+	if !opaque {
+		b.emit(in, raw)
+		for _, id := range ids {
+			if v := assigned[id]; v != nil {
+				b.write(id, b.current, v)
+			}
+		}
+		return
+	}
+	state := b.define(in, raw, "", nil)
+	for i, id := range ids {
+		switch {
+		case parsed && !nested[id] && assigned[id] != nil:
+			b.write(id, b.current, assigned[id])
+		case !parsed || nested[id]:
+			b.write(id, b.current, b.define(&Instr{Op: OpExtract, Index: i, Args: []*Value{state}}, raw, "", b.locals[id].typ))
+		}
+	}
+}
+
+func (b *builder) expressions(raw jsoniter.Any) []*Value {
+	var values []*Value
+	for i := 0; i < raw.Size(); i++ {
+		values = append(values, b.expression(raw.Get(i)))
+	}
+	return values
+}
+
+// expression 降低一个表达式并返回它的值，没有值的表达式（如没有返回值的调用）返回 nil。
+func (b *builder) expression(raw jsoniter.Any) *Value {
+	if isNull(raw) || b.current == nil {
+		return nil
+	}
+
+	switch raw.Get("nodeType").ToString() {
+	case "Literal":
+		value := raw.Get("value").ToString()
+		if raw.Get("value").ValueType() != jsoniter.StringValue {
+			value = "0x" + raw.Get("hexValue").ToString()
+		} else if raw.Get("kind").ToString() == "string" {
+			value = `"` + value + `"`
+		}
+		if subdenomination := raw.Get("subdenomination").ToString(); subdenomination != "" {
+			value += " " + subdenomination
+		}
+		return b.constant(value, raw, typeOf(raw))
+	case "Identifier":
+		return b.identifier(raw)
+	case "MemberAccess", "IndexAccess":
+		if b.isPath(raw) {
+			return b.load(b.lvalue(raw), raw)
+		}
+		return b.access(raw)
+	case "IndexRangeAccess":
+		args := []*Value{b.expression(raw.Get("baseExpression"))}
+		for _, key := range []string{"startExpression", "endExpression"} {
+			if !isNull(raw.Get(key)) {
+				args = append(args, b.expression(raw.Get(key)))
+			}
+		}
+		return b.define(&Instr{Op: OpBuiltin, Name: "slice", Args: args}, raw, "", typeOf(raw))
+	case "Assignment":
+		return b.assignment(raw)
+	case "UnaryOperation":
+		return b.unary(raw)
+	case "BinaryOperation":
+		operator := raw.Get("operator").ToString()
+		if operator == "&&" || operator == "||" {
+			return b.shortCircuit(raw)
+		}
+		left := b.expression(raw.Get("leftExpression"))
+		right := b.expression(raw.Get("rightExpression"))
+		return b.define(&Instr{Op: OpBinary, Name: operator, Args: []*Value{left, right}}, raw, "", typeOf(raw))
+	case "Conditional":
+		return b.conditional(raw)
+	case "TupleExpression":
+		components := raw.Get("components")
+		if components.Size() == 1 && !raw.Get("isInlineArray").ToBool() {
+			return b.expression(components.Get(0))
+		}
+		name := "tuple"
+		if raw.Get("isInlineArray").ToBool() {
+			name = "array"
+		}
+		var args []*Value
+		for i := 0; i < components.Size(); i++ {
+			if v := b.expression(components.Get(i)); v != nil {
+				args = append(args, v)
+			}
+		}
+		return b.define(&Instr{Op: OpBuiltin, Name: name, Args: args}, raw, "", typeOf(raw))
+	case "FunctionCall":
+		return b.call(raw)
+	case "FunctionCallOptions":
+		return b.expression(raw.Get("expression"))
+	case "NewExpression", "ElementaryTypeNameExpression":
+		return b.constant(typeString(raw), raw, typeOf(raw))
+	}
+	return b.constant(raw.Get("nodeType").ToString(), raw, typeOf(raw))
+}
+
+func (b *builder) identifier(raw jsoniter.Any) *Value {
+	id := raw.Get("referencedDeclaration").ToInt()
+	name := raw.Get("name").ToString()
+	if _, ok := b.locals[id]; ok {
+		return b.read(id, b.current)
+	}
+	if v := b.program.variables[id]; v != nil {
+		return b.stateVariable(v, raw)
+	}
+	switch name {
+	case "this", "now", "msg", "block", "tx", "abi", "super":
+		if !b.declarations.functions[id] {
+			return b.define(&Instr{Op: OpEnv, Name: name}, raw, "", typeOf(raw))
+		}
+	}
+	return b.constant(name, raw, typeOf(raw))
+}
+
+func (b *builder) stateVariable(v *Variable, raw jsoniter.Any) *Value {
+	switch {
+	case v.Constant || v.Immutable:
+		return b.define(&Instr{Op: OpGlobal, Name: v.Name, Variable: v}, raw, "", v.Type)
+	case isReference(v.Type):
+		return b.define(&Instr{Op: OpSRef, Variable: v}, raw, "", v.Type)
+	default:
+		return b.define(&Instr{Op: OpSLoad, Variable: v}, raw, "", v.Type)
+	}
+}
+
+// access 降低不是 storage、memory 或 calldata 访问的成员访问与下标访问，如 msg.sender、addr.balance、E.A。
+func (b *builder) access(raw jsoniter.Any) *Value {
+	if raw.Get("nodeType").ToString() == "IndexAccess" {
+		if isNull(raw.Get("indexExpression")) {
+			return b.constant(typeString(raw), raw, typeOf(raw))
+		}
+		base := b.expression(raw.Get("baseExpression"))
+		index := b.expression(raw.Get("indexExpression"))
+		return b.define(&Instr{Op: OpBuiltin, Name: "index", Args: []*Value{base, index}}, raw, "", typeOf(raw))
+	}
+
+	member := raw.Get("memberName").ToString()
+	base := raw.Get("expression")
+	if v := b.program.variables[raw.Get("referencedDeclaration").ToInt()]; v != nil {
+		return b.stateVariable(v, raw)
+	}
+	baseType := typeOf(base)
+	if base.Get("nodeType").ToString() == "Identifier" && baseType != nil {
+		switch baseType.Category {
+		case types.Magic:
+			return b.define(&Instr{Op: OpEnv, Name: base.Get("name").ToString() + "." + member}, raw, "", typeOf(raw))
+		case types.TypeType, types.Module:
+			return b.constant(base.Get("name").ToString()+"."+member, raw, typeOf(raw))
+		}
+	}
+	return b.define(&Instr{Op: OpBuiltin, Name: member, Args: []*Value{b.expression(base)}}, raw, "", typeOf(raw))
+}
+
+// lvalue 是一个可以被读写的位置：局部变量，或者 storage、memory、calldata 中的位置。
+type lvalue struct {
+	local    int
+	location types.Location
+	variable *Variable
+	base     *Value
+	path     []Access
+}
+
+// isPath 判断 raw 是否访问引用类型中的元素，如 balances[a]、s.owner、arr.length。
+func (b *builder) isPath(raw jsoniter.Any) bool {
+	switch raw.Get("nodeType").ToString() {
+	case "IndexAccess":
+		t := typeOf(raw.Get("baseExpression"))
+		return !isNull(raw.Get("indexExpression")) && t != nil && (t.Category == types.Array || t.Category == types.Mapping || t.Category == types.Bytes)
+	case "MemberAccess":
+		if b.program.variables[raw.Get("referencedDeclaration").ToInt()] != nil {
+			return false
+		}
+		t := typeOf(raw.Get("expression"))
+		member := raw.Get("memberName").ToString()
+		return t != nil && (t.Category == types.Struct || (t.Category == types.Array || t.Category == types.Bytes) && member == "length")
+	}
+	return false
+}
+
+func (b *builder) lvalue(raw jsoniter.Any) *lvalue {
+	switch raw.Get("nodeType").ToString() {
+	case "Identifier":
+		id := raw.Get("referencedDeclaration").ToInt()
+		if _, ok := b.locals[id]; ok {
+			return &lvalue{local: id}
+		}
+		if v := b.program.variables[id]; v != nil && !v.Constant {
+			return &lvalue{location: types.Storage, variable: v}
+		}
+	case "MemberAccess":
+		if v := b.program.variables[raw.Get("referencedDeclaration").ToInt()]; v != nil && !v.Constant {
+			return &lvalue{location: types.Storage, variable: v}
+		}
+	}
+	if !b.isPath(raw) {
+		return nil
+	}
+
+	var lv *lvalue
+	var access Access
+	if raw.Get("nodeType").ToString() == "IndexAccess" {
+		lv = b.root(raw.Get("baseExpression"))
+		access.Index = b.expression(raw.Get("indexExpression"))
+	} else {
+		lv = b.root(raw.Get("expression"))
+		access.Member = raw.Get("memberName").ToString()
+	}
+	lv.path = append(lv.path, access)
+	return lv
+}
+
+// root 返回访问路径的起点，storage 引用指向确定的状态变量时，直接从该状态变量开始。
+func (b *builder) root(raw jsoniter.Any) *lvalue {
+	if b.isPath(raw) {
+		return b.lvalue(raw)
+	}
+	if lv := b.lvalue(raw); lv != nil && lv.local == 0 {
+		return lv
+	}
+
+	base := b.expression(raw)
+	lv := &lvalue{location: types.Memory, base: base}
+	if base != nil && base.Def != nil && base.Def.Op == OpSRef {
+		lv.variable = base.Def.Variable
+		lv.base = nil
+		if lv.variable == nil {
+			lv.base = base.Def.Args[0]
+		}
+		lv.path = append(lv.path, base.Def.Path...)
+	}
+	if t := typeOf(raw); t != nil {
+		switch {
+		case t.Category == types.Mapping || t.Location == types.Storage:
+			lv.location = types.Storage
+		case t.Location == types.Calldata:
+			lv.location = types.Calldata
+		}
+	}
+	return lv
+}
+
+// operands 返回访问 lv 所需的参数：引用本身（如果不是状态变量）以及路径中的下标。
+func (lv *lvalue) operands() []*Value {
+	var args []*Value
+	if lv.variable == nil {
+		args = append(args, lv.base)
+	}
+	for _, access := range lv.path {
+		if access.Index != nil {
+			args = append(args, access.Index)
+		}
+	}
+	return args
+}
+
+func (b *builder) load(lv *lvalue, raw jsoniter.Any) *Value {
+	if lv == nil {
+		return b.constant(raw.Get("nodeType").ToString(), raw, typeOf(raw))
+	}
+	if lv.local != 0 {
+		return b.read(lv.local, b.current)
+	}
+	typ := typeOf(raw)
+	var op Op
+	switch lv.location {
+	case types.Storage:
+		op = OpSLoad
+		if isReference(typ) {
+			op = OpSRef
+		}
+	case types.Calldata:
+		op = OpCalldataLoad
+	default:
+		op = OpMLoad
+	}
+	return b.define(&Instr{Op: op, Variable: lv.variable, Path: lv.path, Args: lv.operands()}, raw, "", typ)
+}
+
+func (b *builder) store(lv *lvalue, v *Value, raw jsoniter.Any) {
+	if lv == nil {
+		return
+	}
+	if lv.local != 0 {
+		b.write(lv.local, b.current, v)
+		return
+	}
+	op := OpMStore
+	if lv.location == types.Storage {
+		op = OpSStore
+	}
+	b.emit(&Instr{Op: op, Variable: lv.variable, Path: lv.path, Args: append(lv.operands(), v)}, raw)
+}
+
+func (b *builder) assignment(raw jsoniter.Any) *Value {
+	operator := raw.Get("operator").ToString()
+	left := raw.Get("leftHandSide")
+	right := raw.Get("rightHandSide")
+
+	if left.Get("nodeType").ToString() == "TupleExpression" {
+		components := left.Get("components")
+		values := b.components(right, components.Size())
+		lvalues := make([]*lvalue, components.Size())
+		for i := 0; i < components.Size(); i++ {
+			if !isNull(components.Get(i)) {
+				lvalues[i] = b.lvalue(components.Get(i))
+			}
+		}
+		for i, lv := range lvalues {
+			b.store(lv, values[i], raw)
+		}
+		return nil
+	}
+
+	if operator == "=" {
+		v := b.expression(right)
+		b.store(b.lvalue(left), v, raw)
+		return v
+	}
+	lv := b.lvalue(left)
+	current := b.load(lv, left)
+	v := b.define(&Instr{Op: OpBinary, Name: strings.TrimSuffix(operator, "="), Args: []*Value{current, b.expression(right)}}, raw, "", typeOf(raw))
+	b.store(lv, v, raw)
+	return v
+}
+
+func (b *builder) unary(raw jsoniter.Any) *Value {
+	operator := raw.Get("operator").ToString()
+	sub := raw.Get("subExpression")
+	switch operator {
+	case "++", "--":
+		lv := b.lvalue(sub)
+		current := b.load(lv, sub)
+		one := b.constant("1", raw, typeOf(sub))
+		v := b.define(&Instr{Op: OpBinary, Name: operator[:1], Args: []*Value{current, one}}, raw, "", typeOf(raw))
+		b.store(lv, v, raw)
+		if raw.Get("prefix").ToBool() {
+			return v
+		}
+		return current
+	case "delete":
+		lv := b.lvalue(sub)
+		b.store(lv, b.constant("0", raw, typeOf(sub)), raw)
+		return nil
+	}
+	return b.define(&Instr{Op: OpUnary, Name: operator, Args: []*Value{b.expression(sub)}}, raw, "", typeOf(raw))
+}
+
+// shortCircuit 按照短路求值降低 && 与 ||，右侧只在需要时求值，结果由 phi 合并。
+func (b *builder) shortCircuit(raw jsoniter.Any) *Value {
+	left := b.expression(raw.Get("leftExpression"))
+	from := b.current
+	right, merge := b.newBlock(), b.newBlock()
+	if raw.Get("operator").ToString() == "&&" {
+		b.branch(left, right, merge, raw)
+	} else {
+		b.branch(left, merge, right, raw)
+	}
+	b.seal(right)
+	b.enter(right)
+	r := b.expression(raw.Get("rightExpression"))
+	b.jump(merge, raw)
+	b.seal(merge)
+	b.enter(merge)
+
+	return b.merge(raw, map[*Block]*Value{from: left}, r)
+}
+
+func (b *builder) conditional(raw jsoniter.Any) *Value {
+	cond := b.expression(raw.Get("condition"))
+	then, otherwise, merge := b.newBlock(), b.newBlock(), b.newBlock()
+	b.branch(cond, then, otherwise, raw)
+	b.seal(then)
+	b.enter(then)
+	t := b.expression(raw.Get("trueExpression"))
+	thenEnd := b.current
+	b.jump(merge, raw)
+	b.seal(otherwise)
+	b.enter(otherwise)
+	f := b.expression(raw.Get("falseExpression"))
+	b.jump(merge, raw)
+	b.seal(merge)
+	b.enter(merge)
+
+	return b.merge(raw, map[*Block]*Value{thenEnd: t}, f)
+}
+
+// merge 在当前基本块开头生成 phi，来自 values 中基本块的参数取对应的值，其余前驱取 otherwise。
+func (b *builder) merge(raw jsoniter.Any, values map[*Block]*Value, otherwise *Value) *Value {
+	in := &Instr{Op: OpPhi, NodeID: raw.Get("id").ToInt(), Src: raw.Get("src").ToString()}
+	for _, pred := range b.current.Preds {
+		v, ok := values[pred]
+		if !ok {
+			v = otherwise
+		}
+		in.Args = append(in.Args, v)
+	}
+	in.Dest = b.newValue("", typeOf(raw))
+	in.Dest.Def = in
+	b.insertFront(b.current, in, true)
+	return in.Dest
+}
+
+// lowLevelCalls 是底层调用的函数类型，见 typeIdentifier 中的 t_function_<kind>。
+var lowLevelCalls = map[string]bool{
+	"barecall":         true,
+	"baredelegatecall": true,
+	"barestaticcall":   true,
+	"barecallcode":     true,
+	"send":             true,
+	"transfer":         true,
+}
+
+func (b *builder) call(raw jsoniter.Any) *Value {
+	typ := typeOf(raw)
+	if typ != nil && typ.Category == types.Tuple && len(typ.Components) == 0 {
+		typ = nil
+	}
+	arguments := raw.Get("arguments")
+
+	switch raw.Get("kind").ToString() {
+	case "typeConversion":
+		v := b.expression(arguments.Get(0))
+		return b.define(&Instr{Op: OpConvert, Name: typ.String(), Args: []*Value{v}}, raw, "", typ)
+	case "structConstructorCall":
+		return b.define(&Instr{Op: OpBuiltin, Name: "struct", Args: b.expressions(arguments)}, raw, "", typ)
+	}
+
+	callee, options, optionValues := b.options(raw.Get("expression"))
+	in := &Instr{Options: options}
+	switch callee.Get("nodeType").ToString() {
+	case "Identifier":
+		name := callee.Get("name").ToString()
+		id := callee.Get("referencedDeclaration").ToInt()
+		switch {
+		case b.declarations.functions[id]:
+			in.Op, in.Name, in.Callee = OpCall, name, id
+		case b.declarations.events[id]:
+			in.Op, in.Name = OpEmit, name
+		case b.locals[id] != nil:
+			in.Op, in.Args = OpCall, []*Value{b.read(id, b.current)}
+		// solidity 0.5 之前内置函数的 referencedDeclaration 也是正数，前面的分支已经排除了同名的函数、事件与局部变量。
+		case name == "require" || name == "assert":
+			b.require(raw)
+			return nil
+		case name == "revert":
+			b.emit(&Instr{Op: OpRevert, Args: b.expressions(arguments)}, raw)
+			b.current = nil
+			return nil
+		default:
+			in.Op, in.Name = OpBuiltin, name
+		}
+	case "MemberAccess":
+		member := callee.Get("memberName").ToString()
+		base := callee.Get("expression")
+		calleeType := typeOf(callee)
+		kind := ""
+		if calleeType != nil && calleeType.Category == types.Function {
+			kind = calleeType.Kind
+		}
+		switch {
+		case lowLevelCalls[kind]:
+			in.Op, in.Name, in.Args = OpLowLevelCall, member, []*Value{b.expression(base)}
+		case kind == "external" || kind == "delegatecall":
+			in.Op, in.Name, in.Callee, in.Args = OpExternalCall, member, callee.Get("referencedDeclaration").ToInt(), []*Value{b.expression(base)}
+		case kind == "internal":
+			in.Op, in.Name, in.Callee = OpCall, member, callee.Get("referencedDeclaration").ToInt()
+			if calleeType.AttachedTo != nil {
+				in.Args = []*Value{b.expression(base)}
+			}
+		case (kind == "arraypush" || kind == "arraypop") && b.isStorage(base):
+			lv := b.lvalue(base)
+			args := b.expressions(arguments)
+			if lv == nil {
+				in.Op, in.Name, in.Args = OpBuiltin, member, append([]*Value{b.expression(base)}, args...)
+				break
+			}
+			path := append(lv.path[:len(lv.path):len(lv.path)], Access{Member: member})
+			b.emit(&Instr{Op: OpSStore, Variable: lv.variable, Path: path, Args: append(lv.operands(), args...)}, raw)
+			return nil
+		case base.Get("nodeType").ToString() == "Identifier" && typeOf(base) != nil && (typeOf(base).Category == types.Magic || typeOf(base).Category == types.TypeType):
+			in.Op, in.Name = OpBuiltin, base.Get("name").ToString()+"."+member
+		default:
+			in.Op, in.Name, in.Args = OpBuiltin, member, []*Value{b.expression(base)}
+		}
+	case "NewExpression":
+		in.Op, in.Name = OpNew, typeString(callee)
+	default:
+		in.Op, in.Args = OpCall, []*Value{b.expression(callee)}
+	}
+
+	in.Args = append(in.Args, b.expressions(arguments)...)
+	in.Args = append(in.Args, optionValues...)
+	if typ == nil {
+		b.emit(in, raw)
+		return nil
+	}
+	return b.define(in, raw, "", typ)
+}
+
+// options 去掉调用表达式外层的 {value: ..., gas: ...} 以及 solidity 0.7 之前的 .value(...)、.gas(...)，返回被调用的表达式与调用选项。
+func (b *builder) options(callee jsoniter.Any) (jsoniter.Any, []string, []*Value) {
+	var names []string
+	var values []*Value
+	for {
+		switch callee.Get("nodeType").ToString() {
+		case "FunctionCallOptions":
+			options := callee.Get("options")
+			for i := 0; i < options.Size(); i++ {
+				names = append(names, callee.Get("names").Get(i).ToString())
+				values = append(values, b.expression(options.Get(i)))
+			}
+			callee = callee.Get("expression")
+			continue
+		case "FunctionCall":
+			expression := callee.Get("expression")
+			member := expression.Get("memberName").ToString()
+			if t := typeOf(expression.Get("expression")); expression.Get("nodeType").ToString() == "MemberAccess" && (member == "value" || member == "gas") && t != nil && t.Category == types.Function {
+				names = append(names, member)
+				values = append(values, b.expression(callee.Get("arguments").Get(0)))
+				callee = expression.Get("expression")
+				continue
+			}
+		}
+		return callee, names, values
+	}
+}
+
+func (b *builder) isStorage(raw jsoniter.Any) bool {
+	t := typeOf(raw)
+	return t != nil && t.Location == types.Storage
+}
+
+// require 将 require 与 assert 降低为条件跳转，条件不成立时进入回滚的基本块。
+func (b *builder) require(raw jsoniter.Any) {
+	arguments := raw.Get("arguments")
+	cond := b.expression(arguments.Get(0))
+	var args []*Value
+	for i := 1; i < arguments.Size(); i++ {
+		args = append(args, b.expression(arguments.Get(i)))
+	}
+	ok, fail := b.newBlock(), b.newBlock()
+	b.branch(cond, ok, fail, raw)
+	b.seal(fail)
+	b.enter(fail)
+	b.emit(&Instr{Op: OpRevert, Name: raw.Get("expression").Get("name").ToString(), Args: args}, raw)
+	b.seal(ok)
+	b.enter(ok)
+}
+
+// calleeName 返回被调用的事件或错误的名字，如 Transfer、Errors.Unauthorized 中的 Unauthorized。
+func calleeName(raw jsoniter.Any) string {
+	if raw.Get("nodeType").ToString() == "MemberAccess" {
+		return raw.Get("memberName").ToString()
+	}
+	return raw.Get("name").ToString()
+}
+
+func typeOf(raw jsoniter.Any) *types.Type {
+	t, err := types.Parse(raw.Get("typeDescriptions").Get("typeIdentifier").ToString())
+	if err != nil {
+		return nil
+	}
+	return t
+}
+
+func typeString(raw jsoniter.Any) string {
+	if s := raw.Get("typeDescriptions").Get("typeString").ToString(); s != "" {
+		return s
+	}
+	return raw.Get("nodeType").ToString()
+}
+
+// isReference 判断 storage 中的值是否为引用类型，读取它得到的是 storage 引用而不是值。
+func isReference(t *types.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Category {
+	case types.Struct, types.Array, types.Mapping, types.Bytes, types.String:
+		return true
+	}
+	return false
+}
+
+func isNull(raw jsoniter.Any) bool {
+	return raw.ValueType() == jsoniter.InvalidValue || raw.ValueType() == jsoniter.NilValue
+}
+
+// signature 生成函数的签名，格式与 rule 中的检测结果一致。
+func signature(contractName string, function jsoniter.Any) string {
+	name := function.Get("name").ToString()
+	switch kind := function.Get("kind").ToString(); {
+	case kind == "freeFunction":
+	case name == "" && kind != "" && kind != "function":
+		name = "." + kind
+	case name == "" && function.Get("isConstructor").ToBool():
+		name = ".constructor"
+	case name == "":
+		name = ".fallback"
+	default:
+		name = "." + name
+	}
+
+	var parameters []string
+	list := function.Get("parameters").Get("parameters")
+	for i := 0; i < list.Size(); i++ {
+		parameter := list.Get(i)
+		typeName := parameter.Get("typeName")
+		code := typeName.Get("typeDescriptions").Get("typeString").ToString()
+		switch typeName.Get("nodeType").ToString() {
+		case "ElementaryTypeName":
+			code = typeName.Get("name").ToString()
+			if typeName.Get("stateMutability").ToString() == "payable" && code == "address" {
+				code += " payable"
+			}
+		case "UserDefinedTypeName":
+			if n := typeName.Get("name").ToString(); n != "" {
+				code = n
+			} else if n := typeName.Get("pathNode").Get("name").ToString(); n != "" {
+				code = n
+			}
+		}
+		if location := parameter.Get("storageLocation").ToString(); location != "" && location != "default" {
+			code += " " + location
+		}
+		if n := parameter.Get("name").ToString(); n != "" {
+			code += " " + n
+		}
+		parameters = append(parameters, code)
+	}
+
+	return fmt.Sprintf("%s%s(%s)", contractName, name, strings.Join(parameters, ", "))
+}
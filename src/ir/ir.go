@@ -0,0 +1,359 @@
+// Package ir 将 solc 的语法树降低为与 solidity 版本无关的三地址 SSA 中间表示。
+//
+// 每个有函数体的函数与修饰器对应一个 Function，由若干基本块组成，局部变量在 SSA 中被重命名，
+// 控制流汇合处用 phi 合并；对状态变量的读写、对 memory 与 calldata 的访问以及内部调用、外部调用和
+// call/delegatecall/staticcall 等底层调用都有对应的指令，Value.Def 与 Value.Uses 构成 def-use 链。
+package ir
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src/types"
+)
+
+// Op 是指令的操作码。
+type Op int
+
+const (
+	OpConst        Op = iota + 1 // 常量，Name 为字面值；也用于函数、合约、枚举值等不是值的引用
+	OpParam                      // 函数参数，Index 为参数的位置
+	OpEnv                        // 环境值，Name 如 msg.sender、block.timestamp、this
+	OpUnary                      // 一元运算，Name 为运算符
+	OpBinary                     // 二元运算，Name 为运算符
+	OpConvert                    // 类型转换，Name 为目标类型
+	OpPhi                        // phi，Args 与所在基本块的 Preds 一一对应
+	OpExtract                    // 取元组的第 Index 个元素
+	OpGlobal                     // 读取 constant 或 immutable 状态变量，它们不在 storage 中；构造函数中对 immutable 的赋值仍用 OpSStore 表示
+	OpSLoad                      // 读取 storage，位置为 Variable（或 Args[0] 指向的 storage 引用）加上 Path；Name 为 slot 时 Args[0] 为汇编中直接访问的 slot
+	OpSStore                     // 写入 storage，位置同 OpSLoad，最后一个参数为写入的值
+	OpSRef                       // storage 引用，即对结构体、数组、mapping 等 storage 中引用类型的取址
+	OpMLoad                      // 读取 memory，Args[0] 为 memory 引用，位置为 Path
+	OpMStore                     // 写入 memory，最后一个参数为写入的值
+	OpCalldataLoad               // 读取 calldata，Args[0] 为 calldata 引用，位置为 Path
+	OpCall                       // 内部调用，Callee 为被调用函数的声明 id；Callee 为 0 时 Args[0] 为被调用的函数值
+	OpExternalCall               // 外部调用，Args[0] 为被调用的合约，Name 为函数名
+	OpLowLevelCall               // 底层调用，Name 为 call、delegatecall、staticcall、callcode、send 或 transfer，Args[0] 为目标地址
+	OpNew                        // 创建合约或者 memory 数组，Name 为类型
+	OpBuiltin                    // 其它内置函数或成员，如 keccak256、abi.encode、balance
+	OpEmit                       // 触发事件，Name 为事件名
+	OpModifier                   // 修饰器或者父合约构造函数的调用，Callee 为其声明 id
+	OpPlaceholder                // 修饰器中的 _
+	OpAssembly                   // 内联汇编，Args 为汇编中引用的局部变量，其中被汇编修改且无法确定新值的变量通过 OpExtract 重新定义
+	OpJump                       // 无条件跳转到 Succs[0]
+	OpBranch                     // 条件跳转，Args[0] 为真时跳转到 Succs[0]，否则跳转到 Succs[1]
+	OpTry                        // try 语句，Args[0] 为外部调用，成功时跳转到 Succs[0]，失败时跳转到后面的 catch 块
+	OpReturn                     // 返回，Args 为返回值
+	OpRevert                     // 回滚，包括 revert、throw 以及 require 与 assert 的失败分支
+)
+
+var opNames = map[Op]string{
+	OpConst:        "const",
+	OpParam:        "param",
+	OpEnv:          "env",
+	OpUnary:        "unary",
+	OpBinary:       "binary",
+	OpConvert:      "convert",
+	OpPhi:          "phi",
+	OpExtract:      "extract",
+	OpGlobal:       "global",
+	OpSLoad:        "sload",
+	OpSStore:       "sstore",
+	OpSRef:         "sref",
+	OpMLoad:        "mload",
+	OpMStore:       "mstore",
+	OpCalldataLoad: "calldataload",
+	OpCall:         "call",
+	OpExternalCall: "extcall",
+	OpLowLevelCall: "lowlevelcall",
+	OpNew:          "new",
+	OpBuiltin:      "builtin",
+	OpEmit:         "emit",
+	OpModifier:     "modifier",
+	OpPlaceholder:  "placeholder",
+	OpAssembly:     "assembly",
+	OpJump:         "jump",
+	OpBranch:       "branch",
+	OpTry:          "try",
+	OpReturn:       "return",
+	OpRevert:       "revert",
+}
+
+func (op Op) String() string {
+	if name, ok := opNames[op]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// IsTerminator 判断 op 是否结束一个基本块。
+func (op Op) IsTerminator() bool {
+	switch op {
+	case OpJump, OpBranch, OpTry, OpReturn, OpRevert:
+		return true
+	}
+	return false
+}
+
+// Variable 是一个状态变量。
+type Variable struct {
+	ID        int
+	Name      string
+	Contract  string
+	Type      *types.Type
+	Constant  bool
+	Immutable bool
+	Private   bool   // 声明为 private，派生合约中不能通过名字访问
+	Value     string // constant 状态变量的初始值是字面值时为该字面值
+}
+
+// Access 是 storage、memory 或 calldata 访问路径中的一步，Member 与 Index 只有一个有效。
+type Access struct {
+	Member string
+	Index  *Value
+}
+
+// Value 是一个 SSA 值，每个值只被 Def 定义一次。
+type Value struct {
+	ID   int
+	Name string // 对应的局部变量名，临时值为空
+	Type *types.Type
+	Def  *Instr
+	uses []*Instr
+}
+
+// Uses 返回所有使用了 v 的指令，同一条指令多次使用 v 时只出现一次。
+func (v *Value) Uses() []*Instr {
+	return v.uses
+}
+
+func (v *Value) String() string {
+	if v == nil {
+		return "_"
+	}
+	if v.Name != "" {
+		return fmt.Sprintf("%%%s.%d", v.Name, v.ID)
+	}
+	return fmt.Sprintf("%%%d", v.ID)
+}
+
+// Instr 是一条三地址指令，各个字段的含义见 Op 的说明。
+type Instr struct {
+	Op    Op
+	Dest  *Value
+	Args  []*Value
+	Block *Block
+
+	Name     string
+	Index    int
+	Callee   int
+	Variable *Variable
+	Path     []Access
+	// Options 为调用选项（value、gas、salt）的名字，对应 Args 末尾的值
+	Options []string
+
+	// 产生该指令的语法树节点
+	NodeID int
+	Src    string
+}
+
+func (in *Instr) String() string {
+	var sb strings.Builder
+	if in.Dest != nil {
+		sb.WriteString(in.Dest.String() + " = ")
+	}
+	sb.WriteString(in.Op.String())
+
+	args := in.Args
+	switch in.Op {
+	case OpConst, OpEnv, OpUnary, OpBinary, OpConvert, OpNew, OpBuiltin, OpEmit, OpExternalCall, OpLowLevelCall, OpGlobal:
+		sb.WriteString(" " + in.Name)
+	case OpRevert:
+		if in.Name != "" {
+			sb.WriteString(" " + in.Name)
+		}
+	case OpParam, OpExtract:
+		sb.WriteString(fmt.Sprintf(" %d", in.Index))
+	case OpCall, OpModifier:
+		if in.Callee != 0 {
+			sb.WriteString(fmt.Sprintf(" %s#%d", in.Name, in.Callee))
+		}
+	case OpSLoad, OpSStore, OpSRef, OpMLoad, OpMStore, OpCalldataLoad:
+		sb.WriteString(" " + in.location())
+		skip := len(in.indices())
+		if in.Variable == nil {
+			skip++
+		}
+		args = args[skip:]
+	}
+
+	for i, arg := range args {
+		if i == 0 {
+			sb.WriteString(" ")
+		} else {
+			sb.WriteString(", ")
+		}
+		if i >= len(args)-len(in.Options) && in.Op != OpPhi {
+			sb.WriteString(in.Options[i-len(args)+len(in.Options)] + ": ")
+		}
+		sb.WriteString(arg.String())
+	}
+
+	if in.Op.IsTerminator() && in.Block != nil {
+		for i, succ := range in.Block.Succs {
+			if i == 0 {
+				sb.WriteString(" ->")
+			}
+			sb.WriteString(fmt.Sprintf(" b%d", succ.Index))
+		}
+	}
+	return sb.String()
+}
+
+// location 返回访问位置的可读形式，如 balances[%3].amount。
+func (in *Instr) location() string {
+	var sb strings.Builder
+	switch {
+	case in.Variable != nil:
+		sb.WriteString(in.Variable.Name)
+	case in.Name == "slot":
+		sb.WriteString("slot(" + in.Args[0].String() + ")")
+	default:
+		sb.WriteString(in.Args[0].String())
+	}
+	for _, access := range in.Path {
+		if access.Index != nil {
+			sb.WriteString("[" + access.Index.String() + "]")
+		} else {
+			sb.WriteString("." + access.Member)
+		}
+	}
+	return sb.String()
+}
+
+// indices 返回访问路径中的下标，它们同时出现在 Args 中。
+func (in *Instr) indices() []*Value {
+	var indices []*Value
+	for _, access := range in.Path {
+		if access.Index != nil {
+			indices = append(indices, access.Index)
+		}
+	}
+	return indices
+}
+
+// Block 是一个基本块，phi 总在最前面，最后一条指令是终结指令。
+type Block struct {
+	Index    int
+	Instrs   []*Instr
+	Preds    []*Block
+	Succs    []*Block
+	Function *Function
+
+	sealed bool
+}
+
+// Terminator 返回基本块的终结指令，构造完成的基本块总是有终结指令。
+func (b *Block) Terminator() *Instr {
+	if len(b.Instrs) == 0 || !b.Instrs[len(b.Instrs)-1].Op.IsTerminator() {
+		return nil
+	}
+	return b.Instrs[len(b.Instrs)-1]
+}
+
+// Function 是函数或修饰器的中间表示，Blocks[0] 为入口。
+type Function struct {
+	ID              int
+	Name            string
+	Contract        string
+	Kind            string // function、constructor、fallback、receive、modifier 等
+	Signature       string // 与各个版本中 FunctionDefinition.Signature 的格式一致，如 Token.transfer(address to, uint256 amount)
+	Visibility      string
+	StateMutability string
+	Params          []*Value
+	Blocks          []*Block
+}
+
+// Entry 返回入口基本块。
+func (f *Function) Entry() *Block {
+	return f.Blocks[0]
+}
+
+// Instrs 按照基本块的顺序返回所有指令。
+func (f *Function) Instrs() []*Instr {
+	var instrs []*Instr
+	for _, b := range f.Blocks {
+		instrs = append(instrs, b.Instrs...)
+	}
+	return instrs
+}
+
+func (f *Function) String() string {
+	var sb strings.Builder
+	params := make([]string, len(f.Params))
+	for i, p := range f.Params {
+		params[i] = p.String()
+	}
+	sb.WriteString(fmt.Sprintf("%s %s.%s(%s) {\n", f.Kind, f.Contract, f.Name, strings.Join(params, ", ")))
+	for _, b := range f.Blocks {
+		preds := make([]string, len(b.Preds))
+		for i, p := range b.Preds {
+			preds[i] = fmt.Sprintf("b%d", p.Index)
+		}
+		if len(preds) > 0 {
+			sb.WriteString(fmt.Sprintf("b%d: ; preds %s\n", b.Index, strings.Join(preds, ", ")))
+		} else {
+			sb.WriteString(fmt.Sprintf("b%d:\n", b.Index))
+		}
+		for _, in := range b.Instrs {
+			sb.WriteString("  " + in.String() + "\n")
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Contract 是一个合约、接口或者库。
+type Contract struct {
+	ID        int
+	Name      string
+	Kind      string
+	Bases     []int // C3 线性化后的父合约，第一个为合约自身
+	Variables []*Variable
+	Functions []*Function
+}
+
+// Program 是一个 SourceUnit 的中间表示。
+type Program struct {
+	Contracts []*Contract
+
+	functions map[int]*Function
+	variables map[int]*Variable
+}
+
+// Function 返回声明 id 对应的函数或修饰器，没有函数体时返回 nil。
+func (p *Program) Function(id int) *Function {
+	return p.functions[id]
+}
+
+// Variable 返回声明 id 对应的状态变量。
+func (p *Program) Variable(id int) *Variable {
+	return p.variables[id]
+}
+
+func (p *Program) String() string {
+	var sb strings.Builder
+	for i, c := range p.Contracts {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("%s %s\n", c.Kind, c.Name))
+		for _, v := range c.Variables {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", v.Type, v.Name))
+		}
+		for _, f := range c.Functions {
+			sb.WriteString("\n" + f.String())
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,437 @@
+package ir
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// check 检查 f 是否满足 SSA 的约束：每个基本块以终结指令结束，phi 的参数与前驱一一对应，
+// 前驱与后继互相对应，def-use 链完整，并且每个值的定义支配它的所有使用。
+func check(t *testing.T, f *Function) {
+	t.Helper()
+	name := f.Contract + "." + f.Name
+
+	index := make(map[*Instr]int)
+	for _, b := range f.Blocks {
+		if b.Terminator() == nil {
+			t.Errorf("%s: b%d has no terminator", name, b.Index)
+		}
+		phis := true
+		for i, in := range b.Instrs {
+			index[in] = i
+			if in.Block != b {
+				t.Errorf("%s: [%s] is not in b%d", name, in, b.Index)
+			}
+			if in.Op.IsTerminator() && i != len(b.Instrs)-1 {
+				t.Errorf("%s: terminator [%s] in the middle of b%d", name, in, b.Index)
+			}
+			if in.Op == OpPhi {
+				if !phis {
+					t.Errorf("%s: phi [%s] after other instructions in b%d", name, in, b.Index)
+				}
+				if len(in.Args) != len(b.Preds) {
+					t.Errorf("%s: phi [%s] has %d arguments but b%d has %d predecessors", name, in, len(in.Args), b.Index, len(b.Preds))
+				}
+			} else {
+				phis = false
+			}
+		}
+		for _, succ := range b.Succs {
+			if !containsBlock(succ.Preds, b) {
+				t.Errorf("%s: b%d is a successor of b%d but not the other way around", name, succ.Index, b.Index)
+			}
+		}
+		for _, pred := range b.Preds {
+			if !containsBlock(pred.Succs, b) {
+				t.Errorf("%s: b%d is a predecessor of b%d but not the other way around", name, pred.Index, b.Index)
+			}
+		}
+	}
+
+	idom := dominators(f)
+	dominates := func(a *Block, b *Block) bool {
+		for ; b != nil; b = idom[b] {
+			if a == b {
+				return true
+			}
+			if b == f.Entry() {
+				return false
+			}
+		}
+		return false
+	}
+
+	for _, b := range f.Blocks {
+		for _, in := range b.Instrs {
+			for i, arg := range in.Args {
+				if arg == nil {
+					continue
+				}
+				if arg.Def == nil || arg.Def.Block == nil {
+					t.Errorf("%s: [%s] uses %s which is not defined", name, in, arg)
+					continue
+				}
+				found := false
+				for _, u := range arg.Uses() {
+					found = found || u == in
+				}
+				if !found {
+					t.Errorf("%s: [%s] is missing from the uses of %s", name, in, arg)
+				}
+				// phi 的参数只需要支配对应的前驱。
+				use, position := b, index[in]
+				if in.Op == OpPhi {
+					use, position = b.Preds[i], len(use.Preds[i].Instrs)
+				}
+				if def := arg.Def.Block; def == use && index[arg.Def] >= position || def != use && !dominates(def, use) {
+					t.Errorf("%s: definition of %s does not dominate [%s]", name, arg, in)
+				}
+			}
+		}
+	}
+}
+
+func containsBlock(blocks []*Block, b *Block) bool {
+	for _, block := range blocks {
+		if block == b {
+			return true
+		}
+	}
+	return false
+}
+
+// dominators 用 Cooper 等人的迭代算法计算直接支配节点。
+func dominators(f *Function) map[*Block]*Block {
+	var order []*Block
+	visited := make(map[*Block]bool)
+	var dfs func(b *Block)
+	dfs = func(b *Block) {
+		visited[b] = true
+		for _, succ := range b.Succs {
+			if !visited[succ] {
+				dfs(succ)
+			}
+		}
+		order = append(order, b)
+	}
+	dfs(f.Entry())
+	number := make(map[*Block]int)
+	for i, b := range order {
+		number[b] = i
+	}
+
+	idom := map[*Block]*Block{f.Entry(): f.Entry()}
+	for changed := true; changed; {
+		changed = false
+		for i := len(order) - 2; i >= 0; i-- {
+			b := order[i]
+			var dom *Block
+			for _, pred := range b.Preds {
+				if idom[pred] == nil {
+					continue
+				}
+				if dom == nil {
+					dom = pred
+					continue
+				}
+				x, y := dom, pred
+				for x != y {
+					for number[x] < number[y] {
+						x = idom[x]
+					}
+					for number[y] < number[x] {
+						y = idom[y]
+					}
+				}
+				dom = x
+			}
+			if idom[b] != dom {
+				idom[b] = dom
+				changed = true
+			}
+		}
+	}
+	return idom
+}
+
+func TestBuildFixtures(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", "*", "*.sol_json.ast"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("failed to find fixtures: [%v]", err)
+	}
+	for _, fixture := range fixtures {
+		bz, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := Build(jsoniter.Get(bz))
+		functions := 0
+		for _, c := range p.Contracts {
+			for _, f := range c.Functions {
+				functions++
+				check(t, f)
+			}
+		}
+		if functions == 0 {
+			t.Errorf("%s: no function is lowered", fixture)
+		}
+	}
+}
+
+// TestBuildMalformed 中数组的位置被替换为对象或者字符串，Build 不能 panic。
+func TestBuildMalformed(t *testing.T) {
+	inputs := []string{
+		`{"nodeType":"SourceUnit","nodes":{"":""}}`,
+		`{"nodeType":"SourceUnit","nodes":[{"nodeType":"ContractDefinition","id":1,"linearizedBaseContracts":{"":1},"nodes":{"":""}}]}`,
+		`{"nodeType":"SourceUnit","nodes":[{"nodeType":"ContractDefinition","id":1,"nodes":[{"nodeType":"FunctionDefinition","id":2,"implemented":true,` +
+			`"parameters":{"parameters":{"":""}},"returnParameters":{"parameters":"x"},"modifiers":{"":""},"body":{"nodeType":"Block","statements":{"":""}}}]}]}`,
+		`{"nodeType":"SourceUnit","nodes":[{"nodeType":"ContractDefinition","id":1,"nodes":[{"nodeType":"FunctionDefinition","id":2,"implemented":true,` +
+			`"parameters":{"parameters":[]},"body":{"nodeType":"Block","statements":[{"nodeType":"ExpressionStatement","expression":` +
+			`{"nodeType":"FunctionCall","arguments":{"":""},"expression":{"nodeType":"TupleExpression","components":{"":""}}}}]}}]}]}`,
+	}
+	for _, input := range inputs {
+		Build(jsoniter.Get([]byte(input)))
+	}
+}
+
+// 对应的源码：
+//
+//	contract Proxy {
+//	    address owner;
+//	    address implementation;
+//	    modifier onlyOwner() { require(msg.sender == owner); _; }
+//	    function upgrade(address impl) public onlyOwner { implementation = impl; }
+//	    function forward(bytes calldata data) external returns (bool ok) {
+//	        address target = implementation;
+//	        uint i = 0;
+//	        while (i < 3) { if (i == 1) { target = owner; } i++; }
+//	        (ok, ) = target.delegatecall(data);
+//	    }
+//	}
+const proxy = `{"nodeType": "SourceUnit", "nodes": [{
+	"nodeType": "ContractDefinition", "id": 1, "name": "Proxy", "contractKind": "contract", "linearizedBaseContracts": [1],
+	"nodes": [
+		{"nodeType": "VariableDeclaration", "id": 2, "name": "owner", "stateVariable": true, "typeDescriptions": {"typeIdentifier": "t_address"}},
+		{"nodeType": "VariableDeclaration", "id": 3, "name": "implementation", "stateVariable": true, "typeDescriptions": {"typeIdentifier": "t_address"}},
+		{"nodeType": "ModifierDefinition", "id": 4, "name": "onlyOwner", "parameters": {"parameters": []}, "body": {"nodeType": "Block", "statements": [
+			{"nodeType": "ExpressionStatement", "expression": {"nodeType": "FunctionCall", "kind": "functionCall", "typeDescriptions": {"typeIdentifier": "t_tuple$__$"},
+				"expression": {"nodeType": "Identifier", "name": "require", "referencedDeclaration": -18},
+				"arguments": [{"nodeType": "BinaryOperation", "operator": "==", "typeDescriptions": {"typeIdentifier": "t_bool"},
+					"leftExpression": {"nodeType": "MemberAccess", "memberName": "sender", "typeDescriptions": {"typeIdentifier": "t_address"},
+						"expression": {"nodeType": "Identifier", "name": "msg", "referencedDeclaration": -15, "typeDescriptions": {"typeIdentifier": "t_magic_message"}}},
+					"rightExpression": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 2, "typeDescriptions": {"typeIdentifier": "t_address"}}}]}},
+			{"nodeType": "PlaceholderStatement"}]}},
+		{"nodeType": "FunctionDefinition", "id": 5, "name": "upgrade", "kind": "function", "visibility": "public",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 6, "name": "impl", "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+			"returnParameters": {"parameters": []},
+			"modifiers": [{"nodeType": "ModifierInvocation", "modifierName": {"name": "onlyOwner", "referencedDeclaration": 4}}],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "operator": "=",
+					"leftHandSide": {"nodeType": "Identifier", "name": "implementation", "referencedDeclaration": 3, "typeDescriptions": {"typeIdentifier": "t_address"}},
+					"rightHandSide": {"nodeType": "Identifier", "name": "impl", "referencedDeclaration": 6, "typeDescriptions": {"typeIdentifier": "t_address"}}}}]}},
+		{"nodeType": "FunctionDefinition", "id": 7, "name": "forward", "kind": "function", "visibility": "external",
+			"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 8, "name": "data", "typeDescriptions": {"typeIdentifier": "t_bytes_calldata_ptr"}}]},
+			"returnParameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 9, "name": "ok", "typeDescriptions": {"typeIdentifier": "t_bool"}}]},
+			"modifiers": [],
+			"body": {"nodeType": "Block", "statements": [
+				{"nodeType": "VariableDeclarationStatement",
+					"declarations": [{"nodeType": "VariableDeclaration", "id": 10, "name": "target", "typeDescriptions": {"typeIdentifier": "t_address"}}],
+					"initialValue": {"nodeType": "Identifier", "name": "implementation", "referencedDeclaration": 3, "typeDescriptions": {"typeIdentifier": "t_address"}}},
+				{"nodeType": "VariableDeclarationStatement",
+					"declarations": [{"nodeType": "VariableDeclaration", "id": 11, "name": "i", "typeDescriptions": {"typeIdentifier": "t_uint256"}}],
+					"initialValue": {"nodeType": "Literal", "kind": "number", "value": "0", "typeDescriptions": {"typeIdentifier": "t_rational_0_by_1"}}},
+				{"nodeType": "WhileStatement",
+					"condition": {"nodeType": "BinaryOperation", "operator": "<", "typeDescriptions": {"typeIdentifier": "t_bool"},
+						"leftExpression": {"nodeType": "Identifier", "name": "i", "referencedDeclaration": 11, "typeDescriptions": {"typeIdentifier": "t_uint256"}},
+						"rightExpression": {"nodeType": "Literal", "kind": "number", "value": "3", "typeDescriptions": {"typeIdentifier": "t_rational_3_by_1"}}},
+					"body": {"nodeType": "Block", "statements": [
+						{"nodeType": "IfStatement",
+							"condition": {"nodeType": "BinaryOperation", "operator": "==", "typeDescriptions": {"typeIdentifier": "t_bool"},
+								"leftExpression": {"nodeType": "Identifier", "name": "i", "referencedDeclaration": 11, "typeDescriptions": {"typeIdentifier": "t_uint256"}},
+								"rightExpression": {"nodeType": "Literal", "kind": "number", "value": "1", "typeDescriptions": {"typeIdentifier": "t_rational_1_by_1"}}},
+							"trueBody": {"nodeType": "Block", "statements": [
+								{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "operator": "=",
+									"leftHandSide": {"nodeType": "Identifier", "name": "target", "referencedDeclaration": 10, "typeDescriptions": {"typeIdentifier": "t_address"}},
+									"rightHandSide": {"nodeType": "Identifier", "name": "owner", "referencedDeclaration": 2, "typeDescriptions": {"typeIdentifier": "t_address"}}}}]}},
+						{"nodeType": "ExpressionStatement", "expression": {"nodeType": "UnaryOperation", "operator": "++", "prefix": false, "typeDescriptions": {"typeIdentifier": "t_uint256"},
+							"subExpression": {"nodeType": "Identifier", "name": "i", "referencedDeclaration": 11, "typeDescriptions": {"typeIdentifier": "t_uint256"}}}}]}},
+				{"nodeType": "ExpressionStatement", "expression": {"nodeType": "Assignment", "operator": "=",
+					"leftHandSide": {"nodeType": "TupleExpression", "components": [
+						{"nodeType": "Identifier", "name": "ok", "referencedDeclaration": 9, "typeDescriptions": {"typeIdentifier": "t_bool"}}, null]},
+					"rightHandSide": {"nodeType": "FunctionCall", "kind": "functionCall", "typeDescriptions": {"typeIdentifier": "t_tuple$_t_bool_$_t_bytes_memory_ptr_$"},
+						"expression": {"nodeType": "MemberAccess", "memberName": "delegatecall",
+							"typeDescriptions": {"typeIdentifier": "t_function_baredelegatecall_nonpayable$_t_bytes_memory_ptr_$returns$_t_bool_$_t_bytes_memory_ptr_$"},
+							"expression": {"nodeType": "Identifier", "name": "target", "referencedDeclaration": 10, "typeDescriptions": {"typeIdentifier": "t_address"}}},
+						"arguments": [{"nodeType": "Identifier", "name": "data", "referencedDeclaration": 8, "typeDescriptions": {"typeIdentifier": "t_bytes_calldata_ptr"}}]}}}]}}
+	]}]}`
+
+func TestBuild(t *testing.T) {
+	p := Build(jsoniter.Get([]byte(proxy)))
+	if len(p.Contracts) != 1 || len(p.Contracts[0].Functions) != 3 {
+		t.Fatalf("unexpected program:\n%s", p)
+	}
+
+	expected := map[string]string{
+		"onlyOwner": `modifier Proxy.onlyOwner() {
+b0:
+  %1 = env msg.sender
+  %2 = sload owner
+  %3 = binary == %1, %2
+  branch %3 -> b2 b1
+b1: ; preds b0
+  revert require
+b2: ; preds b0
+  placeholder
+  return
+}
+`,
+		"upgrade": `function Proxy.upgrade(%impl.1) {
+b0:
+  %impl.1 = param 0
+  modifier onlyOwner#4
+  sstore implementation %impl.1
+  return
+}
+`,
+		"forward": `function Proxy.forward(%data.1) {
+b0:
+  %data.1 = param 0
+  %ok.2 = const 0
+  %target.3 = sload implementation
+  %i.4 = const 0
+  jump -> b1
+b1: ; preds b0, b4
+  %i.5 = phi %i.4, %i.14
+  %target.6 = phi %target.3, %target.12
+  %7 = const 3
+  %8 = binary < %i.5, %7
+  branch %8 -> b2 b5
+b2: ; preds b1
+  %9 = const 1
+  %10 = binary == %i.5, %9
+  branch %10 -> b3 b4
+b3: ; preds b2
+  %target.11 = sload owner
+  jump -> b4
+b4: ; preds b2, b3
+  %target.12 = phi %target.6, %target.11
+  %13 = const 1
+  %i.14 = binary + %i.5, %13
+  jump -> b1
+b5: ; preds b1
+  %15 = lowlevelcall delegatecall %target.6, %data.1
+  %ok.16 = extract 0 %15
+  %17 = extract 1 %15
+  return %ok.16
+}
+`,
+	}
+	for _, f := range p.Contracts[0].Functions {
+		check(t, f)
+		if s := f.String(); s != expected[f.Name] {
+			t.Errorf("unexpected IR of %s:\n%s\nexpected:\n%s", f.Name, s, expected[f.Name])
+		}
+	}
+
+	// def-use 链：delegatecall 的目标来自循环头部的 phi，它合并了 implementation 与 owner 两个状态变量。
+	forward := p.Function(7)
+	var call *Instr
+	for _, in := range forward.Instrs() {
+		if in.Op == OpLowLevelCall && in.Name == "delegatecall" {
+			call = in
+		}
+	}
+	if call == nil {
+		t.Fatal("delegatecall is not lowered")
+	}
+	var sources []string
+	var visit func(v *Value)
+	seen := make(map[*Value]bool)
+	visit = func(v *Value) {
+		if seen[v] {
+			return
+		}
+		seen[v] = true
+		switch v.Def.Op {
+		case OpPhi:
+			for _, arg := range v.Def.Args {
+				visit(arg)
+			}
+		case OpSLoad:
+			sources = append(sources, v.Def.Variable.Name)
+		}
+	}
+	visit(call.Args[0])
+	if strings.Join(sources, ",") != "implementation,owner" {
+		t.Errorf("unexpected sources of the delegatecall target: %v", sources)
+	}
+	if uses := forward.Params[0].Uses(); len(uses) != 1 || uses[0] != call {
+		t.Errorf("unexpected uses of data: %v", uses)
+	}
+	if p.Variable(3).Name != "implementation" {
+		t.Errorf("unexpected variable %+v", p.Variable(3))
+	}
+}
+
+// 对应的源码（solidity 0.5，汇编只有 operations 字符串）：
+//
+//	function get(bytes32 slot) public returns (address impl) {
+//	    assembly { impl := sload(slot) if iszero(impl) { sstore(slot, caller) } }
+//	}
+const assembly = `{"nodeType": "SourceUnit", "nodes": [{
+	"nodeType": "FunctionDefinition", "id": 1, "name": "get", "kind": "freeFunction", "visibility": "public",
+	"parameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 2, "name": "slot", "typeDescriptions": {"typeIdentifier": "t_bytes32"}}]},
+	"returnParameters": {"parameters": [{"nodeType": "VariableDeclaration", "id": 3, "name": "impl", "typeDescriptions": {"typeIdentifier": "t_address"}}]},
+	"modifiers": [],
+	"body": {"nodeType": "Block", "statements": [{"nodeType": "InlineAssembly", "id": 4,
+		"operations": "{\n    impl := sload(slot)\n    if iszero(impl) { sstore(slot, caller) }\n}",
+		"externalReferences": [{"impl": {"declaration": 3, "isOffset": false, "isSlot": false}}, {"slot": {"declaration": 2, "isOffset": false, "isSlot": false}},
+			{"slot": {"declaration": 2, "isOffset": false, "isSlot": false}}]}]}}]}`
+
+func TestBuildAssembly(t *testing.T) {
+	p := Build(jsoniter.Get([]byte(assembly)))
+	f := p.Function(1)
+	check(t, f)
+	expected := `freeFunction .get(%slot.1) {
+b0:
+  %slot.1 = param 0
+  %impl.2 = const 0
+  %impl.3 = sload slot(%slot.1)
+  %4 = builtin iszero %impl.3
+  %5 = env msg.sender
+  sstore slot(%slot.1) %5
+  assembly %impl.2, %slot.1
+  return %impl.3
+}
+`
+	if s := f.String(); s != expected {
+		t.Errorf("unexpected IR:\n%s\nexpected:\n%s", s, expected)
+	}
+
+	if _, ok := yulBlock(jsoniter.Get([]byte(`{"operations": "{ tag: jump(tag) }"}`))); ok {
+		t.Error("expected an error for labels")
+	}
+}
+
+// TestSignature 检查 fallback 与 receive 的签名与语法树中的签名一致，0.4 的 fallback 没有 kind，只能通过没有名字判断。
+func TestSignature(t *testing.T) {
+	tests := []struct {
+		function string
+		expected string
+	}{
+		{`{"name": "", "kind": "fallback", "parameters": {"parameters": []}}`, "HackMe.fallback()"},
+		{`{"name": "", "kind": "receive", "parameters": {"parameters": []}}`, "HackMe.receive()"},
+		{`{"name": "", "isConstructor": false, "parameters": {"parameters": []}}`, "HackMe.fallback()"},
+		{`{"name": "", "isConstructor": true, "parameters": {"parameters": []}}`, "HackMe.constructor()"},
+	}
+
+	for _, test := range tests {
+		if actual := signature("HackMe", jsoniter.Get([]byte(test.function))); actual != test.expected {
+			t.Errorf("expected [%s] for %s, got [%s]", test.expected, test.function, actual)
+		}
+	}
+}
@@ -0,0 +1,515 @@
+package ir
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// yulEnv 是 Yul 中读取环境值的内置函数，降低为与 solidity 相同的 OpEnv。
+var yulEnv = map[string]string{
+	"caller":    "msg.sender",
+	"origin":    "tx.origin",
+	"callvalue": "msg.value",
+	"address":   "this",
+	"timestamp": "block.timestamp",
+	"number":    "block.number",
+}
+
+// yulCalls 是 Yul 中的底层调用，第二个参数为目标地址。
+var yulCalls = map[string]bool{"call": true, "callcode": true, "delegatecall": true, "staticcall": true}
+
+// yulLowering 是降低一段内联汇编时的状态。汇编内部的控制流被忽略，所有语句按照出现的顺序降低。
+type yulLowering struct {
+	b          *builder
+	raw        jsoniter.Any
+	references map[string]jsoniter.Any
+	variables  map[string]*Value
+	assigned   map[int]*Value
+	nested     map[int]bool
+	depth      int
+}
+
+// yulBlock 返回内联汇编的 Yul 语法树；solidity 0.6 之前只有 operations 字符串，将其解析为相同结构的语法树，
+// 无法解析时返回 false。
+func yulBlock(raw jsoniter.Any) (jsoniter.Any, bool) {
+	if ast := raw.Get("AST"); ast.Get("nodeType").ToString() == "YulBlock" {
+		return ast, true
+	}
+	operations := raw.Get("operations")
+	if operations.ValueType() != jsoniter.StringValue {
+		return nil, false
+	}
+	p := &yulParser{tokens: yulTokens(operations.ToString())}
+	block, err := p.block()
+	if err != nil || p.pos != len(p.tokens) {
+		return nil, false
+	}
+	return src.Tree(jsoniter.Wrap(block)), true
+}
+
+// lowerYul 降低汇编中的 sload、sstore、底层调用以及它们用到的表达式，返回被赋值的局部变量以及是否在条件或循环中被赋值。
+func (b *builder) lowerYul(raw jsoniter.Any, block jsoniter.Any) (map[int]*Value, map[int]bool) {
+	y := &yulLowering{
+		b:          b,
+		raw:        raw,
+		references: make(map[string]jsoniter.Any),
+		variables:  make(map[string]*Value),
+		assigned:   make(map[int]*Value),
+		nested:     make(map[int]bool),
+	}
+	references := raw.Get("externalReferences")
+	for i := 0; i < references.Size(); i++ {
+		reference := references.Get(i)
+		if reference.Get("declaration").ValueType() == jsoniter.NumberValue {
+			y.references[reference.Get("src").ToString()] = reference
+			continue
+		}
+		for _, key := range reference.Keys() {
+			y.references[key] = reference.Get(key)
+		}
+	}
+	y.statement(block)
+	return y.assigned, y.nested
+}
+
+// reference 返回 Yul 标识符引用的 solidity 声明，0.6 及之后按照 src 查找，之前按照名字查找。
+func (y *yulLowering) reference(identifier jsoniter.Any) (jsoniter.Any, bool) {
+	if reference, ok := y.references[identifier.Get("src").ToString()]; ok && identifier.Get("src").ToString() != "" {
+		return reference, true
+	}
+	reference, ok := y.references[identifier.Get("name").ToString()]
+	return reference, ok
+}
+
+// slotVariable 返回 x.slot（0.7 之前为 x_slot）所指的状态变量。
+func (y *yulLowering) slotVariable(raw jsoniter.Any) *Variable {
+	if raw.Get("nodeType").ToString() != "YulIdentifier" {
+		return nil
+	}
+	reference, ok := y.reference(raw)
+	if !ok || !(reference.Get("isSlot").ToBool() || reference.Get("suffix").ToString() == "slot") {
+		return nil
+	}
+	return y.b.program.variables[reference.Get("declaration").ToInt()]
+}
+
+func (y *yulLowering) statement(raw jsoniter.Any) {
+	switch raw.Get("nodeType").ToString() {
+	case "YulBlock":
+		statements := raw.Get("statements")
+		for i := 0; i < statements.Size(); i++ {
+			y.statement(statements.Get(i))
+		}
+	case "YulVariableDeclaration":
+		y.assign(raw.Get("variables"), raw.Get("value"))
+	case "YulAssignment":
+		y.assign(raw.Get("variableNames"), raw.Get("value"))
+	case "YulExpressionStatement":
+		y.expression(raw.Get("expression"))
+	case "YulIf":
+		y.expression(raw.Get("condition"))
+		y.depth++
+		y.statement(raw.Get("body"))
+		y.depth--
+	case "YulSwitch":
+		y.expression(raw.Get("expression"))
+		y.depth++
+		cases := raw.Get("cases")
+		for i := 0; i < cases.Size(); i++ {
+			y.statement(cases.Get(i).Get("body"))
+		}
+		y.depth--
+	case "YulForLoop":
+		y.statement(raw.Get("pre"))
+		y.depth++
+		y.expression(raw.Get("condition"))
+		y.statement(raw.Get("body"))
+		y.statement(raw.Get("post"))
+		y.depth--
+	}
+}
+
+func (y *yulLowering) assign(names jsoniter.Any, value jsoniter.Any) {
+	var v *Value
+	if !isNull(value) {
+		v = y.expression(value)
+	}
+	for i := 0; i < names.Size(); i++ {
+		name := names.Get(i)
+		component := v
+		switch {
+		case v == nil:
+			component = y.b.constant("0", y.raw, nil)
+		case names.Size() > 1:
+			component = y.b.define(&Instr{Op: OpExtract, Index: i, Args: []*Value{v}}, y.raw, "", nil)
+		}
+		if reference, ok := y.reference(name); ok {
+			id := reference.Get("declaration").ToInt()
+			if _, ok := y.b.locals[id]; ok {
+				y.assigned[id] = component
+				y.nested[id] = y.nested[id] || y.depth > 0
+				continue
+			}
+		}
+		y.variables[name.Get("name").ToString()] = component
+	}
+}
+
+func (y *yulLowering) expression(raw jsoniter.Any) *Value {
+	b := y.b
+	switch raw.Get("nodeType").ToString() {
+	case "YulLiteral":
+		value := raw.Get("value").ToString()
+		if raw.Get("kind").ToString() == "string" {
+			value = `"` + value + `"`
+		}
+		return b.constant(value, y.raw, nil)
+	case "YulIdentifier":
+		return y.identifier(raw)
+	case "YulFunctionCall":
+	default:
+		return b.constant(raw.Get("nodeType").ToString(), y.raw, nil)
+	}
+
+	name := raw.Get("functionName").Get("name").ToString()
+	arguments := raw.Get("arguments")
+	switch {
+	case name == "sload" && arguments.Size() == 1:
+		if v := y.slotVariable(arguments.Get(0)); v != nil {
+			return b.define(&Instr{Op: OpSLoad, Variable: v}, y.raw, "", v.Type)
+		}
+		slot := y.expression(arguments.Get(0))
+		return b.define(&Instr{Op: OpSLoad, Name: "slot", Args: []*Value{slot}}, y.raw, "", nil)
+	case name == "sstore" && arguments.Size() == 2:
+		if v := y.slotVariable(arguments.Get(0)); v != nil {
+			value := y.expression(arguments.Get(1))
+			b.emit(&Instr{Op: OpSStore, Variable: v, Args: []*Value{value}}, y.raw)
+			return nil
+		}
+		slot := y.expression(arguments.Get(0))
+		value := y.expression(arguments.Get(1))
+		b.emit(&Instr{Op: OpSStore, Name: "slot", Args: []*Value{slot, value}}, y.raw)
+		return nil
+	case yulEnv[name] != "" && arguments.Size() == 0:
+		return b.define(&Instr{Op: OpEnv, Name: yulEnv[name]}, y.raw, "", nil)
+	}
+
+	args := make([]*Value, 0, arguments.Size())
+	for i := 0; i < arguments.Size(); i++ {
+		if v := y.expression(arguments.Get(i)); v != nil {
+			args = append(args, v)
+		}
+	}
+	if yulCalls[name] && len(args) == arguments.Size() && len(args) >= 2 {
+		// 目标地址放在最前面，与 solidity 中的底层调用保持一致。
+		args = append([]*Value{args[1], args[0]}, args[2:]...)
+		return b.define(&Instr{Op: OpLowLevelCall, Name: name, Args: args}, y.raw, "", nil)
+	}
+	return b.define(&Instr{Op: OpBuiltin, Name: name, Args: args}, y.raw, "", nil)
+}
+
+func (y *yulLowering) identifier(raw jsoniter.Any) *Value {
+	b := y.b
+	name := raw.Get("name").ToString()
+	if v, ok := y.variables[name]; ok {
+		return v
+	}
+	reference, ok := y.reference(raw)
+	switch {
+	case !ok && yulEnv[name] != "":
+		// solidity 0.5 之前可以省略没有参数的内置函数的括号，如 caller、gas。
+		return b.define(&Instr{Op: OpEnv, Name: yulEnv[name]}, y.raw, "", nil)
+	case !ok:
+		return b.constant(name, y.raw, nil)
+	}
+	id := reference.Get("declaration").ToInt()
+	switch {
+	case reference.Get("isSlot").ToBool() || reference.Get("isOffset").ToBool() || reference.Get("suffix").ToString() != "":
+		return b.constant(name, y.raw, nil)
+	case y.assigned[id] != nil:
+		return y.assigned[id]
+	}
+	if _, ok := b.locals[id]; ok {
+		return b.read(id, b.current)
+	}
+	if v := b.program.variables[id]; v != nil {
+		return b.stateVariable(v, y.raw)
+	}
+	return b.constant(name, y.raw, nil)
+}
+
+// yulParser 将 solidity 0.6 之前内联汇编的 operations 字符串解析为与 0.6 之后的 AST 字段相同结构的 Yul 语法树。
+type yulParser struct {
+	tokens []string
+	pos    int
+}
+
+// yulTokens 将汇编代码切分为标识符、字面值以及 {、}、(、)、,、:=、-> 等符号。
+func yulTokens(code string) []string {
+	var tokens []string
+	runes := []rune(code)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(runes) {
+				return append(tokens, string(runes[i:]))
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		case isYulIdentifier(r):
+			j := i
+			for j < len(runes) && isYulIdentifier(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		case i+1 < len(runes) && (string(runes[i:i+2]) == ":=" || string(runes[i:i+2]) == "->"):
+			tokens = append(tokens, string(runes[i:i+2]))
+			i += 2
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+func isYulIdentifier(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$' || r == '.'
+}
+
+func (p *yulParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *yulParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *yulParser) expect(token string) error {
+	if got := p.next(); got != token {
+		return fmt.Errorf("expected [%s] but got [%s]", token, got)
+	}
+	return nil
+}
+
+func (p *yulParser) identifier() (string, error) {
+	token := p.next()
+	if token == "" || !isYulIdentifier([]rune(token)[0]) || unicode.IsDigit([]rune(token)[0]) {
+		return "", fmt.Errorf("expected an identifier but got [%s]", token)
+	}
+	return token, nil
+}
+
+func (p *yulParser) block() (map[string]interface{}, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	statements := []interface{}{}
+	for p.peek() != "}" {
+		if p.peek() == "" {
+			return nil, fmt.Errorf("unexpected end of assembly")
+		}
+		statement, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	}
+	p.next()
+	return map[string]interface{}{"nodeType": "YulBlock", "statements": statements}, nil
+}
+
+func (p *yulParser) names() ([]interface{}, error) {
+	var names []interface{}
+	for {
+		name, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, map[string]interface{}{"nodeType": "YulIdentifier", "name": name})
+		if p.peek() != "," {
+			return names, nil
+		}
+		p.next()
+	}
+}
+
+func (p *yulParser) statement() (map[string]interface{}, error) {
+	switch p.peek() {
+	case "{":
+		return p.block()
+	case "let":
+		p.next()
+		names, err := p.names()
+		if err != nil {
+			return nil, err
+		}
+		statement := map[string]interface{}{"nodeType": "YulVariableDeclaration", "variables": names}
+		if p.peek() == ":=" {
+			p.next()
+			if statement["value"], err = p.expression(); err != nil {
+				return nil, err
+			}
+		}
+		return statement, nil
+	case "if":
+		p.next()
+		condition, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		body, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"nodeType": "YulIf", "condition": condition, "body": body}, nil
+	case "switch":
+		p.next()
+		expression, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		cases := []interface{}{}
+		for p.peek() == "case" || p.peek() == "default" {
+			c := map[string]interface{}{"nodeType": "YulCase", "value": "default"}
+			if p.next() == "case" {
+				if c["value"], err = p.expression(); err != nil {
+					return nil, err
+				}
+			}
+			if c["body"], err = p.block(); err != nil {
+				return nil, err
+			}
+			cases = append(cases, c)
+		}
+		return map[string]interface{}{"nodeType": "YulSwitch", "expression": expression, "cases": cases}, nil
+	case "for":
+		p.next()
+		pre, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		condition, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		post, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		body, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"nodeType": "YulForLoop", "pre": pre, "condition": condition, "post": post, "body": body}, nil
+	case "function":
+		p.next()
+		if _, err := p.identifier(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			if _, err := p.names(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		if p.peek() == "->" {
+			p.next()
+			if _, err := p.names(); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := p.block(); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"nodeType": "YulFunctionDefinition"}, nil
+	case "break":
+		p.next()
+		return map[string]interface{}{"nodeType": "YulBreak"}, nil
+	case "continue":
+		p.next()
+		return map[string]interface{}{"nodeType": "YulContinue"}, nil
+	case "leave":
+		p.next()
+		return map[string]interface{}{"nodeType": "YulLeave"}, nil
+	}
+
+	// 赋值语句以一个或多个标识符加上 := 开始，否则是表达式语句。
+	start := p.pos
+	if names, err := p.names(); err == nil && p.peek() == ":=" {
+		p.next()
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"nodeType": "YulAssignment", "variableNames": names, "value": value}, nil
+	}
+	p.pos = start
+	expression, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"nodeType": "YulExpressionStatement", "expression": expression}, nil
+}
+
+func (p *yulParser) expression() (map[string]interface{}, error) {
+	token := p.next()
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of assembly")
+	case strings.HasPrefix(token, `"`):
+		return map[string]interface{}{"nodeType": "YulLiteral", "kind": "string", "value": strings.Trim(token, `"`)}, nil
+	case unicode.IsDigit([]rune(token)[0]):
+		return map[string]interface{}{"nodeType": "YulLiteral", "kind": "number", "value": token}, nil
+	case token == "true" || token == "false":
+		return map[string]interface{}{"nodeType": "YulLiteral", "kind": "bool", "value": token}, nil
+	case !isYulIdentifier([]rune(token)[0]):
+		return nil, fmt.Errorf("unexpected [%s] in assembly", token)
+	}
+
+	identifier := map[string]interface{}{"nodeType": "YulIdentifier", "name": token}
+	if p.peek() != "(" {
+		return identifier, nil
+	}
+	p.next()
+	arguments := []interface{}{}
+	for p.peek() != ")" {
+		argument, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, argument)
+		if p.peek() == "," {
+			p.next()
+		} else if p.peek() != ")" {
+			return nil, fmt.Errorf("unexpected [%s] in arguments of [%s]", p.peek(), token)
+		}
+	}
+	p.next()
+	return map[string]interface{}{"nodeType": "YulFunctionCall", "functionName": identifier, "arguments": arguments}, nil
+}
@@ -0,0 +1,318 @@
+package layout
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Variable 是一个状态变量在 storage 中的位置，Offset 为槽内的字节偏移。
+type Variable struct {
+	Contract string `json:"contract"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Slot     int    `json:"slot"`
+	Offset   int    `json:"offset"`
+	Size     int    `json:"size"`
+}
+
+// Layout 是一个合约（包括继承来的状态变量）完整的 storage 布局。
+type Layout struct {
+	Contract  string      `json:"contract"`
+	Variables []*Variable `json:"variables"`
+}
+
+// Find 返回合约 contract 中声明的状态变量 name 在布局中的位置，l 为 nil 或者找不到时返回 nil。
+func (l *Layout) Find(contract string, name string) *Variable {
+	if l == nil {
+		return nil
+	}
+	for _, v := range l.Variables {
+		if v.Contract == contract && v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// size 为类型占用的字节数，whole 表示该类型必须从新的槽开始，并且之后的变量也要从新的槽开始。
+type size struct {
+	bytes int
+	whole bool
+}
+
+type resolver struct {
+	declarations map[int]jsoniter.Any
+	structs      map[int]size
+}
+
+var staticArray = regexp.MustCompile(`\[(\d+)\](?: storage.*)?$`)
+
+// Compute 按照 solc 的规则计算 SourceUnit 中每个合约的 storage 布局：状态变量按照 C3 线性化的逆序（最基础的合约在前）依次排列，
+// 小于 32 字节的变量在同一个槽内紧密排列，constant 和 immutable 变量不占用 storage。
+func Compute(sourceUnit jsoniter.Any) ([]*Layout, error) {
+	r := &resolver{declarations: make(map[int]jsoniter.Any), structs: make(map[int]size)}
+	r.collect(sourceUnit)
+
+	var layouts []*Layout
+	nodes := sourceUnit.Get("nodes")
+	for i := 0; i < nodes.Size(); i++ {
+		contract := nodes.Get(i)
+		if contract.Get("nodeType").ToString() != "ContractDefinition" {
+			continue
+		}
+		if kind := contract.Get("contractKind").ToString(); kind == "interface" || kind == "library" {
+			continue
+		}
+
+		linearized := contract.Get("linearizedBaseContracts")
+		var variables, owners []jsoniter.Any
+		for j := linearized.Size() - 1; j >= 0; j-- {
+			base, ok := r.declarations[linearized.Get(j).ToInt()]
+			if !ok {
+				return nil, fmt.Errorf("base contract [%d] of [%s] is not defined", linearized.Get(j).ToInt(), contract.Get("name").ToString())
+			}
+			for _, variable := range StateVariables(base) {
+				variables = append(variables, variable)
+				owners = append(owners, base)
+			}
+		}
+
+		layout := &Layout{Contract: contract.Get("name").ToString(), Variables: []*Variable{}}
+		slot, offset := 0, 0
+		for k, variable := range variables {
+			s, err := r.sizeOf(variable.Get("typeName"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute the size of [%s.%s]: [%v]", layout.Contract, variable.Get("name").ToString(), err)
+			}
+			if offset > 0 && (s.whole || offset+s.bytes > 32) {
+				slot, offset = slot+1, 0
+			}
+			layout.Variables = append(layout.Variables, &Variable{
+				Contract: owners[k].Get("name").ToString(),
+				Name:     variable.Get("name").ToString(),
+				Type:     variable.Get("typeDescriptions").Get("typeString").ToString(),
+				Slot:     slot,
+				Offset:   offset,
+				Size:     s.bytes,
+			})
+			if s.whole {
+				slot, offset = slot+(s.bytes+31)/32, 0
+			} else {
+				offset += s.bytes
+			}
+		}
+		layouts = append(layouts, layout)
+	}
+
+	return layouts, nil
+}
+
+// StateVariables 返回合约自身声明的、占用 storage 的状态变量。
+func StateVariables(contract jsoniter.Any) []jsoniter.Any {
+	var variables []jsoniter.Any
+	nodes := contract.Get("nodes")
+	for i := 0; i < nodes.Size(); i++ {
+		node := nodes.Get(i)
+		if node.Get("nodeType").ToString() != "VariableDeclaration" || !node.Get("stateVariable").ToBool() {
+			continue
+		}
+		if node.Get("constant").ToBool() {
+			continue
+		}
+		if mutability := node.Get("mutability").ToString(); mutability == "constant" || mutability == "immutable" {
+			continue
+		}
+		variables = append(variables, node)
+	}
+	return variables
+}
+
+func (r *resolver) collect(raw jsoniter.Any) {
+	switch raw.ValueType() {
+	case jsoniter.ObjectValue:
+		switch raw.Get("nodeType").ToString() {
+		case "ContractDefinition", "StructDefinition", "EnumDefinition", "UserDefinedValueTypeDefinition":
+			r.declarations[raw.Get("id").ToInt()] = raw
+		}
+		for _, key := range raw.Keys() {
+			r.collect(raw.Get(key))
+		}
+	case jsoniter.ArrayValue:
+		for i := 0; i < raw.Size(); i++ {
+			r.collect(raw.Get(i))
+		}
+	}
+}
+
+func (r *resolver) sizeOf(typeName jsoniter.Any) (size, error) {
+	switch typeName.Get("nodeType").ToString() {
+	case "ElementaryTypeName":
+		name := typeName.Get("name").ToString()
+		if typeString := typeName.Get("typeDescriptions").Get("typeString").ToString(); typeString != "" {
+			name = typeString
+		}
+		return elementary(name)
+	case "Mapping":
+		return size{bytes: 32, whole: true}, nil
+	case "FunctionTypeName":
+		if typeName.Get("visibility").ToString() == "external" {
+			return size{bytes: 24}, nil
+		}
+		return size{bytes: 8}, nil
+	case "ArrayTypeName":
+		match := staticArray.FindStringSubmatch(typeName.Get("typeDescriptions").Get("typeString").ToString())
+		if match == nil {
+			return size{bytes: 32, whole: true}, nil
+		}
+		length, err := strconv.Atoi(match[1])
+		if err != nil {
+			return size{}, fmt.Errorf("invalid array length [%s]: [%v]", match[1], err)
+		}
+		element, err := r.sizeOf(typeName.Get("baseType"))
+		if err != nil {
+			return size{}, err
+		}
+		if element.whole {
+			return size{bytes: length * ((element.bytes + 31) / 32) * 32, whole: true}, nil
+		}
+		perSlot := 32 / element.bytes
+		return size{bytes: (length + perSlot - 1) / perSlot * 32, whole: true}, nil
+	case "UserDefinedTypeName", "IdentifierPath":
+		id := typeName.Get("referencedDeclaration").ToInt()
+		declaration, ok := r.declarations[id]
+		if !ok {
+			return size{}, fmt.Errorf("declaration [%d] of [%s] is not defined", id, typeName.Get("name").ToString())
+		}
+		switch declaration.Get("nodeType").ToString() {
+		case "ContractDefinition":
+			return size{bytes: 20}, nil
+		case "EnumDefinition":
+			if declaration.Get("members").Size() > 256 {
+				return size{bytes: 2}, nil
+			}
+			return size{bytes: 1}, nil
+		case "UserDefinedValueTypeDefinition":
+			return r.sizeOf(declaration.Get("underlyingType"))
+		case "StructDefinition":
+			return r.structSize(id, declaration)
+		}
+	}
+
+	return size{}, fmt.Errorf("unsupported type [%s]", typeName.Get("typeDescriptions").Get("typeString").ToString())
+}
+
+func (r *resolver) structSize(id int, declaration jsoniter.Any) (size, error) {
+	if s, ok := r.structs[id]; ok {
+		return s, nil
+	}
+
+	slot, offset := 0, 0
+	members := declaration.Get("members")
+	for i := 0; i < members.Size(); i++ {
+		s, err := r.sizeOf(members.Get(i).Get("typeName"))
+		if err != nil {
+			return size{}, err
+		}
+		if offset > 0 && (s.whole || offset+s.bytes > 32) {
+			slot, offset = slot+1, 0
+		}
+		if s.whole {
+			slot, offset = slot+(s.bytes+31)/32, 0
+		} else {
+			offset += s.bytes
+		}
+	}
+	if offset > 0 {
+		slot++
+	}
+
+	s := size{bytes: slot * 32, whole: true}
+	r.structs[id] = s
+	return s, nil
+}
+
+func elementary(name string) (size, error) {
+	if fields := strings.Fields(name); len(fields) > 0 {
+		name = fields[0]
+	}
+	switch {
+	case name == "address":
+		return size{bytes: 20}, nil
+	case name == "bool", name == "byte":
+		return size{bytes: 1}, nil
+	case name == "string", name == "bytes":
+		return size{bytes: 32, whole: true}, nil
+	case name == "uint", name == "int":
+		return size{bytes: 32}, nil
+	case name == "fixed", name == "ufixed":
+		return size{bytes: 16}, nil
+	case strings.HasPrefix(name, "uint"), strings.HasPrefix(name, "int"):
+		bits, err := strconv.Atoi(strings.TrimPrefix(strings.TrimPrefix(name, "u"), "int"))
+		if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+			return size{}, fmt.Errorf("unsupported type [%s]", name)
+		}
+		return size{bytes: bits / 8}, nil
+	case strings.HasPrefix(name, "bytes"):
+		n, err := strconv.Atoi(strings.TrimPrefix(name, "bytes"))
+		if err != nil || n <= 0 || n > 32 {
+			return size{}, fmt.Errorf("unsupported type [%s]", name)
+		}
+		return size{bytes: n}, nil
+	case strings.HasPrefix(name, "fixed"), strings.HasPrefix(name, "ufixed"):
+		var bits, decimals int
+		if _, err := fmt.Sscanf(strings.TrimPrefix(strings.TrimPrefix(name, "u"), "fixed"), "%dx%d", &bits, &decimals); err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+			return size{}, fmt.Errorf("unsupported type [%s]", name)
+		}
+		return size{bytes: bits / 8}, nil
+	}
+
+	return size{}, fmt.Errorf("unsupported type [%s]", name)
+}
+
+// Change 是插桩前后一个合约的 storage 布局的变化。
+type Change struct {
+	Contract    string
+	Description string
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("contract [%s] %s", c.Contract, c.Description)
+}
+
+// Diff 比较插桩前后每个合约按照继承顺序排列的状态变量声明，按照合约名的顺序返回布局发生变化的合约；
+// 只在 after 中出现的合约（插桩不会新增合约）被忽略。
+func Diff(before map[string][]string, after map[string][]string) []*Change {
+	contracts := make([]string, 0, len(before))
+	for contract := range before {
+		contracts = append(contracts, contract)
+	}
+	sort.Strings(contracts)
+	var changes []*Change
+	for _, contract := range contracts {
+		declarations := after[contract]
+		if declarations == nil {
+			continue
+		}
+		original := before[contract]
+		i := 0
+		for i < len(original) && i < len(declarations) && original[i] == declarations[i] {
+			i++
+		}
+		switch {
+		case i == len(original) && i == len(declarations):
+			continue
+		case i == len(original):
+			changes = append(changes, &Change{contract, fmt.Sprintf("appends [%s] after its original state variables", strings.Join(declarations[i:], ", "))})
+		case i == len(declarations):
+			changes = append(changes, &Change{contract, fmt.Sprintf("loses [%s]", strings.Join(original[i:], ", "))})
+		default:
+			changes = append(changes, &Change{contract, fmt.Sprintf("has [%s] in place of [%s] at position %d", declarations[i], original[i], i)})
+		}
+	}
+	return changes
+}
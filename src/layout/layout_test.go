@@ -0,0 +1,108 @@
+package layout
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func elementaryType(name string) string {
+	return fmt.Sprintf(`{"nodeType":"ElementaryTypeName","name":%q,"typeDescriptions":{"typeString":%q}}`, name, name)
+}
+
+func stateVariable(name string, typeName string, typeString string, extra string) string {
+	return fmt.Sprintf(`{"nodeType":"VariableDeclaration","name":%q,"stateVariable":true,"constant":false%s,"typeName":%s,"typeDescriptions":{"typeString":%q}}`, name, extra, typeName, typeString)
+}
+
+func TestCompute(t *testing.T) {
+	// contract Base { address owner; bool paused; uint256 constant X = 1; }
+	// contract C is Base { struct S { uint128 a; uint128 b; uint8 c; } uint8[40] small; S s; mapping(address => uint) m; uint64 tail; }
+	sourceUnit := jsoniter.Get([]byte(`{"nodeType":"SourceUnit","nodes":[
+		{"nodeType":"ContractDefinition","id":1,"name":"Base","contractKind":"contract","linearizedBaseContracts":[1],"nodes":[
+			` + stateVariable("owner", elementaryType("address"), "address", "") + `,
+			` + stateVariable("paused", elementaryType("bool"), "bool", "") + `,
+			` + stateVariable("X", elementaryType("uint256"), "uint256", `,"mutability":"constant"`) + `]},
+		{"nodeType":"ContractDefinition","id":2,"name":"C","contractKind":"contract","linearizedBaseContracts":[2,1],"nodes":[
+			{"nodeType":"StructDefinition","id":3,"name":"S","members":[
+				{"nodeType":"VariableDeclaration","name":"a","typeName":` + elementaryType("uint128") + `},
+				{"nodeType":"VariableDeclaration","name":"b","typeName":` + elementaryType("uint128") + `},
+				{"nodeType":"VariableDeclaration","name":"c","typeName":` + elementaryType("uint8") + `}]},
+			` + stateVariable("small", `{"nodeType":"ArrayTypeName","baseType":`+elementaryType("uint8")+`,"typeDescriptions":{"typeString":"uint8[40]"}}`, "uint8[40]", "") + `,
+			` + stateVariable("s", `{"nodeType":"UserDefinedTypeName","referencedDeclaration":3}`, "struct C.S", "") + `,
+			` + stateVariable("m", `{"nodeType":"Mapping"}`, "mapping(address => uint256)", "") + `,
+			` + stateVariable("tail", elementaryType("uint64"), "uint64", "") + `]},
+		{"nodeType":"ContractDefinition","id":4,"name":"L","contractKind":"library","linearizedBaseContracts":[4],"nodes":[]}]}`))
+
+	layouts, err := Compute(sourceUnit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*Layout{
+		{Contract: "Base", Variables: []*Variable{
+			{Contract: "Base", Name: "owner", Type: "address", Slot: 0, Offset: 0, Size: 20},
+			{Contract: "Base", Name: "paused", Type: "bool", Slot: 0, Offset: 20, Size: 1},
+		}},
+		{Contract: "C", Variables: []*Variable{
+			{Contract: "Base", Name: "owner", Type: "address", Slot: 0, Offset: 0, Size: 20},
+			{Contract: "Base", Name: "paused", Type: "bool", Slot: 0, Offset: 20, Size: 1},
+			{Contract: "C", Name: "small", Type: "uint8[40]", Slot: 1, Offset: 0, Size: 64},
+			{Contract: "C", Name: "s", Type: "struct C.S", Slot: 3, Offset: 0, Size: 64},
+			{Contract: "C", Name: "m", Type: "mapping(address => uint256)", Slot: 5, Offset: 0, Size: 32},
+			{Contract: "C", Name: "tail", Type: "uint64", Slot: 6, Offset: 0, Size: 8},
+		}},
+	}
+	if !reflect.DeepEqual(expected, layouts) {
+		for _, layout := range layouts {
+			for _, variable := range layout.Variables {
+				t.Logf("%s %+v", layout.Contract, variable)
+			}
+		}
+		t.Fatal("unexpected storage layout")
+	}
+}
+
+func TestComputeUnsupportedType(t *testing.T) {
+	sourceUnit := jsoniter.Get([]byte(`{"nodeType":"SourceUnit","nodes":[
+		{"nodeType":"ContractDefinition","id":1,"name":"C","contractKind":"contract","linearizedBaseContracts":[1],"nodes":[
+			` + stateVariable("x", elementaryType("uint7"), "uint7", "") + `]}]}`))
+
+	if _, err := Compute(sourceUnit); err == nil {
+		t.Fatal("expected an error for uint7")
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	if got := hex.EncodeToString(Keccak256(nil)); got != "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470" {
+		t.Errorf("unexpected keccak256 of the empty input %s", got)
+	}
+	if got := hex.EncodeToString(Keccak256([]byte("abc"))); got != "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45" {
+		t.Errorf("unexpected keccak256 of abc %s", got)
+	}
+	// EIP-7201 中的示例。
+	if got := Namespace("example.main"); got != "0x183a6125c38840424c4a85fa12bab2ab606c4b6d0e7cc73c0c06ba5300eab500" {
+		t.Errorf("unexpected slot of namespace example.main %s", got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := map[string][]string{"A": {"address owner"}, "B": {"address owner", "uint256 x"}, "C": {"bool paused"}}
+	after := map[string][]string{"A": {"address owner", "bytes xxx_track_owner"}, "B": {"address owner", "bytes xxx_track_owner", "uint256 x"}, "C": {"bool paused"}}
+	expected := []string{
+		"contract [A] appends [bytes xxx_track_owner] after its original state variables",
+		"contract [B] has [bytes xxx_track_owner] in place of [uint256 x] at position 1",
+	}
+	var got []string
+	for _, change := range Diff(before, after) {
+		got = append(got, change.String())
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected layout changes %v", got)
+	}
+	if got := Diff(before, before); len(got) != 0 {
+		t.Errorf("unexpected layout changes %v", got)
+	}
+}
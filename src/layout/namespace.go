@@ -0,0 +1,88 @@
+package layout
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"math/bits"
+)
+
+// Namespace 返回 EIP-7201 中命名空间 id 的根 slot：keccak256(abi.encode(uint256(keccak256(id)) - 1)) & ~bytes32(uint256(0xff))，
+// 以 0x 开头的 64 位十六进制表示。
+func Namespace(id string) string {
+	n := new(big.Int).SetBytes(Keccak256([]byte(id)))
+	n.Sub(n, big.NewInt(1))
+	encoded := make([]byte, 32)
+	n.FillBytes(encoded)
+	slot := Keccak256(encoded)
+	slot[31] = 0
+	return "0x" + hex.EncodeToString(slot)
+}
+
+// Keccak256 返回以太坊使用的 keccak256 哈希，填充方式为原始的 Keccak（0x01），而不是 SHA3-256（0x06）。
+func Keccak256(data []byte) []byte {
+	const rate = 136
+	var state [25]uint64
+	padded := make([]byte, len(data), len(data)+rate)
+	copy(padded, data)
+	padded = append(padded, 0x01)
+	for len(padded)%rate != 0 {
+		padded = append(padded, 0)
+	}
+	padded[len(padded)-1] |= 0x80
+	for ; len(padded) > 0; padded = padded[rate:] {
+		for i := 0; i < rate/8; i++ {
+			state[i] ^= binary.LittleEndian.Uint64(padded[i*8:])
+		}
+		keccakF(&state)
+	}
+	out := make([]byte, 32)
+	for i := 0; i < 4; i++ {
+		binary.LittleEndian.PutUint64(out[i*8:], state[i])
+	}
+	return out
+}
+
+var (
+	roundConstants = [24]uint64{
+		0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+		0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+		0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+		0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+		0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+		0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+	}
+	rotations = [24]int{1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14, 27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44}
+	lanes     = [24]int{10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4, 15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1}
+)
+
+// keccakF 是 Keccak-f[1600] 置换。
+func keccakF(state *[25]uint64) {
+	var c [5]uint64
+	for _, rc := range roundConstants {
+		// theta
+		for i := 0; i < 5; i++ {
+			c[i] = state[i] ^ state[i+5] ^ state[i+10] ^ state[i+15] ^ state[i+20]
+		}
+		for i := 0; i < 5; i++ {
+			t := c[(i+4)%5] ^ bits.RotateLeft64(c[(i+1)%5], 1)
+			for j := 0; j < 25; j += 5 {
+				state[j+i] ^= t
+			}
+		}
+		// rho 与 pi
+		t := state[1]
+		for i, lane := range lanes {
+			t, state[lane] = state[lane], bits.RotateLeft64(t, rotations[i])
+		}
+		// chi
+		for j := 0; j < 25; j += 5 {
+			copy(c[:], state[j:j+5])
+			for i := 0; i < 5; i++ {
+				state[j+i] ^= ^c[(i+1)%5] & c[(i+2)%5]
+			}
+		}
+		// iota
+		state[0] ^= rc
+	}
+}
@@ -0,0 +1,222 @@
+package src
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/geistwelt/logging"
+)
+
+// NewLogger 根据命令行参数创建全局唯一的日志记录器，日志默认输出到 stderr；
+// 返回的 close 函数用于在程序退出前关闭日志文件。
+func NewLogger(level string, format string, file string, quiet bool) (logging.Logger, func() error, error) {
+	filterLevel, err := ParseLogLevel(level)
+	if err != nil {
+		return nil, nil, err
+	}
+	if quiet {
+		filterLevel = logging.ErrorLevel
+	}
+
+	var writer io.Writer = os.Stderr
+	closeFn := func() error { return nil }
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open log file [%s]: [%v]", file, err)
+		}
+		writer = f
+		closeFn = f.Close
+	}
+
+	switch format {
+	case "text":
+		spec := "[%{time}] [%{level}] [%{module}] %{location} => %{message}"
+		if isTerminal(writer) {
+			spec = "%{color}[%{time}] [%{module}] %{location}%{color:reset} => %{message}"
+		}
+		logger, err := logging.NewLogger(logging.Option{
+			Module:         "TaintGuard",
+			FilterLevel:    filterLevel,
+			Spec:           spec,
+			FormatSelector: "terminal",
+			Writer:         writer,
+		})
+		if err != nil {
+			closeFn()
+			return nil, nil, err
+		}
+		return logger, closeFn, nil
+	case "json":
+		return &jsonLogger{mutex: new(sync.Mutex), module: "TaintGuard", filterLevel: filterLevel, writer: writer}, closeFn, nil
+	default:
+		closeFn()
+		return nil, nil, fmt.Errorf("unknown log format [%s], expected json or text", format)
+	}
+}
+
+// ParseLogLevel 将 debug、info、warn、error 解析为对应的日志级别。
+func ParseLogLevel(level string) (logging.LogLevel, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return logging.DebugLevel, nil
+	case "info":
+		return logging.InfoLevel, nil
+	case "warn", "warning":
+		return logging.WarnLevel, nil
+	case "error":
+		return logging.ErrorLevel, nil
+	default:
+		return 0, fmt.Errorf("unknown log level [%s], expected one of debug, info, warn, error", level)
+	}
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// jsonLogger 每条日志输出一行合法的 json，便于 CI 解析；logging 自带的 json 格式不会转义消息内容。
+type jsonLogger struct {
+	mutex       *sync.Mutex
+	module      string
+	filterLevel logging.LogLevel
+	writer      io.Writer
+}
+
+func (l *jsonLogger) log(level logging.LogLevel, msg string, kvs []interface{}) {
+	if level > l.filterLevel {
+		return
+	}
+
+	entry := map[string]interface{}{
+		"time":    time.Now().Format(time.RFC3339Nano),
+		"level":   strings.TrimSpace(level.LowercaseString()),
+		"module":  l.module,
+		"message": msg,
+	}
+	if _, file, line, ok := runtime.Caller(2); ok {
+		entry["location"] = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		entry[fmt.Sprint(kvs[i])] = fmt.Sprint(kvs[i+1])
+	}
+
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mutex.Lock()
+	l.writer.Write(append(bz, '\n'))
+	l.mutex.Unlock()
+}
+
+func (l *jsonLogger) Debug(msg string, kvs ...interface{}) { l.log(logging.DebugLevel, msg, kvs) }
+func (l *jsonLogger) Debugf(format string, args ...interface{}) {
+	l.log(logging.DebugLevel, fmt.Sprintf(format, args...), nil)
+}
+func (l *jsonLogger) Info(msg string, kvs ...interface{}) { l.log(logging.InfoLevel, msg, kvs) }
+func (l *jsonLogger) Infof(format string, args ...interface{}) {
+	l.log(logging.InfoLevel, fmt.Sprintf(format, args...), nil)
+}
+func (l *jsonLogger) Warn(msg string, kvs ...interface{}) { l.log(logging.WarnLevel, msg, kvs) }
+func (l *jsonLogger) Warnf(format string, args ...interface{}) {
+	l.log(logging.WarnLevel, fmt.Sprintf(format, args...), nil)
+}
+func (l *jsonLogger) Error(msg string, kvs ...interface{}) { l.log(logging.ErrorLevel, msg, kvs) }
+func (l *jsonLogger) Errorf(format string, args ...interface{}) {
+	l.log(logging.ErrorLevel, fmt.Sprintf(format, args...), nil)
+}
+func (l *jsonLogger) Panic(msg string, kvs ...interface{}) {
+	l.log(logging.PanicLevel, msg, kvs)
+	panic(msg)
+}
+func (l *jsonLogger) Panicf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	l.log(logging.PanicLevel, msg, nil)
+	panic(msg)
+}
+
+func (l *jsonLogger) SetModule(module string, level logging.LogLevel) {
+	if l.module == module {
+		l.filterLevel = level
+	}
+}
+
+func (l *jsonLogger) DeriveChildLogger(module string) logging.Logger {
+	child := *l
+	child.module = module
+	return &child
+}
+
+func (l *jsonLogger) Update(opt logging.Option) error {
+	if opt.Module != "" {
+		l.module = opt.Module
+	}
+	if opt.FilterLevel != 0 {
+		l.filterLevel = opt.FilterLevel
+	}
+	if opt.Writer != nil {
+		l.writer = opt.Writer
+	}
+	return nil
+}
+
+// DropRecorder 记录输出源码时因为节点类型未知而被丢弃的节点，见 Dropf；所有日志仍然交给内嵌的 Logger。
+type DropRecorder struct {
+	logging.Logger
+	Dropped []string
+}
+
+// Dropf 输出节点在输出源码时因为类型未知而被丢弃的警告，各个版本 SourceCode 中未知类型的分支都通过它输出；
+// logger 是 *DropRecorder 时同时记录被丢弃的节点。
+func Dropf(logger logging.Logger, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if r, ok := logger.(*DropRecorder); ok {
+		r.Dropped = append(r.Dropped, strings.TrimSuffix(message, "."))
+	}
+	logger.Warnf("%s", message)
+}
+
+// SourceCoder 是各个版本语法树节点都实现了的方法。
+type SourceCoder interface {
+	SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string
+}
+
+// PrintSourceCode 输出 node 的源码，汇总因为节点类型未知而被丢弃的节点；被丢弃的节点会使输出的合约缺少代码，
+// strict 模式下只要存在被丢弃的节点就返回错误。
+func PrintSourceCode(node SourceCoder, strict bool, logger logging.Logger) (string, error) {
+	recorder := &DropRecorder{Logger: logger}
+	code := node.SourceCode(false, false, "", recorder)
+	if len(recorder.Dropped) == 0 {
+		return code, nil
+	}
+	logger.Warnf("Coverage: dropped [%d] node(s) when printing the source code, first: [%s].", len(recorder.Dropped), recorder.Dropped[0])
+	if strict {
+		return "", Errorf(ParseError, "strict mode: %s", recorder.Dropped[0])
+	}
+	return code, nil
+}
+
+// SilentLogger 用于仅比较两个节点源码是否相同的场景，此时生成源码产生的日志没有意义。
+var SilentLogger = logging.MustNewLogger(logging.Option{
+	Module:         "Silent",
+	FilterLevel:    logging.PanicLevel,
+	FormatSelector: "terminal",
+	Writer:         io.Discard,
+})
@@ -0,0 +1,69 @@
+package src
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/logging"
+)
+
+func TestJSONLogger(t *testing.T) {
+	logger, closeFn, err := NewLogger("info", "json", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFn()
+
+	var buf bytes.Buffer
+	logger.Update(logging.Option{Writer: &buf})
+	logger.Debugf("hidden")
+	logger.Infof("call [%s] with \"quotes\"", "f()")
+	logger.Warn("kv", "contract", "A")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), buf.String())
+	}
+
+	var entry map[string]string
+	if err = json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["level"] != "info" || entry["message"] != `call [f()] with "quotes"` {
+		t.Fatalf("unexpected entry: %v", entry)
+	}
+	if err = json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry["level"] != "warn" || entry["contract"] != "A" {
+		t.Fatalf("unexpected entry: %v", entry)
+	}
+
+	if _, _, err = NewLogger("verbose", "json", "", false); err == nil {
+		t.Fatal("expected an error for unknown log level")
+	}
+}
+
+func TestDropRecorder(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _, err := NewLogger("warn", "json", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.Update(logging.Option{Writer: &buf})
+
+	recorder := &DropRecorder{Logger: logger}
+	Dropf(recorder, "Unknown node nodeType [%s] for SourceUnit [src:%s].", "Foo", "0:10:0")
+	Dropf(recorder, "Unknown argument nodeType for YulFunctionCall [src:%s].", "3:4:0")
+	recorder.Warnf("Unknown function [%s] called by delegatecall.", "Lib")
+
+	expected := []string{"Unknown node nodeType [Foo] for SourceUnit [src:0:10:0]", "Unknown argument nodeType for YulFunctionCall [src:3:4:0]"}
+	if strings.Join(recorder.Dropped, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected dropped nodes %q", recorder.Dropped)
+	}
+	if strings.Count(buf.String(), "\n") != 3 {
+		t.Errorf("every warning should be forwarded, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,82 @@
+package roundtrip
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// 使用 -update 将重新生成的源码写入 contracts/roundtrip/<version>/，之后用 solc 编译得到对应的语法树：
+//
+//	go test ./src/roundtrip -update
+//	solc --ast-compact-json contracts/roundtrip/v0.8/1.sol > contracts/roundtrip/v0.8/1.sol_json.ast
+var update = flag.Bool("update", false, "write regenerated sources to contracts/roundtrip")
+
+var versions = []string{"v0.4", "v0.5", "v0.6", "v0.8"}
+
+// TestRoundTrip 把 contracts/<version> 中的语法树还原为源码，与 contracts/roundtrip 中用 solc 重新编译得到的语法树比较；
+// 解析时遇到无法识别的节点，或者缺少编译得到的语法树时，对应的合约失败。
+func TestRoundTrip(t *testing.T) {
+	for _, version := range versions {
+		fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", version, "*.sol_json.ast"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, fixture := range fixtures {
+			name := strings.TrimSuffix(filepath.Base(fixture), "_json.ast")
+			t.Run(version+"/"+name, func(t *testing.T) {
+				original, err := os.ReadFile(fixture)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				code, unknown, err := Regenerate(version, jsoniter.Get(original), src.SilentLogger)
+				if err != nil {
+					t.Fatalf("failed to regenerate source code: [%v]", err)
+				}
+				if len(unknown) > 0 {
+					t.Fatalf("unknown nodes are dropped from the regenerated source code: %v", unknown)
+				}
+
+				dir := filepath.Join("..", "..", "contracts", "roundtrip", version)
+				if *update {
+					if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+						t.Fatal(err)
+					}
+					if err = os.WriteFile(filepath.Join(dir, name), []byte(code), 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				regenerated, err := os.ReadFile(filepath.Join(dir, name+"_json.ast"))
+				if os.IsNotExist(err) {
+					t.Fatalf("no round-trip ast for [%s], run with -update and compile %s with solc --ast-compact-json", fixture, filepath.Join(dir, name))
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				stored, err := os.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(stored) != code {
+					t.Fatalf("[%s] is stale, run with -update and recompile it with solc", filepath.Join(dir, name))
+				}
+
+				d, err := Compare(original, regenerated)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d != nil {
+					t.Fatal(d.String())
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package roundtrip
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/geistwelt/logging"
+	ast04 "github.com/geistwelt/taintguard/src/v0.4/ast"
+	ast05 "github.com/geistwelt/taintguard/src/v0.5/ast"
+	ast06 "github.com/geistwelt/taintguard/src/v0.6/ast"
+	ast08 "github.com/geistwelt/taintguard/src/v0.8/ast"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// ignoredKeys 中的字段在两次编译之间必然不同（节点 id、源码位置、引用的声明 id 等），比较时直接跳过。
+var ignoredKeys = map[string]bool{
+	"absolutePath":             true,
+	"assignments":              true,
+	"baseFunctions":            true,
+	"baseModifiers":            true,
+	"contractDependencies":     true,
+	"declaration":              true,
+	"exportedSymbols":          true,
+	"functionReturnParameters": true,
+	"id":                       true,
+	"internalFunctionIDs":      true,
+	"license":                  true,
+	"linearizedBaseContracts":  true,
+	"nameLocation":             true,
+	"nameLocations":            true,
+	"nativeSrc":                true,
+	"overloadedDeclarations":   true,
+	"referencedDeclaration":    true,
+	"scope":                    true,
+	"sourceUnit":               true,
+	"src":                      true,
+	"superFunction":            true,
+	"usedErrors":               true,
+	"usedEvents":               true,
+}
+
+// typeIDs 匹配 typeIdentifier 中嵌入的声明 id，例如 t_struct$_S_$45_storage 中的 45；
+// 数组长度等其它数字是类型的一部分，不能去掉。
+var typeIDs = regexp.MustCompile(`((?:contract|enum|struct|super|userDefinedValueType)\$_[A-Za-z0-9_$]*?_\$)[0-9]+|(t_module_)[0-9]+`)
+
+// Regenerate 按照 solidity 版本解析 raw 中的 SourceUnit，并返回还原出的源码，不做任何插桩；
+// unknown 为解析时无法识别、因此没有出现在源码中的节点，格式为 nodeType [src]。
+func Regenerate(version string, raw jsoniter.Any, logger logging.Logger) (code string, unknown []string, err error) {
+	switch version {
+	case "v0.4":
+		gn := ast04.NewGlobalNodes()
+		su, err := ast04.GetSourceUnit(gn, raw, logger)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, node := range gn.UnknownNodes() {
+			unknown = append(unknown, fmt.Sprintf("%s [%s]", node.NodeType, node.Src))
+		}
+		return su.SourceCode(false, false, "", logger), unknown, nil
+	case "v0.5":
+		gn := ast05.NewGlobalNodes()
+		su, err := ast05.GetSourceUnit(gn, raw, logger)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, node := range gn.UnknownNodes() {
+			unknown = append(unknown, fmt.Sprintf("%s [%s]", node.NodeType, node.Src))
+		}
+		return su.SourceCode(false, false, "", logger), unknown, nil
+	case "v0.6":
+		gn := ast06.NewGlobalNodes()
+		su, err := ast06.GetSourceUnit(gn, raw, logger)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, node := range gn.UnknownNodes() {
+			unknown = append(unknown, fmt.Sprintf("%s [%s]", node.NodeType, node.Src))
+		}
+		return su.SourceCode(false, false, "", logger), unknown, nil
+	case "v0.7", "v0.8":
+		gn := ast08.NewGlobalNodes()
+		su, err := ast08.GetSourceUnit(gn, raw, logger)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, node := range gn.UnknownNodes() {
+			unknown = append(unknown, fmt.Sprintf("%s [%s]", node.NodeType, node.Src))
+		}
+		return su.SourceCode(false, false, "", logger), unknown, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported solidity version [%s]", version)
+	}
+}
+
+// Divergence 描述两棵语法树第一次出现差异的位置。
+type Divergence struct {
+	Path     string // e.g. nodes[3].nodes[1].body.statements[0]
+	NodeType string // nodeType of the closest enclosing node in the original tree
+	Src      string // src of the closest enclosing node in the original tree
+	Expected string
+	Actual   string
+}
+
+func (d *Divergence) String() string {
+	return fmt.Sprintf("first divergence at [%s] in nodeType [%s] [src:%s]: expected [%s], got [%s]", d.Path, d.NodeType, d.Src, d.Expected, d.Actual)
+}
+
+// Compare 结构化地比较原始语法树与重新编译得到的语法树，忽略 ignoredKeys 中的字段。
+// 两者等价时返回 nil。
+func Compare(expected []byte, actual []byte) (*Divergence, error) {
+	var e, a interface{}
+	if err := json.Unmarshal(expected, &e); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal expected ast: [%v]", err)
+	}
+	if err := json.Unmarshal(actual, &a); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal actual ast: [%v]", err)
+	}
+
+	return compare(e, a, "", "", ""), nil
+}
+
+func compare(e interface{}, a interface{}, path string, nodeType string, src string) *Divergence {
+	switch ev := e.(type) {
+	case map[string]interface{}:
+		av, ok := a.(map[string]interface{})
+		if !ok {
+			return &Divergence{Path: path, NodeType: nodeType, Src: src, Expected: "object", Actual: describe(a)}
+		}
+
+		if nt, ok := ev["nodeType"].(string); ok {
+			nodeType = nt
+			src, _ = ev["src"].(string)
+		}
+
+		keys := make([]string, 0, len(ev)+len(av))
+		seen := make(map[string]bool)
+		for key := range ev {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+		for key := range av {
+			if !seen[key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if ignoredKeys[key] {
+				continue
+			}
+			if key == "typeIdentifier" {
+				if d := compare(normalize(ev[key]), normalize(av[key]), join(path, key), nodeType, src); d != nil {
+					return d
+				}
+				continue
+			}
+			if d := compare(ev[key], av[key], join(path, key), nodeType, src); d != nil {
+				return d
+			}
+		}
+	case []interface{}:
+		av, ok := a.([]interface{})
+		if !ok {
+			return &Divergence{Path: path, NodeType: nodeType, Src: src, Expected: "array", Actual: describe(a)}
+		}
+
+		for i := 0; i < len(ev) && i < len(av); i++ {
+			if d := compare(ev[i], av[i], fmt.Sprintf("%s[%d]", path, i), nodeType, src); d != nil {
+				return d
+			}
+		}
+
+		if len(ev) != len(av) {
+			return &Divergence{Path: path, NodeType: nodeType, Src: src, Expected: fmt.Sprintf("%d elements", len(ev)), Actual: fmt.Sprintf("%d elements", len(av))}
+		}
+	default:
+		if describe(e) != describe(a) {
+			return &Divergence{Path: path, NodeType: nodeType, Src: src, Expected: describe(e), Actual: describe(a)}
+		}
+	}
+
+	return nil
+}
+
+// normalize 去掉 typeIdentifier 中嵌入的声明 id，v 不是字符串时原样返回。
+func normalize(v interface{}) interface{} {
+	if s, ok := v.(string); ok {
+		return typeIDs.ReplaceAllString(s, "${1}${2}")
+	}
+	return v
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func describe(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		if nodeType, ok := value["nodeType"].(string); ok {
+			return nodeType
+		}
+		return "object"
+	case []interface{}:
+		return fmt.Sprintf("array of %d elements", len(value))
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
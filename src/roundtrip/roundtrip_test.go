@@ -0,0 +1,66 @@
+package roundtrip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	original, err := os.ReadFile(filepath.Join("..", "..", "contracts", "v0.8", "1.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := Compare(original, original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != nil {
+		t.Fatalf("expected no divergence, got: %s", d.String())
+	}
+
+	expected := []byte(`{"nodeType":"SourceUnit","src":"0:10:0","id":1,"nodes":[{"nodeType":"ContractDefinition","src":"0:9:0","id":2,"name":"A","nodes":[{"nodeType":"Block","src":"5:3:0","statements":[]}]}]}`)
+	actual := []byte(`{"nodeType":"SourceUnit","src":"0:12:0","id":7,"nodes":[{"nodeType":"ContractDefinition","src":"0:11:0","id":8,"name":"A","nodes":[{"nodeType":"Block","src":"5:5:0","statements":[{"nodeType":"Break"}]}]}]}`)
+
+	d, err = Compare(expected, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d == nil {
+		t.Fatal("expected a divergence")
+	}
+	if d.Path != "nodes[0].nodes[0].statements" || d.NodeType != "Block" || d.Src != "5:3:0" {
+		t.Fatalf("unexpected divergence: %s", d.String())
+	}
+}
+
+func TestCompareTypeIdentifier(t *testing.T) {
+	tests := []struct {
+		expected string
+		actual   string
+		diverges bool
+	}{
+		{"t_struct$_S_$45_storage_ptr", "t_struct$_S_$52_storage_ptr", false},
+		{"t_contract$_Foo_$123", "t_contract$_Foo_$7", false},
+		{"t_array$_t_enum$_E_$12_$dyn_memory_ptr", "t_array$_t_enum$_E_$30_$dyn_memory_ptr", false},
+		{"t_type$_t_super$_A_$8_$", "t_type$_t_super$_A_$9_$", false},
+		{"t_module_3", "t_module_4", false},
+		{"t_struct$_S_$45_storage_ptr", "t_struct$_T_$45_storage_ptr", true},
+		{"t_struct$_S_$45_storage_ptr", "t_struct$_S_$45_memory_ptr", true},
+		{"t_array$_t_uint256_$2_storage", "t_array$_t_uint256_$3_storage", true},
+		{"t_rational_1_by_1", "t_rational_2_by_1", true},
+	}
+
+	for _, test := range tests {
+		expected := []byte(`{"nodeType":"Identifier","src":"0:1:0","typeDescriptions":{"typeIdentifier":"` + test.expected + `"}}`)
+		actual := []byte(`{"nodeType":"Identifier","src":"0:1:0","typeDescriptions":{"typeIdentifier":"` + test.actual + `"}}`)
+		d, err := Compare(expected, actual)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (d != nil) != test.diverges {
+			t.Fatalf("[%s] vs [%s]: expected divergence [%v], got [%v]", test.expected, test.actual, test.diverges, d)
+		}
+	}
+}
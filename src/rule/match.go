@@ -0,0 +1,351 @@
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// pattern 是编译后的匹配模式，同一时刻只有一种形式生效：
+//   - literal：与字段的字符串值相等；
+//   - metavar：形如 $X 的元变量，第一次出现时绑定字段的值，之后出现时要求值相等；
+//   - regex：字段的字符串值与正则表达式匹配；
+//   - any：列表中任意一个模式匹配即可；
+//   - fields：节点中对应字段都匹配，并且 not 不匹配、has 在某个后代节点上匹配。
+type pattern struct {
+	literal *string
+	metavar string
+	regex   *regexp.Regexp
+	any     []*pattern
+
+	fields map[string]*pattern
+	not    *pattern
+	has    *pattern
+}
+
+var metavarPattern = regexp.MustCompile(`^\$[A-Z_][A-Z0-9_]*$`)
+
+// compile 将 yaml 解析得到的值编译为 pattern，map 中的 not、any、has、regex 为操作符，其余的键为字段名。
+func compile(value interface{}) (*pattern, error) {
+	switch v := value.(type) {
+	case string:
+		if metavarPattern.MatchString(v) {
+			return &pattern{metavar: v}, nil
+		}
+		return &pattern{literal: &v}, nil
+	case bool, int, float64:
+		literal := fmt.Sprint(v)
+		return &pattern{literal: &literal}, nil
+	case nil:
+		literal := ""
+		return &pattern{literal: &literal}, nil
+	case []interface{}:
+		p := &pattern{}
+		for i, item := range v {
+			alternative, err := compile(item)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %v", i, err)
+			}
+			p.any = append(p.any, alternative)
+		}
+		return p, nil
+	case map[string]interface{}:
+		p := &pattern{fields: make(map[string]*pattern)}
+		for key, item := range v {
+			var err error
+			switch key {
+			case "regex":
+				expr, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("regex: expected a string")
+				}
+				if p.regex, err = regexp.Compile(expr); err != nil {
+					return nil, fmt.Errorf("regex: [%v]", err)
+				}
+			case "any":
+				if _, ok := item.([]interface{}); !ok {
+					return nil, fmt.Errorf("any: expected a list")
+				}
+				alternatives, err := compile(item)
+				if err != nil {
+					return nil, fmt.Errorf("any%v", err)
+				}
+				p.any = alternatives.any
+			case "not":
+				if p.not, err = compile(item); err != nil {
+					return nil, fmt.Errorf("not: %v", err)
+				}
+			case "has":
+				if p.has, err = compile(item); err != nil {
+					return nil, fmt.Errorf("has: %v", err)
+				}
+			default:
+				if p.fields[key], err = compile(item); err != nil {
+					return nil, fmt.Errorf("%s: %v", key, err)
+				}
+			}
+		}
+		if p.regex != nil && (len(p.fields) > 0 || p.any != nil || p.not != nil || p.has != nil) {
+			return nil, fmt.Errorf("regex can not be used together with other keys")
+		}
+		if p.any != nil && len(p.fields) > 0 {
+			return nil, fmt.Errorf("any can not be used together with fields")
+		}
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unsupported value [%v]", value)
+	}
+}
+
+// field 返回节点中名为 name 的字段，name 可以是 memberName 或 MemberName，也可以用点号访问嵌套字段，如 typeDescriptions.typeString。
+func field(raw jsoniter.Any, name string) jsoniter.Any {
+	for _, part := range strings.Split(name, ".") {
+		if raw.ValueType() != jsoniter.ObjectValue {
+			return jsoniter.Wrap(nil).Get(part)
+		}
+		next := raw.Get(part)
+		if next.ValueType() == jsoniter.InvalidValue && part != "" {
+			runes := []rune(part)
+			runes[0] = unicode.ToLower(runes[0])
+			next = raw.Get(string(runes))
+		}
+		raw = next
+	}
+	return raw
+}
+
+// match 判断 raw 是否与 p 匹配，匹配成功时返回新的元变量绑定，bindings 本身不会被修改。
+// raw 为数组时，只要有一个元素匹配即可。
+func (p *pattern) match(raw jsoniter.Any, bindings map[string]string) (map[string]string, bool) {
+	if p.any != nil {
+		for _, alternative := range p.any {
+			if b, ok := alternative.match(raw, bindings); ok {
+				return b, true
+			}
+		}
+		return nil, false
+	}
+
+	if raw.ValueType() == jsoniter.ArrayValue {
+		if p.not != nil && len(p.fields) == 0 && p.has == nil {
+			// 单独的 not 作用于整个数组：数组中没有元素与之匹配。
+			if _, ok := p.not.match(raw, bindings); ok {
+				return nil, false
+			}
+			return bindings, true
+		}
+		for i := 0; i < raw.Size(); i++ {
+			if b, ok := p.match(raw.Get(i), bindings); ok {
+				return b, true
+			}
+		}
+		return nil, false
+	}
+
+	switch {
+	case p.literal != nil:
+		return bindings, isScalar(raw) && raw.ToString() == *p.literal
+	case p.regex != nil:
+		return bindings, isScalar(raw) && p.regex.MatchString(raw.ToString())
+	case p.metavar != "":
+		if !isScalar(raw) {
+			return nil, false
+		}
+		if value, ok := bindings[p.metavar]; ok {
+			return bindings, value == raw.ToString()
+		}
+		b := make(map[string]string, len(bindings)+1)
+		for k, v := range bindings {
+			b[k] = v
+		}
+		b[p.metavar] = raw.ToString()
+		return b, true
+	}
+
+	if len(p.fields) > 0 && raw.ValueType() != jsoniter.ObjectValue {
+		return nil, false
+	}
+	names := make([]string, 0, len(p.fields))
+	for name := range p.fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		var ok bool
+		if bindings, ok = p.fields[name].match(field(raw, name), bindings); !ok {
+			return nil, false
+		}
+	}
+	if p.not != nil {
+		if _, ok := p.not.match(raw, bindings); ok {
+			return nil, false
+		}
+	}
+	if p.has != nil {
+		var found map[string]string
+		keys := raw.Keys()
+		sort.Strings(keys)
+		for _, key := range keys {
+			walk(raw.Get(key), nil, func(node jsoniter.Any, ancestors []jsoniter.Any) bool {
+				if found != nil {
+					return false
+				}
+				if b, ok := p.has.match(node, bindings); ok {
+					found = b
+					return false
+				}
+				return true
+			})
+		}
+		if found == nil {
+			return nil, false
+		}
+		bindings = found
+	}
+	return bindings, true
+}
+
+func isScalar(raw jsoniter.Any) bool {
+	switch raw.ValueType() {
+	case jsoniter.StringValue, jsoniter.NumberValue, jsoniter.BoolValue:
+		return true
+	}
+	return false
+}
+
+// walk 先序遍历 raw 中所有带 nodeType 的节点，ancestors 由外到内排列，fn 返回 false 时不再进入该节点的子节点。
+func walk(raw jsoniter.Any, ancestors []jsoniter.Any, fn func(node jsoniter.Any, ancestors []jsoniter.Any) bool) {
+	switch raw.ValueType() {
+	case jsoniter.ObjectValue:
+		if raw.Get("nodeType").ValueType() == jsoniter.StringValue {
+			if !fn(raw, ancestors) {
+				return
+			}
+			ancestors = append(ancestors[:len(ancestors):len(ancestors)], raw)
+		}
+		keys := raw.Keys()
+		sort.Strings(keys)
+		for _, key := range keys {
+			walk(raw.Get(key), ancestors, fn)
+		}
+	case jsoniter.ArrayValue:
+		for i := 0; i < raw.Size(); i++ {
+			walk(raw.Get(i), ancestors, fn)
+		}
+	}
+}
+
+// Run 在 sourceUnit 上执行所有规则，按照 conf 过滤合约与函数，并且只执行对应合约中启用了的规则。
+func Run(rules []*Rule, sourceUnit jsoniter.Any, conf *config.Config) []*src.Finding {
+	var findings []*src.Finding
+	walk(sourceUnit, nil, func(node jsoniter.Any, ancestors []jsoniter.Any) bool {
+		contract, function := enclosing(append(ancestors[:len(ancestors):len(ancestors)], node))
+		contractName := contract.Get("name").ToString()
+		if function != nil && !conf.Included(contractName, function.Get("name").ToString()) {
+			return false
+		}
+		settings := conf.For(contractName)
+		for _, r := range rules {
+			if !settings.DetectorEnabled(r.ID) {
+				continue
+			}
+			bindings, ok := r.match.match(node, map[string]string{})
+			if !ok {
+				continue
+			}
+			if r.inside != nil {
+				if bindings, ok = matchAncestor(r.inside, ancestors, bindings); !ok {
+					continue
+				}
+			}
+			if r.notInside != nil {
+				if _, ok := matchAncestor(r.notInside, ancestors, bindings); ok {
+					continue
+				}
+			}
+			finding := &src.Finding{Detector: r.ID, Severity: r.Severity, Confidence: r.Confidence, Contract: contractName, Message: r.message(bindings)}
+			if function != nil {
+				finding.Function = signature(contractName, function)
+			}
+			findings = append(findings, finding)
+		}
+		return true
+	})
+	return findings
+}
+
+// matchAncestor 由内到外查找第一个与 p 匹配的祖先节点。
+func matchAncestor(p *pattern, ancestors []jsoniter.Any, bindings map[string]string) (map[string]string, bool) {
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if b, ok := p.match(ancestors[i], bindings); ok {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// enclosing 返回包含节点（nodes 的最后一项）的最内层合约以及函数或修饰器，不存在时分别返回空对象和 nil。
+func enclosing(nodes []jsoniter.Any) (contract jsoniter.Any, function jsoniter.Any) {
+	contract = jsoniter.Wrap(map[string]interface{}{})
+	for i := len(nodes) - 1; i >= 0; i-- {
+		switch nodes[i].Get("nodeType").ToString() {
+		case "FunctionDefinition", "ModifierDefinition":
+			if function == nil {
+				function = nodes[i]
+			}
+		case "ContractDefinition":
+			return nodes[i], function
+		}
+	}
+	return contract, function
+}
+
+// signature 与各个版本中 FunctionDefinition.Signature 的格式保持一致，如 Token.transfer(address to, uint256 amount)。
+func signature(contractName string, function jsoniter.Any) string {
+	name := function.Get("name").ToString()
+	switch kind := function.Get("kind").ToString(); {
+	case kind == "freeFunction":
+	case name == "" && kind != "" && kind != "function":
+		name = "." + kind
+	case name == "" && function.Get("isConstructor").ToBool():
+		name = ".constructor"
+	default:
+		name = "." + name
+	}
+
+	var parameters []string
+	list := function.Get("parameters").Get("parameters")
+	for i := 0; i < list.Size(); i++ {
+		parameter := list.Get(i)
+		typeName := parameter.Get("typeName")
+		code := typeName.Get("typeDescriptions").Get("typeString").ToString()
+		switch typeName.Get("nodeType").ToString() {
+		case "ElementaryTypeName":
+			code = typeName.Get("name").ToString()
+			if typeName.Get("stateMutability").ToString() == "payable" && code == "address" {
+				code += " payable"
+			}
+		case "UserDefinedTypeName":
+			if n := typeName.Get("name").ToString(); n != "" {
+				code = n
+			} else if n := typeName.Get("pathNode").Get("name").ToString(); n != "" {
+				code = n
+			}
+		}
+		if location := parameter.Get("storageLocation").ToString(); location != "" && location != "default" {
+			code += " " + location
+		}
+		if n := parameter.Get("name").ToString(); n != "" {
+			code += " " + n
+		}
+		parameters = append(parameters, code)
+	}
+
+	return fmt.Sprintf("%s%s(%s)", contractName, name, strings.Join(parameters, ", "))
+}
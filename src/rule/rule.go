@@ -0,0 +1,149 @@
+package rule
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/geistwelt/taintguard/src"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule 是一条声明式的检查规则：语法树中与 Match 匹配的节点会被报告为一条检测结果，
+// Inside 要求某个祖先节点与之匹配，NotInside 要求所有祖先节点都不与之匹配。
+type Rule struct {
+	ID          string
+	Description string
+	Message     string
+	Severity    src.Severity
+	Confidence  src.Confidence
+
+	match     *pattern
+	inside    *pattern
+	notInside *pattern
+}
+
+// spec 是规则在 yaml 中的写法。
+type spec struct {
+	ID          string      `yaml:"id"`
+	Description string      `yaml:"description"`
+	Message     string      `yaml:"message"`
+	Severity    string      `yaml:"severity"`
+	Confidence  string      `yaml:"confidence"`
+	Match       interface{} `yaml:"match"`
+	Inside      interface{} `yaml:"inside"`
+	NotInside   interface{} `yaml:"not-inside"`
+}
+
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Load 读取规则文件。
+func Load(file string) ([]*Rule, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rule file [%s]: [%v]", file, err)
+	}
+	rules, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule file [%s]: [%v]", file, err)
+	}
+	return rules, nil
+}
+
+// Parse 解析 yaml 格式的规则，文件的顶层为 rules 列表。
+func Parse(content []byte) ([]*Rule, error) {
+	var file struct {
+		Rules []spec `yaml:"rules"`
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse rules: [%v]", err)
+	}
+
+	var rules []*Rule
+	ids := make(map[string]bool)
+	for i, s := range file.Rules {
+		r, err := s.compile()
+		if err != nil {
+			return nil, fmt.Errorf("rules[%d]: %v", i, err)
+		}
+		if ids[r.ID] {
+			return nil, fmt.Errorf("rules[%d]: duplicate rule id [%s]", i, r.ID)
+		}
+		ids[r.ID] = true
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func (s spec) compile() (*Rule, error) {
+	if !idPattern.MatchString(s.ID) {
+		return nil, fmt.Errorf("invalid rule id [%s], expected lower case letters, digits and dashes", s.ID)
+	}
+	if s.Match == nil {
+		return nil, fmt.Errorf("rule [%s]: match is required", s.ID)
+	}
+
+	r := &Rule{ID: s.ID, Description: s.Description, Message: s.Message, Severity: src.SeverityMedium, Confidence: src.ConfidenceMedium}
+	if r.Message == "" {
+		r.Message = s.Description
+	}
+	if r.Message == "" {
+		r.Message = fmt.Sprintf("matches rule [%s]", s.ID)
+	}
+	if s.Severity != "" {
+		severity, err := src.ParseSeverity(s.Severity)
+		if err != nil || severity == 0 {
+			return nil, fmt.Errorf("rule [%s]: unknown severity [%s], expected one of info, low, medium, high", s.ID, s.Severity)
+		}
+		r.Severity = severity
+	}
+	if s.Confidence != "" {
+		confidence, err := src.ParseConfidence(s.Confidence)
+		if err != nil {
+			return nil, fmt.Errorf("rule [%s]: %v", s.ID, err)
+		}
+		r.Confidence = confidence
+	}
+
+	var err error
+	if r.match, err = compile(s.Match); err != nil {
+		return nil, fmt.Errorf("rule [%s]: match: %v", s.ID, err)
+	}
+	if s.Inside != nil {
+		if r.inside, err = compile(s.Inside); err != nil {
+			return nil, fmt.Errorf("rule [%s]: inside: %v", s.ID, err)
+		}
+	}
+	if s.NotInside != nil {
+		if r.notInside, err = compile(s.NotInside); err != nil {
+			return nil, fmt.Errorf("rule [%s]: not-inside: %v", s.ID, err)
+		}
+	}
+	return r, nil
+}
+
+// Info 返回规则对应的检测器描述，规则与语法树的版本无关。
+func (r *Rule) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{ID: r.ID, Description: r.Description, Severity: r.Severity, Confidence: r.Confidence, Versions: []string{"rule"}}
+}
+
+// message 将 Message 中的 $X 替换为元变量绑定的值，较长的元变量名优先替换。
+func (r *Rule) message(bindings map[string]string) string {
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	message := r.Message
+	for _, name := range names {
+		message = strings.ReplaceAll(message, name, bindings[name])
+	}
+	return message
+}
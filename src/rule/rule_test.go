@@ -0,0 +1,123 @@
+package rule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const sourceUnit = `{
+	"nodeType": "SourceUnit",
+	"nodes": [{
+		"nodeType": "ContractDefinition",
+		"name": "Proxy",
+		"nodes": [{
+			"nodeType": "FunctionDefinition",
+			"name": "forward",
+			"kind": "function",
+			"visibility": "external",
+			"modifiers": [],
+			"parameters": {"nodeType": "ParameterList", "parameters": [
+				{"nodeType": "VariableDeclaration", "name": "target", "storageLocation": "default", "typeName": {"nodeType": "ElementaryTypeName", "name": "address", "typeDescriptions": {"typeString": "address"}}},
+				{"nodeType": "VariableDeclaration", "name": "data", "storageLocation": "calldata", "typeName": {"nodeType": "ElementaryTypeName", "name": "bytes", "typeDescriptions": {"typeString": "bytes"}}}
+			]},
+			"body": {"nodeType": "Block", "statements": [{
+				"nodeType": "ExpressionStatement",
+				"expression": {
+					"nodeType": "FunctionCall",
+					"expression": {"nodeType": "MemberAccess", "memberName": "delegatecall", "expression": {"nodeType": "Identifier", "name": "target"}}
+				}
+			}]}
+		}, {
+			"nodeType": "FunctionDefinition",
+			"name": "upgrade",
+			"kind": "function",
+			"visibility": "public",
+			"modifiers": [{"nodeType": "ModifierInvocation", "modifierName": {"nodeType": "Identifier", "name": "onlyOwner"}}],
+			"parameters": {"nodeType": "ParameterList", "parameters": []},
+			"body": {"nodeType": "Block", "statements": [{
+				"nodeType": "ExpressionStatement",
+				"expression": {
+					"nodeType": "FunctionCall",
+					"expression": {"nodeType": "MemberAccess", "memberName": "delegatecall", "expression": {"nodeType": "Identifier", "name": "implementation"}}
+				}
+			}]}
+		}]
+	}]
+}`
+
+const rules = `
+rules:
+  - id: unprotected-delegatecall
+    description: Delegatecall in a function without onlyOwner.
+    message: "delegatecall to $TARGET"
+    severity: high
+    confidence: low
+    match:
+      nodeType: FunctionCall
+      expression:
+        nodeType: MemberAccess
+        MemberName: delegatecall
+        expression: {name: $TARGET}
+    inside:
+      nodeType: FunctionDefinition
+      visibility: [public, external]
+    not-inside:
+      nodeType: FunctionDefinition
+      modifiers:
+        modifierName: {name: onlyOwner}
+  - id: function-without-modifiers
+    match:
+      nodeType: FunctionDefinition
+      name: {regex: "^(forward|upgrade)$"}
+      modifiers: {not: {nodeType: ModifierInvocation}}
+      has: {memberName: delegatecall}
+`
+
+func TestRun(t *testing.T) {
+	rs, err := Parse([]byte(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := jsoniter.Get([]byte(sourceUnit))
+
+	findings := Run(rs, source, config.Default())
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, but got %d: %v", len(findings), findings)
+	}
+	expected := []src.Finding{
+		{Detector: "function-without-modifiers", Severity: src.SeverityMedium, Confidence: src.ConfidenceMedium, Contract: "Proxy", Function: "Proxy.forward(address target, bytes calldata data)", Message: "matches rule [function-without-modifiers]"},
+		{Detector: "unprotected-delegatecall", Severity: src.SeverityHigh, Confidence: src.ConfidenceLow, Contract: "Proxy", Function: "Proxy.forward(address target, bytes calldata data)", Message: "delegatecall to target"},
+	}
+	for i, finding := range findings {
+		if !reflect.DeepEqual(*finding, expected[i]) {
+			t.Errorf("findings[%d] = %+v, expected %+v", i, *finding, expected[i])
+		}
+	}
+
+	conf := config.Default()
+	conf.Detectors.Disable = []string{"unprotected-*"}
+	conf.Exclude.Functions = []string{"upgrade"}
+	if findings := Run(rs, source, conf); len(findings) != 1 || findings[0].Detector != "function-without-modifiers" {
+		t.Errorf("unexpected findings %v", findings)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []string{
+		"rules: [{id: Bad_ID, match: {nodeType: Block}}]",
+		"rules: [{id: no-match}]",
+		"rules: [{id: bad-regex, match: {name: {regex: \"(\"}}}]",
+		"rules: [{id: bad-severity, severity: critical, match: {nodeType: Block}}]",
+		"rules: [{id: dup, match: {nodeType: Block}}, {id: dup, match: {nodeType: Block}}]",
+		"rules: [{id: unknown-key, when: {}, match: {nodeType: Block}}]",
+	}
+	for _, c := range cases {
+		if _, err := Parse([]byte(c)); err == nil {
+			t.Errorf("expected an error for [%s]", c)
+		}
+	}
+}
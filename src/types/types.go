@@ -0,0 +1,633 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Category 是类型的种类。
+type Category int
+
+const (
+	Unknown Category = iota
+	Address
+	Bool
+	Integer
+	FixedPoint
+	FixedBytes
+	Bytes
+	String
+	Contract
+	Super
+	Struct
+	Enum
+	UserDefinedValueType
+	Array
+	Mapping
+	Function
+	Modifier
+	Tuple
+	TypeType
+	Magic
+	Rational
+	StringLiteral
+	Module
+)
+
+func (c Category) String() string {
+	switch c {
+	case Address:
+		return "address"
+	case Bool:
+		return "bool"
+	case Integer:
+		return "integer"
+	case FixedPoint:
+		return "fixed point"
+	case FixedBytes:
+		return "fixed bytes"
+	case Bytes:
+		return "bytes"
+	case String:
+		return "string"
+	case Contract:
+		return "contract"
+	case Super:
+		return "super"
+	case Struct:
+		return "struct"
+	case Enum:
+		return "enum"
+	case UserDefinedValueType:
+		return "user defined value type"
+	case Array:
+		return "array"
+	case Mapping:
+		return "mapping"
+	case Function:
+		return "function"
+	case Modifier:
+		return "modifier"
+	case Tuple:
+		return "tuple"
+	case TypeType:
+		return "type"
+	case Magic:
+		return "magic"
+	case Rational:
+		return "rational"
+	case StringLiteral:
+		return "string literal"
+	case Module:
+		return "module"
+	default:
+		return "unknown"
+	}
+}
+
+// Location 是引用类型的数据位置，值类型的数据位置为空。
+type Location string
+
+const (
+	Storage  Location = "storage"
+	Memory   Location = "memory"
+	Calldata Location = "calldata"
+)
+
+// Type 是从 typeDescriptions.typeIdentifier 解析出的类型，只有与 Category 相关的字段才有意义。
+type Type struct {
+	Category Category
+
+	// Address
+	Payable bool
+	// Integer、FixedPoint：Bits 为位数，Decimals 为小数位数
+	Signed   bool
+	Bits     int
+	Decimals int
+	// FixedBytes：字节数
+	Size int
+	// Contract、Super、Struct、Enum、UserDefinedValueType 为声明的名字与 id；Magic、Rational、StringLiteral、Module 为去掉前缀后的名字
+	Name string
+	ID   int
+	// Bytes、String、Struct、Array：Pointer 表示 storage 指针或者引用
+	Location Location
+	Pointer  bool
+	// Array：Length 为 -1 表示动态数组；TypeType 与元类型的 Base 为被描述的类型
+	Base   *Type
+	Length int
+	// Mapping
+	Key   *Type
+	Value *Type
+	// Function：Kind 如 internal、external、baredelegatecall，Mutability 如 pure、view、nonpayable、payable；
+	// Options 为已经通过 {gas: ..., value: ...} 设置的选项，AttachedTo 为 using for 绑定的类型
+	Kind       string
+	Mutability string
+	Parameters []*Type
+	Returns    []*Type
+	Options    []string
+	AttachedTo *Type
+	// 较早的编译器使用 bound_to 而不是 attached_to 编码 AttachedTo
+	boundTo bool
+	// Tuple 与 Modifier：元组中被省略的元素为 nil
+	Components []*Type
+}
+
+// Parse 解析 typeIdentifier，如 t_mapping$_t_address_$_t_uint256_$、t_contract$_Foo_$123、t_address_payable。
+func Parse(identifier string) (*Type, error) {
+	p := &parser{s: identifier}
+	t, err := p.parseType()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse type identifier [%s]: [%v]", identifier, err)
+	}
+	if p.pos != len(p.s) {
+		return nil, fmt.Errorf("failed to parse type identifier [%s]: [unexpected [%s] at %d]", identifier, p.s[p.pos:], p.pos)
+	}
+	return t, nil
+}
+
+// IsAddress 判断 typeIdentifier 是否为 address 或 address payable。
+func IsAddress(identifier string) bool {
+	t, err := Parse(identifier)
+	return err == nil && t.Category == Address
+}
+
+// ContractName 返回 typeIdentifier 所表示的合约的名字，不是合约类型时返回空字符串。
+func ContractName(identifier string) string {
+	t, err := Parse(identifier)
+	if err != nil || t.Category != Contract {
+		return ""
+	}
+	return t.Name
+}
+
+// ContractID 返回 typeIdentifier 所表示的合约的声明 id，不是合约类型时返回 0。
+func ContractID(identifier string) int {
+	t, err := Parse(identifier)
+	if err != nil || t.Category != Contract {
+		return 0
+	}
+	return t.ID
+}
+
+// IsValueType 判断类型是否为值类型，值类型在赋值时被复制，没有数据位置。
+func (t *Type) IsValueType() bool {
+	switch t.Category {
+	case Address, Bool, Integer, FixedPoint, FixedBytes, Contract, Enum, UserDefinedValueType, Rational:
+		return true
+	case Function:
+		return t.Kind == "internal" || t.Kind == "external"
+	}
+	return false
+}
+
+// String 返回与 typeString 相近的可读形式。
+func (t *Type) String() string {
+	if t == nil {
+		return ""
+	}
+	switch t.Category {
+	case Address:
+		if t.Payable {
+			return "address payable"
+		}
+		return "address"
+	case Bool:
+		return "bool"
+	case Integer:
+		if t.Signed {
+			return fmt.Sprintf("int%d", t.Bits)
+		}
+		return fmt.Sprintf("uint%d", t.Bits)
+	case FixedPoint:
+		if t.Signed {
+			return fmt.Sprintf("fixed%dx%d", t.Bits, t.Decimals)
+		}
+		return fmt.Sprintf("ufixed%dx%d", t.Bits, t.Decimals)
+	case FixedBytes:
+		return fmt.Sprintf("bytes%d", t.Size)
+	case Bytes, String:
+		return withLocation(t.Category.String(), t)
+	case Contract:
+		return "contract " + t.Name
+	case Super:
+		return "contract super " + t.Name
+	case Struct:
+		return withLocation("struct "+t.Name, t)
+	case Enum:
+		return "enum " + t.Name
+	case UserDefinedValueType:
+		return t.Name
+	case Array:
+		length := ""
+		if t.Length >= 0 {
+			length = strconv.Itoa(t.Length)
+		}
+		return withLocation(fmt.Sprintf("%s[%s]", strings.TrimSuffix(strings.TrimSuffix(t.Base.String(), " ref"), " pointer"), length), t)
+	case Mapping:
+		return fmt.Sprintf("mapping(%s => %s)", t.Key, t.Value)
+	case Function:
+		s := fmt.Sprintf("function (%s) %s %s", join(t.Parameters), t.Kind, t.Mutability)
+		if len(t.Returns) > 0 {
+			s += fmt.Sprintf(" returns (%s)", join(t.Returns))
+		}
+		return s
+	case Modifier:
+		return fmt.Sprintf("modifier (%s)", join(t.Components))
+	case Tuple:
+		return fmt.Sprintf("tuple(%s)", join(t.Components))
+	case TypeType:
+		return fmt.Sprintf("type(%s)", t.Base)
+	case Magic:
+		if t.Base != nil {
+			return fmt.Sprintf("type(%s)", t.Base)
+		}
+		return t.Name
+	case Rational:
+		return "int_const " + t.Name
+	case StringLiteral:
+		return "literal_string " + t.Name
+	case Module:
+		return "module " + t.Name
+	}
+	return "unknown"
+}
+
+// Identifier 将类型重新编码为 typeIdentifier，Parse(t.Identifier()) 与 t 相同。
+func (t *Type) Identifier() string {
+	if t == nil {
+		return ""
+	}
+	switch t.Category {
+	case Address:
+		if t.Payable {
+			return "t_address_payable"
+		}
+		return "t_address"
+	case Bool:
+		return "t_bool"
+	case Integer, FixedPoint:
+		s := "t_"
+		if !t.Signed {
+			s += "u"
+		}
+		if t.Category == Integer {
+			return fmt.Sprintf("%sint%d", s, t.Bits)
+		}
+		return fmt.Sprintf("%sfixed%dx%d", s, t.Bits, t.Decimals)
+	case FixedBytes:
+		return fmt.Sprintf("t_bytes%d", t.Size)
+	case Bytes:
+		return "t_bytes" + locationIdentifier(t)
+	case String:
+		return "t_string" + locationIdentifier(t)
+	case Contract, Super, Struct, Enum, UserDefinedValueType:
+		prefix := map[Category]string{Contract: "t_contract", Super: "t_super", Struct: "t_struct", Enum: "t_enum", UserDefinedValueType: "t_userDefinedValueType"}[t.Category]
+		return fmt.Sprintf("%s$_%s_$%d", prefix, strings.ReplaceAll(t.Name, "$", "$$$"), t.ID) + locationIdentifier(t)
+	case Array:
+		length := "dyn"
+		if t.Length >= 0 {
+			length = strconv.Itoa(t.Length)
+		}
+		return "t_array" + identifierList(t.Base) + length + locationIdentifier(t)
+	case Mapping:
+		return "t_mapping" + identifierList(t.Key, t.Value)
+	case Function:
+		s := fmt.Sprintf("t_function_%s_%s%sreturns%s%s", t.Kind, t.Mutability, identifierList(t.Parameters...), identifierList(t.Returns...), strings.Join(t.Options, ""))
+		if t.AttachedTo != nil && t.boundTo {
+			s += "bound_to" + identifierList(t.AttachedTo)
+		} else if t.AttachedTo != nil {
+			s += "attached_to" + identifierList(t.AttachedTo)
+		}
+		return s
+	case Modifier:
+		return "t_modifier" + identifierList(t.Components...)
+	case Tuple:
+		return "t_tuple" + identifierList(t.Components...)
+	case TypeType:
+		return "t_type" + identifierList(t.Base)
+	case Magic:
+		if t.Base != nil {
+			return "t_magic_meta_type_" + t.Base.Identifier()
+		}
+		return "t_magic_" + t.Name
+	case Rational:
+		return "t_rational_" + t.Name
+	case StringLiteral:
+		return "t_stringliteral_" + t.Name
+	case Module:
+		return "t_module_" + t.Name
+	}
+	return ""
+}
+
+func locationIdentifier(t *Type) string {
+	if t.Location == "" {
+		return ""
+	}
+	if t.Pointer {
+		return "_" + string(t.Location) + "_ptr"
+	}
+	return "_" + string(t.Location)
+}
+
+func identifierList(list ...*Type) string {
+	s := make([]string, len(list))
+	for i, t := range list {
+		s[i] = t.Identifier()
+	}
+	return "$_" + strings.Join(s, "_$_") + "_$"
+}
+
+func withLocation(s string, t *Type) string {
+	switch {
+	case t.Location == "":
+		return s
+	case t.Location == Storage && t.Pointer:
+		return s + " storage pointer"
+	case t.Location == Storage:
+		return s + " storage ref"
+	default:
+		return s + " " + string(t.Location)
+	}
+}
+
+func join(list []*Type) string {
+	s := make([]string, len(list))
+	for i, t := range list {
+		s[i] = t.String()
+	}
+	return strings.Join(s, ",")
+}
+
+var (
+	integerPattern    = regexp.MustCompile(`^t_(u?)int(\d+)`)
+	fixedPattern      = regexp.MustCompile(`^t_(u?)fixed(\d+)x(\d+)`)
+	fixedBytesPattern = regexp.MustCompile(`^t_bytes(\d+)`)
+	locationPattern   = regexp.MustCompile(`^_(storage|memory|calldata)(_ptr)?`)
+	declaredPattern   = regexp.MustCompile(`^\$_(.*?)_\$(\d+)`)
+	functionPattern   = regexp.MustCompile(`^t_function_([a-z0-9]+)_(pure|view|nonpayable|payable)`)
+)
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) rest() string {
+	return p.s[p.pos:]
+}
+
+func (p *parser) consume(prefix string) bool {
+	if strings.HasPrefix(p.rest(), prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(prefix string) error {
+	if !p.consume(prefix) {
+		return fmt.Errorf("expected [%s] at %d", prefix, p.pos)
+	}
+	return nil
+}
+
+// token 读取到下一个参数的边界（_$ 或者结尾）为止的简单标识符。
+func (p *parser) token() string {
+	rest := p.rest()
+	end := len(rest)
+	if i := strings.Index(rest, "_$"); i >= 0 {
+		end = i
+	}
+	p.pos += end
+	return rest[:end]
+}
+
+func (p *parser) location(t *Type) {
+	if m := locationPattern.FindStringSubmatch(p.rest()); m != nil {
+		t.Location = Location(m[1])
+		t.Pointer = m[2] != ""
+		p.pos += len(m[0])
+	}
+}
+
+// declared 解析 $_Name_$ID 形式的用户定义的名字与声明 id，名字中的 $ 被转义为 $$$。
+func (p *parser) declared(t *Type) error {
+	m := declaredPattern.FindStringSubmatch(p.rest())
+	if m == nil {
+		return fmt.Errorf("expected a declared name at %d", p.pos)
+	}
+	t.Name = strings.ReplaceAll(m[1], "$$$", "$")
+	t.ID, _ = strconv.Atoi(m[2])
+	p.pos += len(m[0])
+	return nil
+}
+
+// list 解析 $_T1_$_T2_$ 形式的类型列表，$__$ 为空列表。
+func (p *parser) list() ([]*Type, error) {
+	if err := p.expect("$_"); err != nil {
+		return nil, err
+	}
+	list := []*Type{}
+	if strings.HasPrefix(p.rest(), "_$") && !p.separator() {
+		p.pos += len("_$")
+		return list, nil
+	}
+	for {
+		if strings.HasPrefix(p.rest(), "_$") {
+			// 被省略的元组元素。
+			list = append(list, nil)
+		} else {
+			t, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, t)
+		}
+		if p.separator() {
+			p.pos += len("_$_")
+			continue
+		}
+		if err := p.expect("_$"); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+}
+
+// separator 判断当前位置是否为列表元素之间的 _$_，其后是下一个类型或者被省略的元素。
+func (p *parser) separator() bool {
+	return strings.HasPrefix(p.rest(), "_$_t_") || strings.HasPrefix(p.rest(), "_$__$")
+}
+
+func (p *parser) parseType() (*Type, error) {
+	rest := p.rest()
+	switch {
+	case strings.HasPrefix(rest, "t_address"):
+		p.pos += len("t_address")
+		return &Type{Category: Address, Payable: p.consume("_payable")}, nil
+	case strings.HasPrefix(rest, "t_bool"):
+		p.pos += len("t_bool")
+		return &Type{Category: Bool}, nil
+	case strings.HasPrefix(rest, "t_string_") && !strings.HasPrefix(rest, "t_stringliteral"):
+		p.pos += len("t_string")
+		t := &Type{Category: String}
+		p.location(t)
+		return t, nil
+	case strings.HasPrefix(rest, "t_bytes_"), rest == "t_bytes":
+		p.pos += len("t_bytes")
+		t := &Type{Category: Bytes}
+		p.location(t)
+		return t, nil
+	case fixedBytesPattern.MatchString(rest):
+		m := fixedBytesPattern.FindStringSubmatch(rest)
+		p.pos += len(m[0])
+		size, _ := strconv.Atoi(m[1])
+		return &Type{Category: FixedBytes, Size: size}, nil
+	case integerPattern.MatchString(rest):
+		m := integerPattern.FindStringSubmatch(rest)
+		p.pos += len(m[0])
+		bits, _ := strconv.Atoi(m[2])
+		return &Type{Category: Integer, Signed: m[1] == "", Bits: bits}, nil
+	case fixedPattern.MatchString(rest):
+		m := fixedPattern.FindStringSubmatch(rest)
+		p.pos += len(m[0])
+		bits, _ := strconv.Atoi(m[2])
+		decimals, _ := strconv.Atoi(m[3])
+		return &Type{Category: FixedPoint, Signed: m[1] == "", Bits: bits, Decimals: decimals}, nil
+	case strings.HasPrefix(rest, "t_contract$"):
+		p.pos += len("t_contract")
+		t := &Type{Category: Contract}
+		return t, p.declared(t)
+	case strings.HasPrefix(rest, "t_super$"):
+		p.pos += len("t_super")
+		t := &Type{Category: Super}
+		return t, p.declared(t)
+	case strings.HasPrefix(rest, "t_struct$"):
+		p.pos += len("t_struct")
+		t := &Type{Category: Struct}
+		if err := p.declared(t); err != nil {
+			return nil, err
+		}
+		p.location(t)
+		return t, nil
+	case strings.HasPrefix(rest, "t_enum$"):
+		p.pos += len("t_enum")
+		t := &Type{Category: Enum}
+		return t, p.declared(t)
+	case strings.HasPrefix(rest, "t_userDefinedValueType$"):
+		p.pos += len("t_userDefinedValueType")
+		t := &Type{Category: UserDefinedValueType}
+		return t, p.declared(t)
+	case strings.HasPrefix(rest, "t_array$"):
+		p.pos += len("t_array")
+		list, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		if len(list) != 1 || list[0] == nil {
+			return nil, fmt.Errorf("array should have exactly one base type")
+		}
+		t := &Type{Category: Array, Base: list[0], Length: -1}
+		if !p.consume("dyn") {
+			digits := p.rest()
+			n := 0
+			for n < len(digits) && digits[n] >= '0' && digits[n] <= '9' {
+				n++
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("expected array length at %d", p.pos)
+			}
+			t.Length, _ = strconv.Atoi(digits[:n])
+			p.pos += n
+		}
+		p.location(t)
+		return t, nil
+	case strings.HasPrefix(rest, "t_mapping$"):
+		p.pos += len("t_mapping")
+		list, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		if len(list) != 2 || list[0] == nil || list[1] == nil {
+			return nil, fmt.Errorf("mapping should have a key type and a value type")
+		}
+		return &Type{Category: Mapping, Key: list[0], Value: list[1]}, nil
+	case functionPattern.MatchString(rest):
+		m := functionPattern.FindStringSubmatch(rest)
+		p.pos += len(m[0])
+		t := &Type{Category: Function, Kind: m[1], Mutability: m[2]}
+		var err error
+		if t.Parameters, err = p.list(); err != nil {
+			return nil, err
+		}
+		if err = p.expect("returns"); err != nil {
+			return nil, err
+		}
+		if t.Returns, err = p.list(); err != nil {
+			return nil, err
+		}
+		for _, option := range []string{"gas", "value", "salt"} {
+			if p.consume(option) {
+				t.Options = append(t.Options, option)
+			}
+		}
+		if t.boundTo = p.consume("bound_to"); t.boundTo || p.consume("attached_to") {
+			list, err := p.list()
+			if err != nil {
+				return nil, err
+			}
+			if len(list) != 1 || list[0] == nil {
+				return nil, fmt.Errorf("function should be attached to exactly one type")
+			}
+			t.AttachedTo = list[0]
+		}
+		return t, nil
+	case strings.HasPrefix(rest, "t_modifier$"):
+		p.pos += len("t_modifier")
+		list, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Category: Modifier, Components: list}, nil
+	case strings.HasPrefix(rest, "t_tuple$"):
+		p.pos += len("t_tuple")
+		list, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Category: Tuple, Components: list}, nil
+	case strings.HasPrefix(rest, "t_type$"):
+		p.pos += len("t_type")
+		list, err := p.list()
+		if err != nil {
+			return nil, err
+		}
+		if len(list) != 1 || list[0] == nil {
+			return nil, fmt.Errorf("type should describe exactly one type")
+		}
+		return &Type{Category: TypeType, Base: list[0]}, nil
+	case strings.HasPrefix(rest, "t_magic_meta_type_"):
+		p.pos += len("t_magic_meta_type_")
+		base, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		return &Type{Category: Magic, Name: "meta_type", Base: base}, nil
+	case strings.HasPrefix(rest, "t_magic_"):
+		p.pos += len("t_magic_")
+		return &Type{Category: Magic, Name: p.token()}, nil
+	case strings.HasPrefix(rest, "t_rational_"):
+		p.pos += len("t_rational_")
+		return &Type{Category: Rational, Name: p.token()}, nil
+	case strings.HasPrefix(rest, "t_stringliteral_"):
+		p.pos += len("t_stringliteral_")
+		return &Type{Category: StringLiteral, Name: p.token()}, nil
+	case strings.HasPrefix(rest, "t_module_"):
+		p.pos += len("t_module_")
+		return &Type{Category: Module, Name: p.token()}, nil
+	}
+	return nil, fmt.Errorf("unknown type at %d", p.pos)
+}
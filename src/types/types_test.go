@@ -0,0 +1,101 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		identifier string
+		expected   string
+	}{
+		{"t_address", "address"},
+		{"t_address_payable", "address payable"},
+		{"t_uint256", "uint256"},
+		{"t_int8", "int8"},
+		{"t_bytes32", "bytes32"},
+		{"t_bytes_memory_ptr", "bytes memory"},
+		{"t_string_storage", "string storage ref"},
+		{"t_contract$_Foo_$123", "contract Foo"},
+		{"t_struct$_Order_$42_storage_ptr", "struct Order storage pointer"},
+		{"t_enum$_State_$7", "enum State"},
+		{"t_array$_t_address_$dyn_storage", "address[] storage ref"},
+		{"t_array$_t_uint8_$40_memory_ptr", "uint8[40] memory"},
+		{"t_mapping$_t_address_$_t_uint256_$", "mapping(address => uint256)"},
+		{"t_mapping$_t_address_$_t_mapping$_t_address_$_t_bool_$_$", "mapping(address => mapping(address => bool))"},
+		{"t_function_baredelegatecall_nonpayable$_t_bytes_memory_ptr_$returns$_t_bool_$_t_bytes_memory_ptr_$", "function (bytes memory) baredelegatecall nonpayable returns (bool,bytes memory)"},
+		{"t_tuple$__$_t_bytes_memory_ptr_$", "tuple(,bytes memory)"},
+		{"t_tuple$__$", "tuple()"},
+		{"t_type$_t_contract$_Foo_$123_$", "type(contract Foo)"},
+		{"t_magic_message", "message"},
+		{"t_rational_1_by_1", "int_const 1_by_1"},
+	}
+	for _, c := range cases {
+		typ, err := Parse(c.identifier)
+		if err != nil {
+			t.Errorf("failed to parse [%s]: %v", c.identifier, err)
+			continue
+		}
+		if typ.String() != c.expected {
+			t.Errorf("Parse(%s).String() = [%s], expected [%s]", c.identifier, typ.String(), c.expected)
+		}
+		if typ.Identifier() != c.identifier {
+			t.Errorf("Parse(%s).Identifier() = [%s]", c.identifier, typ.Identifier())
+		}
+	}
+
+	mapping, _ := Parse("t_mapping$_t_address_$_t_array$_t_struct$_Order_$42_storage_$dyn_storage_$")
+	if mapping.Category != Mapping || mapping.Key.Category != Address || mapping.Value.Category != Array || mapping.Value.Length != -1 ||
+		mapping.Value.Base.Category != Struct || mapping.Value.Base.Name != "Order" || mapping.Value.Base.ID != 42 || mapping.Value.Base.Location != Storage {
+		t.Errorf("unexpected mapping type %+v", mapping)
+	}
+
+	if !IsAddress("t_address_payable") || IsAddress("t_bytes32") {
+		t.Error("unexpected result of IsAddress")
+	}
+	if name := ContractName("t_contract$_Foo$$$Bar_$9"); name != "Foo$Bar" {
+		t.Errorf("ContractName = [%s], expected [Foo$Bar]", name)
+	}
+	if id := ContractID("t_contract$_Foo$$$Bar_$9"); id != 9 || ContractID("t_struct$_Foo_$9_storage") != 0 {
+		t.Errorf("ContractID = [%d], expected [9]", id)
+	}
+
+	for _, identifier := range []string{"", "t_uint", "t_address_foo", "t_mapping$_t_address_$", "t_array$_t_bool_$"} {
+		if _, err := Parse(identifier); err == nil {
+			t.Errorf("expected an error for [%s]", identifier)
+		}
+	}
+}
+
+var identifierPattern = regexp.MustCompile(`"typeIdentifier"\s*:\s*"([^"]*)"`)
+
+// TestParseFixtures 解析所有测试合约语法树中出现的 typeIdentifier，并检查重新编码后与原来的字符串一致。
+func TestParseFixtures(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", "*", "*.sol_json.ast"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("failed to find fixtures: [%v]", err)
+	}
+	identifiers := make(map[string]bool)
+	for _, fixture := range fixtures {
+		bz, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range identifierPattern.FindAllSubmatch(bz, -1) {
+			identifiers[string(m[1])] = true
+		}
+	}
+	for identifier := range identifiers {
+		typ, err := Parse(identifier)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if typ.Identifier() != identifier {
+			t.Errorf("Parse(%s).Identifier() = [%s]", identifier, typ.Identifier())
+		}
+	}
+}
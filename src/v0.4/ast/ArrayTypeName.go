@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -34,9 +34,9 @@ func (atn *ArrayTypeName) SourceCode(isSc bool, isIndent bool, indent string, lo
 			code = code + baseType.SourceCode(false, false, indent, logger)
 		default:
 			if baseType != nil {
-				logger.Warnf("Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseType.Type(), atn.Src)
+				src.Dropf(logger, "Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseType.Type(), atn.Src)
 			} else {
-				logger.Warnf("Unknown baseType nodeType for ArrayTypeName [src:%s].", atn.Src)
+				src.Dropf(logger, "Unknown baseType nodeType for ArrayTypeName [src:%s].", atn.Src)
 			}
 		}
 	}
@@ -49,9 +49,9 @@ func (atn *ArrayTypeName) SourceCode(isSc bool, isIndent bool, indent string, lo
 			code = code + length.SourceCode(false, false, indent, logger)
 		default:
 			if length != nil {
-				logger.Warnf("Unknown length nodeType [%s] for ArrayTypeName [src:%s].", length.Type(), atn.Src)
+				src.Dropf(logger, "Unknown length nodeType [%s] for ArrayTypeName [src:%s].", length.Type(), atn.Src)
 			} else {
-				logger.Warnf("Unknown length nodeType for ArrayTypeName [src:%s].", atn.Src)
+				src.Dropf(logger, "Unknown length nodeType for ArrayTypeName [src:%s].", atn.Src)
 			}
 		}
 	}
@@ -75,7 +75,7 @@ func (atn *ArrayTypeName) NodeID() int {
 
 func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ArrayTypeName, error) {
 	atn := new(ArrayTypeName)
-	if err := json.Unmarshal([]byte(raw.ToString()), atn); err != nil {
+	if err := src.UnmarshalAny(raw, atn); err != nil {
 		logger.Errorf("Failed to unmarshal ArrayTypeName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ArrayTypeName: [%v]", err)
 	}
@@ -95,6 +95,7 @@ func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				atnBaseType, err = GetUserDefinedTypeName(gn, baseType, logger)
 			default:
 				logger.Warnf("Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseTypeNodeType, atn.Src)
+				gn.AddUnknownNode(baseTypeNodeType, baseType.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -120,6 +121,7 @@ func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				atnLength, err = GetLiteral(gn, length, logger)
 			default:
 				logger.Warnf("Unknown length nodeType [%s] for ArrayTypeName [src:%s].", lengthTypeNodeType, atn.Src)
+				gn.AddUnknownNode(lengthTypeNodeType, length.Get("src").ToString())
 			}
 
 			if err != nil {
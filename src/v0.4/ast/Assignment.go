@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -45,9 +45,9 @@ func (a *Assignment) SourceCode(isSc bool, isIndent bool, indent string, logger
 				code = code + leftHandSide.SourceCode(false, false, indent, logger)
 			default:
 				if leftHandSide != nil {
-					logger.Warnf("Unknown leftHandSide nodeType [%s] for Assignment [src:%s].", leftHandSide.Type(), a.Src)
+					src.Dropf(logger, "Unknown leftHandSide nodeType [%s] for Assignment [src:%s].", leftHandSide.Type(), a.Src)
 				} else {
-					logger.Warnf("Unknown leftHandSide nodeType for Assignment [src:%s].", a.Src)
+					src.Dropf(logger, "Unknown leftHandSide nodeType for Assignment [src:%s].", a.Src)
 				}
 			}
 		}
@@ -77,9 +77,9 @@ func (a *Assignment) SourceCode(isSc bool, isIndent bool, indent string, logger
 				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
 			default:
 				if rightHandSide != nil {
-					logger.Warnf("Unknown rightHandSide nodeType [%s] for Assignment [src:%s].", rightHandSide.Type(), a.Src)
+					src.Dropf(logger, "Unknown rightHandSide nodeType [%s] for Assignment [src:%s].", rightHandSide.Type(), a.Src)
 				} else {
-					logger.Warnf("Unknown rightHandSide nodeType for Assignment [src:%s].", a.Src)
+					src.Dropf(logger, "Unknown rightHandSide nodeType for Assignment [src:%s].", a.Src)
 				}
 			}
 		}
@@ -106,7 +106,7 @@ func (a *Assignment) NodeID() int {
 
 func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Assignment, error) {
 	a := new(Assignment)
-	if err := json.Unmarshal([]byte(raw.ToString()), a); err != nil {
+	if err := src.UnmarshalAny(raw, a); err != nil {
 		logger.Errorf("Failed to unmarshal Assignment: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Assignment: [%v]", err)
 	}
@@ -130,6 +130,7 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 				aLeftHandSide, err = GetMemberAccess(gn, leftHandSide, logger)
 			default:
 				logger.Warnf("Unknown leftHandSide nodeType [%s] for Assignment [src:%s].", leftHandSideNodeType, a.Src)
+				gn.AddUnknownNode(leftHandSideNodeType, leftHandSide.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -169,6 +170,7 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 				aRightHandSide, err = GetConditional(gn, rightHandSide, logger)
 			default:
 				logger.Warnf("Unknown rightHandSide nodeType [%s] for Assignment [src:%s].", rightHandSideNodeType, a.Src)
+				gn.AddUnknownNode(rightHandSideNodeType, rightHandSide.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -188,43 +190,13 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (a *Assignment) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// leftHandSide
-	{
-		if a.leftHandSide != nil {
-			switch leftHandSide := a.leftHandSide.(type) {
-			case *IndexAccess:
-				leftHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				leftHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	//rightHandSide
-	{
-		if a.rightHandSide != nil {
-			switch rightHandSide := a.rightHandSide.(type) {
-			case *FunctionCall:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *BinaryOperation:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (a *Assignment) TraverseTaintOwner(opt *Option, logger logging.Logger) {
 	// leftHandSide
 	{
 		if a.leftHandSide != nil {
 			switch leftHandSide := a.leftHandSide.(type) {
 			case *Identifier:
-				if leftHandSide.Name == opt.SimilarOwnerVariableName {
+				if opt.OwnerVariable != nil && opt.Symbols.Resolve(leftHandSide) == opt.OwnerVariable {
 					opt.IsTainted = true
 					trackAssignment := &Assignment{
 						leftHandSide: &IndexAccess{
@@ -253,46 +225,12 @@ func (a *Assignment) TraverseTaintOwner(opt *Option, logger logging.Logger) {
 			}
 		}
 	}
-
-	// //rightHandSide
-	// {
-	// 	if a.rightHandSide != nil {
-	// 		switch rightHandSide := a.rightHandSide.(type) {
-	// 		case *FunctionCall:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *MemberAccess:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *BinaryOperation:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *IndexAccess:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		}
-	// 	}
-	// }
 }
 
-func (a *Assignment) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	// leftHandSide
-	{
-		if a.leftHandSide != nil {
-			switch leftHandSide := a.leftHandSide.(type) {
-			case *MemberAccess:
-				leftHandSide.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
+func (a *Assignment) SetLeft(left ASTNode) {
+	a.leftHandSide = left
+}
 
-	//rightHandSide
-	{
-		if a.rightHandSide != nil {
-			switch rightHandSide := a.rightHandSide.(type) {
-			case *FunctionCall:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			case *MemberAccess:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			case *BinaryOperation:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
+func (a *Assignment) SetRight(right ASTNode) {
+	a.rightHandSide = right
 }
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -56,7 +56,7 @@ func (etne *ElementaryTypeNameExpression) NodeID() int {
 
 func GetElementaryTypeNameExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ElementaryTypeNameExpression, error) {
 	etne := new(ElementaryTypeNameExpression)
-	if err := json.Unmarshal([]byte(raw.ToString()), etne); err != nil {
+	if err := src.UnmarshalAny(raw, etne); err != nil {
 		logger.Errorf("Failed to unmarshal ElementaryTypeNameExpression: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ElementaryTypeNameExpression: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -40,9 +40,9 @@ func (es *ExpressionStatement) SourceCode(isSc bool, isIndent bool, indent strin
 			code = code + expression.SourceCode(false, false, indent, logger)
 		default:
 			if expression != nil {
-				logger.Warnf("Unknown expression nodeType [%s] for ExpressionStatement [src:%s].", expression.Type(), es.Src)
+				src.Dropf(logger, "Unknown expression nodeType [%s] for ExpressionStatement [src:%s].", expression.Type(), es.Src)
 			} else {
-				logger.Warnf("Unknown expression nodeType for ExpressionStatement [src:%s].", es.Src)
+				src.Dropf(logger, "Unknown expression nodeType for ExpressionStatement [src:%s].", es.Src)
 			}
 		}
 
@@ -92,7 +92,7 @@ func (es *ExpressionStatement) NodeID() int {
 
 func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ExpressionStatement, error) {
 	es := new(ExpressionStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), es); err != nil {
+	if err := src.UnmarshalAny(raw, es); err != nil {
 		logger.Errorf("Failed to unmarshal ExpressionStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ExpressionStatement: [%v]", err)
 	}
@@ -116,6 +116,7 @@ func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 				esExpression, err = GetIdentifier(gn, expression, logger)
 			default:
 				logger.Warnf("Unknown expression nodeType [%s] for ExpressionStatement [src:%s].", expressionNodeType, es.Src)
+				gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -135,20 +136,9 @@ func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (es *ExpressionStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if es.expression != nil {
-		switch expression := es.expression.(type) {
-		case *Assignment:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
+// TraverseTaintOwner 只处理语句本身，语句块中的语句由 ContractDefinition.TraverseTaintOwner 遍历。
 func (es *ExpressionStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
+	opt.IsTainted = false
 	if es.expression != nil {
 		switch expression := es.expression.(type) {
 		case *Assignment:
@@ -190,15 +180,6 @@ func (es *ExpressionStatement) TraverseTaintOwner(opt *Option, logger logging.Lo
 	}
 }
 
-func (es *ExpressionStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if es.expression != nil {
-		switch expression := es.expression.(type) {
-		case *Assignment:
-			expression.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
 func (es *ExpressionStatement) SetExpression(expression ASTNode) {
 	es.expression = expression
 }
@@ -1,10 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/types"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -68,9 +69,9 @@ func (fc *FunctionCall) SourceCode(isSc bool, isIndent bool, indent string, logg
 			// 	code = code + expression.SourceCode(false, false, indent, logger)
 			default:
 				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for FunctionCall [src:%s].", expression.Type(), fc.Src)
+					src.Dropf(logger, "Unknown expression nodeType [%s] for FunctionCall [src:%s].", expression.Type(), fc.Src)
 				} else {
-					logger.Warnf("Unknown expression nodeType for FunctionCall [src:%s].", fc.Src)
+					src.Dropf(logger, "Unknown expression nodeType for FunctionCall [src:%s].", fc.Src)
 				}
 			}
 		}
@@ -105,9 +106,9 @@ func (fc *FunctionCall) SourceCode(isSc bool, isIndent bool, indent string, logg
 					code = code + arg.SourceCode(false, false, indent, logger)
 				default:
 					if arg != nil {
-						logger.Warnf("Unknown argument nodeType [%s] for FunctionCall [src:%s].", arg.Type(), fc.Src)
+						src.Dropf(logger, "Unknown argument nodeType [%s] for FunctionCall [src:%s].", arg.Type(), fc.Src)
 					} else {
-						logger.Warnf("Unknown argument nodeType for FunctionCall [src:%s].", fc.Src)
+						src.Dropf(logger, "Unknown argument nodeType for FunctionCall [src:%s].", fc.Src)
 					}
 				}
 				if index < len(fc.arguments)-1 {
@@ -145,7 +146,7 @@ func (fc *FunctionCall) NodeID() int {
 func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionCall, error) {
 	fc := new(FunctionCall)
 	fc.referencedFunctionDefinition = -1
-	if err := json.Unmarshal([]byte(raw.ToString()), fc); err != nil {
+	if err := src.UnmarshalAny(raw, fc); err != nil {
 		logger.Errorf("Failed to unmarshal FunctionCall: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal FunctionCall: [%v]", err)
 	}
@@ -161,12 +162,14 @@ func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 			switch expressionNodeType {
 			case "Identifier":
 				fcExpression, err = GetIdentifier(gn, expression, logger)
-				identifier, _ := fcExpression.(*Identifier)
-				fc.referencedFunctionDefinition = identifier.ReferencedDeclaration
+				if identifier, ok := fcExpression.(*Identifier); ok && err == nil {
+					fc.referencedFunctionDefinition = identifier.ReferencedDeclaration
+				}
 			case "MemberAccess":
 				fcExpression, err = GetMemberAccess(gn, expression, logger)
-				memberAccess, _ := fcExpression.(*MemberAccess)
-				fc.referencedFunctionDefinition = memberAccess.ReferencedDeclaration
+				if memberAccess, ok := fcExpression.(*MemberAccess); ok && err == nil {
+					fc.referencedFunctionDefinition = memberAccess.ReferencedDeclaration
+				}
 			case "ElementaryTypeNameExpression":
 				fcExpression, err = GetElementaryTypeNameExpression(gn, expression, logger)
 			// case "NewExpression":
@@ -177,6 +180,7 @@ func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fcExpression, err = GetFunctionCall(gn, expression, logger)
 			default:
 				logger.Warnf("Unknown expression nodeType [%s] for FunctionCall [src:%s].", expressionNodeType, fc.Src)
+				gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -224,6 +228,7 @@ func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 					fcArgument, err = GetIndexAccess(gn, argument, logger)
 				default:
 					logger.Warnf("Unknown argument nodeType [%s] for FunctionCall [src:%s].", argumentNodeType, fc.Src)
+					gn.AddUnknownNode(argumentNodeType, argument.Get("src").ToString())
 				}
 
 				if err != nil {
@@ -248,6 +253,7 @@ func (fc *FunctionCall) ReferencedFunctionDefinition() int {
 	return fc.referencedFunctionDefinition
 }
 
+// TraverseFunctionCall 只处理调用本身，参数等子节点中的调用由 FunctionDefinition.TraverseFunctionCall 遍历。
 func (fc *FunctionCall) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
 	if fc.ReferencedFunctionDefinition() != -1 {
 		fd := gn.Functions()[fc.ReferencedFunctionDefinition()]
@@ -257,56 +263,49 @@ func (fc *FunctionCall) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNode
 		}
 	}
 
-	if fc.expression != nil {
-		switch fcExpression := fc.expression.(type) {
-		case *MemberAccess:
-			if fcExpression.MemberName == "delegatecall" {
-				if fcExpression.expression != nil {
-					switch maExpression := fcExpression.expression.(type) {
-					case *FunctionCall:
-						if maExpression.Kind == "typeConversion" && maExpression.TypeDescriptions.TypeString == "address" {
-							if maExpression.expression != nil {
-								switch fcExpression2 := maExpression.expression.(type) {
-								case *ElementaryTypeNameExpression:
-									if fcExpression2.ArgumentTypes[0].TypeString[0:8] == "contract" {
-										logger.Debugf("An explicit contract [%s] is being called using delegatecall.", fcExpression2.ArgumentTypes[0].TypeString[9:])
-										if opt != nil {
-											select {
-											case opt.delegatecallKnownContractCh <- fcExpression2.ArgumentTypes[0].TypeString[9:]:
-											default:
-											}
+	if fcExpression := delegatecallMember(fc); fcExpression != nil {
+		if fcExpression.expression != nil {
+			switch maExpression := fcExpression.expression.(type) {
+			case *FunctionCall:
+				if maExpression.Kind == "typeConversion" && types.IsAddress(maExpression.TypeDescriptions.TypeIdentifier) {
+					if maExpression.expression != nil {
+						switch fcExpression2 := maExpression.expression.(type) {
+						case *ElementaryTypeNameExpression:
+							if len(fcExpression2.ArgumentTypes) > 0 {
+								if contractName := types.ContractName(fcExpression2.ArgumentTypes[0].TypeIdentifier); contractName != "" {
+									logger.Debugf("An explicit contract [%s] is being called using delegatecall.", contractName)
+									if opt != nil {
+										select {
+										case opt.delegatecallKnownContractCh <- types.ContractID(fcExpression2.ArgumentTypes[0].TypeIdentifier):
+										default:
 										}
 									}
 								}
 							}
-						} else {
-							// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
-							if opt != nil {
-								select {
-								case opt.delegatecallUnknownContractCh <- struct{}{}:
-								default:
-								}
-							}
 						}
-					default:
-						// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
-						if opt != nil {
-							select {
-							case opt.delegatecallUnknownContractCh <- struct{}{}:
-							default:
-							}
+					}
+				} else {
+					// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
+					if opt != nil {
+						select {
+						case opt.delegatecallUnknownContractCh <- struct{}{}:
+						default:
 						}
 					}
 				}
+			default:
+				// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
+				if opt != nil {
+					select {
+					case opt.delegatecallUnknownContractCh <- struct{}{}:
+					default:
+					}
+				}
 			}
 		}
 	}
 }
 
-func (fc *FunctionCall) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-
-}
-
 func (fc *FunctionCall) SetExpression(expression ASTNode) {
 	fc.expression = expression
 }
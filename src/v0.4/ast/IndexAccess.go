@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -44,9 +44,9 @@ func (ia *IndexAccess) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + baseExpression.SourceCode(false, false, indent, logger)
 			default:
 				if baseExpression != nil {
-					logger.Warnf("Unknown baseExpression nodeType [%s] for IndexAccess [src:%s].", baseExpression.Type(), ia.Src)
+					src.Dropf(logger, "Unknown baseExpression nodeType [%s] for IndexAccess [src:%s].", baseExpression.Type(), ia.Src)
 				} else {
-					logger.Warnf("Unknown baseExpression nodeType for IndexAccess [src:%s].", ia.Src)
+					src.Dropf(logger, "Unknown baseExpression nodeType for IndexAccess [src:%s].", ia.Src)
 				}
 			}
 		}
@@ -74,9 +74,9 @@ func (ia *IndexAccess) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + indexExpression.SourceCode(false, false, indent, logger)
 			default:
 				if indexExpression != nil {
-					logger.Warnf("Unknown indexExpression nodeType [%s] for IndexAccess [src:%s].", indexExpression.Type(), ia.Src)
+					src.Dropf(logger, "Unknown indexExpression nodeType [%s] for IndexAccess [src:%s].", indexExpression.Type(), ia.Src)
 				} else {
-					logger.Warnf("Unknown indexExpression nodeType for IndexAccess [src:%s].", ia.Src)
+					src.Dropf(logger, "Unknown indexExpression nodeType for IndexAccess [src:%s].", ia.Src)
 				}
 			}
 		}
@@ -105,7 +105,7 @@ func (ia *IndexAccess) NodeID() int {
 
 func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IndexAccess, error) {
 	ia := new(IndexAccess)
-	if err := json.Unmarshal([]byte(raw.ToString()), ia); err != nil {
+	if err := src.UnmarshalAny(raw, ia); err != nil {
 		logger.Errorf("Failed to unmarshal IndexAccess: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal IndexAccess: [%v]", err)
 	}
@@ -129,6 +129,7 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				iaBaseExpression, err = GetElementaryTypeNameExpression(gn, baseExpression, logger)
 			default:
 				logger.Warnf("Unknown baseExpression [%s] for IndexAccess [src:%s].", baseExpressionNodeType, ia.Src)
+				gn.AddUnknownNode(baseExpressionNodeType, baseExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -166,6 +167,7 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				iaIndexExpression, err = GetUnaryOperation(gn, indexExpression, logger)
 			default:
 				logger.Warnf("Unknown indexExpression [%s] for IndexAccess [src:%s].", indexExpressionNodeType, ia.Src)
+				gn.AddUnknownNode(indexExpressionNodeType, indexExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -185,32 +187,6 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (ia *IndexAccess) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// baseExpression
-	{
-		if ia.baseExpression != nil {
-			switch baseExpression := ia.baseExpression.(type) {
-			case *IndexAccess:
-				baseExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	// indexExpression
-	{
-		if ia.indexExpression != nil {
-			switch indexExpression := ia.indexExpression.(type) {
-			case *FunctionCall:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (ia *IndexAccess) SetBaseExpression(baseExpression ASTNode) {
 	ia.baseExpression = baseExpression
 }
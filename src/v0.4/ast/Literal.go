@@ -1,11 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -51,7 +51,7 @@ func (l *Literal) SourceCode(isSc bool, isIndent bool, indent string, logger log
 	case "hexString":
 		code = code + "hex" + fmt.Sprintf("\"%s\"", l.Value)
 	default:
-		logger.Warnf("Unknown kind [%s] for Literal [src:%s].", l.Kind, l.Src)
+		src.Dropf(logger, "Unknown kind [%s] for Literal [src:%s].", l.Kind, l.Src)
 	}
 
 	if l.Subdenomination != "" {
@@ -79,7 +79,7 @@ func (l *Literal) NodeID() int {
 
 func GetLiteral(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Literal, error) {
 	l := new(Literal)
-	if err := json.Unmarshal([]byte(raw.ToString()), l); err != nil {
+	if err := src.UnmarshalAny(raw, l); err != nil {
 		logger.Errorf("Failed to unmarshal for Literal: [%s].", err)
 		return nil, fmt.Errorf("failed to unmarshal for Literal: [%s]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -44,7 +44,7 @@ func (ps *PlaceholderStatement) NodeID() int {
 
 func GetPlaceholderStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*PlaceholderStatement, error) {
 	ps := new(PlaceholderStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), ps); err != nil {
+	if err := src.UnmarshalAny(raw, ps); err != nil {
 		logger.Errorf("Failed to unmarshal PlaceholderStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal PlaceholderStatement: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -44,9 +44,9 @@ func (uo *UnaryOperation) SourceCode(isSc bool, isIndent bool, indent string, lo
 			expression = subExpression.SourceCode(false, false, indent, logger)
 		default:
 			if subExpression != nil {
-				logger.Warnf("Unknown subExpression nodeType [%s] for UnaryOperation [src:%s].", subExpression.Type(), uo.Src)
+				src.Dropf(logger, "Unknown subExpression nodeType [%s] for UnaryOperation [src:%s].", subExpression.Type(), uo.Src)
 			} else {
-				logger.Warnf("Unknown subExpression nodeType for UnaryOperation [src:%s].", uo.Src)
+				src.Dropf(logger, "Unknown subExpression nodeType for UnaryOperation [src:%s].", uo.Src)
 			}
 		}
 		if uo.Prefix {
@@ -83,7 +83,7 @@ func (uo *UnaryOperation) NodeID() int {
 
 func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UnaryOperation, error) {
 	uo := new(UnaryOperation)
-	if err := json.Unmarshal([]byte(raw.ToString()), uo); err != nil {
+	if err := src.UnmarshalAny(raw, uo); err != nil {
 		logger.Errorf("Failed to unmarshal UnaryOperation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UnaryOperation: [%v]", err)
 	}
@@ -107,6 +107,7 @@ func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				uoSubExpression, err = GetLiteral(gn, subExpression, logger)
 			default:
 				logger.Warnf("Unknown subExpression nodeType [%s] for UnaryOperation [src:%s].", subExpressionNodeType, uo.Src)
+				gn.AddUnknownNode(subExpressionNodeType, subExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -123,16 +124,3 @@ func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return uo, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (uo *UnaryOperation) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if uo.subExpression != nil {
-		switch subExpression := uo.subExpression.(type) {
-		case *FunctionCall:
-			subExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			subExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -50,7 +50,7 @@ func (udtn *UserDefinedTypeName) NodeID() int {
 
 func GetUserDefinedTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UserDefinedTypeName, error) {
 	udtn := new(UserDefinedTypeName)
-	if err := json.Unmarshal([]byte(raw.ToString()), udtn); err != nil {
+	if err := src.UnmarshalAny(raw, udtn); err != nil {
 		logger.Errorf("Failed to unmarshal UserDefinedTypeName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UserDefinedTypeName: [%v]", err)
 	}
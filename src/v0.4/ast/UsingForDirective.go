@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -28,7 +28,7 @@ func (ufd *UsingForDirective) SourceCode(isSc bool, isIndent bool, indent string
 		case *UserDefinedTypeName:
 			code = code + " " + libraryNameType.SourceCode(false, false, indent, logger)
 		default:
-			logger.Warnf("Unknown libraryName nodeType [%s] for UsingForDirective [src:%s].", libraryNameType.Type(), ufd.Src)
+			src.Dropf(logger, "Unknown libraryName nodeType [%s] for UsingForDirective [src:%s].", libraryNameType.Type(), ufd.Src)
 		}
 	}
 
@@ -41,9 +41,9 @@ func (ufd *UsingForDirective) SourceCode(isSc bool, isIndent bool, indent string
 		code = code + " " + typeNameType.SourceCode(false, false, indent, logger)
 	default:
 		if typeNameType != nil {
-			logger.Warnf("Unknown typeName nodeType [%s] for UsingForDirective [src:%s].", typeNameType.Type(), ufd.Src)
+			src.Dropf(logger, "Unknown typeName nodeType [%s] for UsingForDirective [src:%s].", typeNameType.Type(), ufd.Src)
 		} else {
-			logger.Warnf("Unknown typeName nodeType for UsingForDirective [src:%s].", ufd.Src)
+			src.Dropf(logger, "Unknown typeName nodeType for UsingForDirective [src:%s].", ufd.Src)
 		}
 	}
 
@@ -69,7 +69,7 @@ func (ufd *UsingForDirective) NodeID() int {
 func GetUsingForDirective(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UsingForDirective, error) {
 	ufd := new(UsingForDirective)
 
-	if err := json.Unmarshal([]byte(raw.ToString()), ufd); err != nil {
+	if err := src.UnmarshalAny(raw, ufd); err != nil {
 		logger.Errorf("Failed to unmarshal UsingForDirective: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UsingForDirective: [%v]", err)
 	}
@@ -86,6 +86,7 @@ func GetUsingForDirective(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logg
 			ufd.libraryName = ip
 		default:
 			logger.Warnf("Unknown libraryName nodeType [%s] for UsingForDirective [src:%s].", libraryName.Get("nodeType").ToString(), ufd.Src)
+			gn.AddUnknownNode(libraryName.Get("nodeType").ToString(), libraryName.Get("src").ToString())
 		}
 	}
 
@@ -102,6 +103,7 @@ func GetUsingForDirective(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logg
 			ufdTypeName, err = GetUserDefinedTypeName(gn, typeName, logger)
 		default:
 			logger.Warnf("Unknown typeName nodeType [%s] for UsingForDirective [src:%s].", typeName.Get("nodeType").ToString(), ufd.Src)
+			gn.AddUnknownNode(typeName.Get("nodeType").ToString(), typeName.Get("src").ToString())
 		}
 
 		if err != nil {
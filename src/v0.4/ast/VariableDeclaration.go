@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -48,9 +48,9 @@ func (vd *VariableDeclaration) SourceCode(isSc bool, isIndent bool, indent strin
 			code = code + typeName.SourceCode(false, false, indent, logger)
 		default:
 			if typeName != nil {
-				logger.Warnf("Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeName.Type(), vd.Src)
+				src.Dropf(logger, "Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeName.Type(), vd.Src)
 			} else {
-				logger.Warnf("Unknown typeName nodeType for VariableDeclaration [src:%s].", vd.Src)
+				src.Dropf(logger, "Unknown typeName nodeType for VariableDeclaration [src:%s].", vd.Src)
 			}
 		}
 	}
@@ -95,9 +95,9 @@ func (vd *VariableDeclaration) SourceCode(isSc bool, isIndent bool, indent strin
 			code = code + " = " + value.SourceCode(false, false, indent, logger)
 		default:
 			if value != nil {
-				logger.Warnf("Unknown value nodeType [%s] for VariableDeclaration [src:%s].", value.Type(), vd.Src)
+				src.Dropf(logger, "Unknown value nodeType [%s] for VariableDeclaration [src:%s].", value.Type(), vd.Src)
 			} else {
-				logger.Warnf("Unknown value nodeType for VariableDeclaration [src:%s].", vd.Src)
+				src.Dropf(logger, "Unknown value nodeType for VariableDeclaration [src:%s].", vd.Src)
 			}
 		}
 	}
@@ -123,7 +123,7 @@ func (vd *VariableDeclaration) NodeID() int {
 
 func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*VariableDeclaration, error) {
 	vd := new(VariableDeclaration)
-	if err := json.Unmarshal([]byte(raw.ToString()), vd); err != nil {
+	if err := src.UnmarshalAny(raw, vd); err != nil {
 		logger.Errorf("Failed to unmarshal VariableDeclaration: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal VariableDeclaration: [%v]", err)
 	}
@@ -152,6 +152,7 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 			}
 		default:
 			logger.Warnf("Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeNameNodeType, vd.Src)
+			gn.AddUnknownNode(typeNameNodeType, typeName.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -186,6 +187,7 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 				vdValue, err = GetTupleExpression(gn, value, logger)
 			default:
 				logger.Warnf("Unknown value nodeType [%s] for VariableDeclaration [src:%s]", valueNodeType, vd.Src)
+				gn.AddUnknownNode(valueNodeType, value.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -205,24 +207,6 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (vd *VariableDeclaration) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if vd.typeName != nil {
-		switch typeName := vd.typeName.(type) {
-		case *Mapping:
-			typeName.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if vd.value != nil {
-		switch value := vd.value.(type) {
-		case *BinaryOperation:
-			value.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			value.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
 func (vd *VariableDeclaration) SetTypeName(typeName ASTNode) {
 	vd.typeName = typeName
 }
@@ -0,0 +1,138 @@
+package ast
+
+import (
+	"fmt"
+)
+
+// IsDelegatecall 判断 call 是否调用了地址的 delegatecall，包括 t.delegatecall.gas(g)(d) 这样设置了 gas 或者 value 的形式。
+func IsDelegatecall(call *FunctionCall) bool {
+	return delegatecallMember(call) != nil
+}
+
+// delegatecallMember 返回 call 所调用的 delegatecall 成员访问，call 不是 delegatecall 时返回 nil。
+func delegatecallMember(call *FunctionCall) *MemberAccess {
+	callee := call.expression
+	if c, ok := callee.(*FunctionCall); ok {
+		if member, ok := c.expression.(*MemberAccess); ok && (member.MemberName == "gas" || member.MemberName == "value") {
+			callee = member.expression
+		}
+	}
+	if member, ok := callee.(*MemberAccess); ok && member.MemberName == "delegatecall" {
+		return member
+	}
+	return nil
+}
+
+// Calls 判断语句 statement 中是否有满足 match 的函数调用，嵌套的语句块中的调用属于嵌套的语句块。
+func Calls(statement ASTNode, match func(call *FunctionCall) bool) bool {
+	if _, ok := statement.(*Block); ok {
+		return false
+	}
+	found := false
+	Inspect(statement, func(node ASTNode) bool {
+		switch n := node.(type) {
+		case *Block:
+			return false
+		case *FunctionCall:
+			found = found || match(n)
+		}
+		return !found
+	})
+	return found
+}
+
+// StatementBlocks 返回函数 f 中所有的语句块；if 的分支与循环体不是语句块却有满足 match 的调用时，先把它包装为语句块，
+// 插桩的语句才能插入在它的前后。
+func StatementBlocks(f *FunctionDefinition, match func(call *FunctionCall) bool) []*Block {
+	var blocks []*Block
+	Walk(f, func(c *Cursor) bool {
+		if isBody(c) && Calls(c.Node(), match) {
+			c.Replace(&Block{NodeType: "Block", Src: "xxx", statements: []ASTNode{c.Node()}})
+		}
+		if block, ok := c.Node().(*Block); ok {
+			blocks = append(blocks, block)
+		}
+		return true
+	}, nil)
+	return blocks
+}
+
+// isBody 判断 c 是否是 if 的分支或者循环体，for 语句的初始化与更新部分也是语句，循环体是它的最后一个子节点。
+func isBody(c *Cursor) bool {
+	switch parent := c.Parent().(type) {
+	case *IfStatement:
+		return isStatement(c.Node())
+	case *ForStatement:
+		children := Children(parent)
+		return c.Node() == children[len(children)-1] && isStatement(c.Node())
+	}
+	return false
+}
+
+// isStatement 判断 node 是否是语句块以外的语句。
+func isStatement(node ASTNode) bool {
+	switch node.(type) {
+	case *ExpressionStatement, *VariableDeclarationStatement, *Return, *EmitStatement, *IfStatement, *ForStatement:
+		return true
+	}
+	return false
+}
+
+// SplitReturn 把函数 f 中的 return expression; 改写为 return xxx_return_<id>_<i>, ...;，并返回保存返回值的声明语句
+// (T0 xxx_return_<id>_0, ...) = expression;，插入在两者之间的语句在返回之前执行。函数没有返回值时返回 nil。
+func SplitReturn(r *Return, f *FunctionDefinition) *VariableDeclarationStatement {
+	parameters, ok := f.returnParameters.(*ParameterList)
+	if r.expression == nil || !ok || len(parameters.parameters) == 0 {
+		return nil
+	}
+	// 声明语句代替 return 语句调用 delegatecall，沿用它的 id，重复插桩时快照的名字不变。
+	declaration := &VariableDeclarationStatement{ID: r.ID, NodeType: "VariableDeclarationStatement", Src: "xxx"}
+	var values []ASTNode
+	for i, parameter := range parameters.parameters {
+		p, ok := parameter.(*VariableDeclaration)
+		if !ok {
+			return nil
+		}
+		// 复制的变量是插入的节点，与其它插入的节点一样没有 id，不能与返回参数共用同一个声明的 id。
+		variable := *p
+		variable.ID = 0
+		variable.Name = fmt.Sprintf("xxx_return_%d_%d", r.ID, i)
+		variable.Src = "xxx"
+		declaration.declarations = append(declaration.declarations, &variable)
+		declaration.Assignments = append(declaration.Assignments, i)
+		values = append(values, &Identifier{Name: variable.Name, NodeType: "Identifier", Src: "xxx"})
+	}
+	declaration.initialValue = r.expression
+	if len(values) == 1 {
+		r.expression = values[0]
+	} else {
+		r.expression = &TupleExpression{NodeType: "TupleExpression", Src: "xxx", components: values}
+	}
+	return declaration
+}
+
+// insertAfterCalls 在合约 cd 中每个有满足 match 的调用的语句之后插入 statement；return 语句先把返回值保存在局部变量中，
+// statement 插入在返回之前。
+func insertAfterCalls(cd *ContractDefinition, statement ASTNode, match func(call *FunctionCall) bool) {
+	for _, node := range cd.nodes {
+		f, ok := node.(*FunctionDefinition)
+		if !ok {
+			continue
+		}
+		for _, b := range StatementBlocks(f, match) {
+			statements := append([]ASTNode(nil), b.statements...)
+			// 从后往前插入，插入的语句不会影响前面语句的位置。
+			for i := len(statements) - 1; i >= 0; i-- {
+				if !Calls(statements[i], match) {
+					continue
+				}
+				if r, ok := statements[i].(*Return); ok {
+					if declaration := SplitReturn(r, f); declaration != nil {
+						b.InsertStatement(declaration, i)
+					}
+				}
+				b.InsertStatement(statement, i+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package ast
+
+import "sort"
+
+// SymbolKind 表示声明的种类。
+type SymbolKind int
+
+const (
+	SymbolContract SymbolKind = iota + 1
+	SymbolStateVariable
+	SymbolLocalVariable
+	SymbolParameter
+	SymbolStructMember
+	SymbolFunction
+	SymbolModifier
+	SymbolEvent
+	SymbolError
+	SymbolStruct
+	SymbolEnum
+	SymbolEnumValue
+	SymbolUserDefinedValueType
+)
+
+func (k SymbolKind) String() string {
+	switch k {
+	case SymbolContract:
+		return "contract"
+	case SymbolStateVariable:
+		return "state variable"
+	case SymbolLocalVariable:
+		return "local variable"
+	case SymbolParameter:
+		return "parameter"
+	case SymbolStructMember:
+		return "struct member"
+	case SymbolFunction:
+		return "function"
+	case SymbolModifier:
+		return "modifier"
+	case SymbolEvent:
+		return "event"
+	case SymbolError:
+		return "error"
+	case SymbolStruct:
+		return "struct"
+	case SymbolEnum:
+		return "enum"
+	case SymbolEnumValue:
+		return "enum value"
+	case SymbolUserDefinedValueType:
+		return "user defined value type"
+	default:
+		return "unknown"
+	}
+}
+
+// Symbol 是语法树中的一个声明，Scope 为声明所在作用域节点的 id，Contract 为声明所在的合约，文件级的声明为 nil。
+type Symbol struct {
+	ID       int
+	Name     string
+	Kind     SymbolKind
+	Node     ASTNode
+	Scope    int
+	Contract *ContractDefinition
+}
+
+// SymbolTable 根据 referencedDeclaration、scope 以及 exportedSymbols 将标识符映射到它的声明，
+// 分析时应当比较声明而不是名字，避免把同名的局部变量、参数与状态变量混淆。
+type SymbolTable struct {
+	symbols    map[int]*Symbol
+	scopes     map[int][]*Symbol
+	parents    map[int]int
+	references map[ASTNode]*Symbol
+	uses       map[int][]ASTNode
+	contracts  map[int]*ContractDefinition
+	exported   map[string][]int
+}
+
+// NewSymbolTable 遍历 sourceUnit 构造符号表。
+func NewSymbolTable(sourceUnit *SourceUnit) *SymbolTable {
+	st := &SymbolTable{
+		symbols:    make(map[int]*Symbol),
+		scopes:     make(map[int][]*Symbol),
+		parents:    make(map[int]int),
+		references: make(map[ASTNode]*Symbol),
+		uses:       make(map[int][]ASTNode),
+		contracts:  make(map[int]*ContractDefinition),
+		exported:   sourceUnit.ExportedSymbols,
+	}
+
+	var referenced []ASTNode
+	Walk(sourceUnit, func(c *Cursor) bool {
+		node := c.Node()
+		ancestors := c.Ancestors()
+		if node.NodeID() != 0 {
+			for i := len(ancestors) - 1; i >= 0; i-- {
+				if ancestors[i].NodeID() != 0 {
+					st.parents[node.NodeID()] = ancestors[i].NodeID()
+					break
+				}
+			}
+		}
+
+		var contract *ContractDefinition
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			if cd, ok := ancestors[i].(*ContractDefinition); ok {
+				contract = cd
+				break
+			}
+		}
+
+		if symbol := declare(node, c.Parent()); symbol != nil {
+			symbol.Contract = contract
+			if symbol.Scope == 0 {
+				symbol.Scope = st.parents[symbol.ID]
+			}
+			if cd, ok := node.(*ContractDefinition); ok {
+				st.contracts[cd.ID] = cd
+				symbol.Contract = nil
+			}
+			st.symbols[symbol.ID] = symbol
+			st.scopes[symbol.Scope] = append(st.scopes[symbol.Scope], symbol)
+		}
+		if referencedDeclaration(node) > 0 {
+			referenced = append(referenced, node)
+		}
+		return true
+	}, nil)
+
+	for _, node := range referenced {
+		if symbol, ok := st.symbols[referencedDeclaration(node)]; ok {
+			st.references[node] = symbol
+			st.uses[symbol.ID] = append(st.uses[symbol.ID], node)
+		}
+	}
+
+	return st
+}
+
+// declare 为声明节点 node 构造符号，node 不是声明时返回 nil。
+func declare(node ASTNode, parent ASTNode) *Symbol {
+	switch n := node.(type) {
+	case *ContractDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolContract, Node: n, Scope: n.Scope}
+	case *FunctionDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolFunction, Node: n, Scope: n.Scope}
+	case *ModifierDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolModifier, Node: n}
+	case *EventDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolEvent, Node: n}
+	case *StructDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolStruct, Node: n, Scope: n.Scope}
+	case *EnumDefinition:
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: SymbolEnum, Node: n}
+	case *VariableDeclaration:
+		kind := SymbolLocalVariable
+		switch {
+		case n.StateVariable:
+			kind = SymbolStateVariable
+		case isStructDefinition(parent):
+			kind = SymbolStructMember
+		case isParameterList(parent):
+			kind = SymbolParameter
+		}
+		return &Symbol{ID: n.ID, Name: n.Name, Kind: kind, Node: n, Scope: n.Scope}
+	}
+	return nil
+}
+
+func isStructDefinition(node ASTNode) bool {
+	_, ok := node.(*StructDefinition)
+	return ok
+}
+
+func isParameterList(node ASTNode) bool {
+	_, ok := node.(*ParameterList)
+	return ok
+}
+
+// referencedDeclaration 返回引用节点所引用的声明的 id，内置的符号（如 msg、require）为负数。
+func referencedDeclaration(node ASTNode) int {
+	switch n := node.(type) {
+	case *Identifier:
+		return n.ReferencedDeclaration
+	case *MemberAccess:
+		return n.ReferencedDeclaration
+	case *UserDefinedTypeName:
+		return n.ReferencedDeclaration
+	}
+	return 0
+}
+
+// Symbol 返回 id 对应的声明。
+func (st *SymbolTable) Symbol(id int) *Symbol {
+	return st.symbols[id]
+}
+
+// Symbols 按照 id 的顺序返回所有声明。
+func (st *SymbolTable) Symbols() []*Symbol {
+	symbols := make([]*Symbol, 0, len(st.symbols))
+	for _, symbol := range st.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i].ID < symbols[j].ID })
+	return symbols
+}
+
+// Resolve 返回 Identifier、MemberAccess 等引用节点所引用的声明，引用内置符号或者无法解析时返回 nil。
+func (st *SymbolTable) Resolve(node ASTNode) *Symbol {
+	return st.references[node]
+}
+
+// References 返回所有引用了声明 id 的节点。
+func (st *SymbolTable) References(id int) []ASTNode {
+	return st.uses[id]
+}
+
+// Lookup 从作用域 scope 开始由内向外按名字查找声明，合约作用域中还会按照 C3 线性化的顺序查找继承来的声明。
+// Lookup 不考虑局部变量声明的先后顺序，只用于没有 referencedDeclaration 的场景，如插桩时新建的节点。
+func (st *SymbolTable) Lookup(scope int, name string) *Symbol {
+	visited := make(map[int]bool)
+	for scope != 0 && !visited[scope] {
+		visited[scope] = true
+		if contract, ok := st.contracts[scope]; ok {
+			for _, base := range contract.LinearizedBaseContracts {
+				if symbol := st.lookupIn(base, name); symbol != nil {
+					return symbol
+				}
+			}
+		} else if symbol := st.lookupIn(scope, name); symbol != nil {
+			return symbol
+		}
+		scope = st.parents[scope]
+	}
+	for _, id := range st.exported[name] {
+		if symbol, ok := st.symbols[id]; ok {
+			return symbol
+		}
+	}
+	return nil
+}
+
+func (st *SymbolTable) lookupIn(scope int, name string) *Symbol {
+	for _, symbol := range st.scopes[scope] {
+		if symbol.Name == name {
+			return symbol
+		}
+	}
+	return nil
+}
+
+// Exported 返回 SourceUnit 的 exportedSymbols 中名为 name 的声明。
+func (st *SymbolTable) Exported(name string) []*Symbol {
+	var symbols []*Symbol
+	for _, id := range st.exported[name] {
+		if symbol, ok := st.symbols[id]; ok {
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
+
+// StateVariable 返回合约 contract（包括继承来的）中名为 name 的状态变量。
+func (st *SymbolTable) StateVariable(contract *ContractDefinition, name string) *Symbol {
+	for _, base := range contract.LinearizedBaseContracts {
+		for _, symbol := range st.scopes[base] {
+			if symbol.Kind == SymbolStateVariable && symbol.Name == name {
+				return symbol
+			}
+		}
+	}
+	return nil
+}
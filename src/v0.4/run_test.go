@@ -0,0 +1,71 @@
+package v04
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/golden"
+)
+
+func TestRunGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", "v0.4", "*.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		solFileName := strings.TrimSuffix(filepath.Base(fixture), "_json.ast")
+		t.Run(solFileName, func(t *testing.T) {
+			source, reachability := golden.Load(t, fixture)
+
+			var log bytes.Buffer
+			logger := golden.NewLogger(&log)
+			dirName := t.TempDir()
+
+			node, findings, err := Run(source, reachability, true, logger, solFileName, dirName, config.Default(), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			code := node.SourceCode(false, false, "", logger)
+
+			cg, err := golden.CallGraph(filepath.Join(dirName, "call-graph", solFileName))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := filepath.Join("testdata", solFileName)
+			golden.Assert(t, filepath.Join(dir, "findings.golden"), golden.Findings(log.Bytes(), findings))
+			golden.Assert(t, filepath.Join(dir, "instrumented.sol.golden"), []byte(code))
+			golden.Assert(t, filepath.Join(dir, "callgraph.dot.golden"), cg)
+		})
+	}
+}
+
+// TestRunNamespaced 检查 0.4 的合约在 namespaced 模式下插桩改变 storage 布局时返回错误，owner 使用快照保护时不改变布局。
+func TestRunNamespaced(t *testing.T) {
+	solFileName := "1.sol"
+	source, reachability := golden.Load(t, filepath.Join("..", "..", "contracts", "v0.4", solFileName+"_json.ast"))
+	conf := config.Default()
+	conf.TrackStorage = "namespaced"
+
+	var log bytes.Buffer
+	_, _, err := Run(source, reachability, false, golden.NewLogger(&log), solFileName, t.TempDir(), conf, false)
+	var e *src.Error
+	if !errors.As(err, &e) || e.Kind != src.InstrumentationError {
+		t.Fatalf("expected an instrumentation error, got [%v]", err)
+	}
+	if !strings.Contains(err.Error(), "needs solidity 0.6 or later") {
+		t.Errorf("error should explain the version requirement: [%v]", err)
+	}
+
+	source, reachability = golden.Load(t, filepath.Join("..", "..", "contracts", "v0.4", solFileName+"_json.ast"))
+	conf.OwnerGuard = "snapshot"
+	if _, _, err := Run(source, reachability, false, golden.NewLogger(&log), solFileName, t.TempDir(), conf, false); err != nil {
+		t.Fatal(err)
+	}
+}
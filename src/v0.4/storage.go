@@ -0,0 +1,34 @@
+package v04
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/v0.4/ast"
+)
+
+// StorageLayout 返回每个合约按照继承顺序（从最基础的父合约开始）排列的状态变量声明，constant 不占用 storage，不包括在内；
+// 插桩前后各调用一次，用 layout.Diff 检查插桩是否改变了合约原有的 storage 布局。
+func StorageLayout(gn *ast.GlobalNodes) map[string][]string {
+	declarations := make(map[string][]string)
+	for _, node := range gn.ContractsByID() {
+		contract, ok := node.(*ast.ContractDefinition)
+		if !ok {
+			continue
+		}
+		list := make([]string, 0)
+		for i := len(contract.LinearizedBaseContracts) - 1; i >= 0; i-- {
+			base, ok := gn.ContractsByID()[contract.LinearizedBaseContracts[i]].(*ast.ContractDefinition)
+			if !ok {
+				continue
+			}
+			for _, n := range base.Nodes() {
+				if vd, ok := n.(*ast.VariableDeclaration); ok && !vd.Constant {
+					list = append(list, fmt.Sprintf("%s in %s", vd.SourceCode(false, false, "", src.SilentLogger), base.Name))
+				}
+			}
+		}
+		declarations[contract.Name] = list
+	}
+	return declarations
+}
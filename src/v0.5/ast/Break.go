@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -43,7 +43,7 @@ func (b *Break) NodeID() int {
 
 func GetBreak(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Break, error) {
 	b := new(Break)
-	if err := json.Unmarshal([]byte(raw.ToString()), b); err != nil {
+	if err := src.UnmarshalAny(raw, b); err != nil {
 		logger.Errorf("Failed to unmarshal Break: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Break: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -32,9 +32,9 @@ func (ed *EnumDefinition) SourceCode(isSc bool, isIndent bool, indent string, lo
 				code = code + m.SourceCode(false, true, indent+"    ", logger)
 			default:
 				if m != nil {
-					logger.Warnf("Unknown member nodeType [%s] for EnumValue [src:%s].", m.Type(), ed.Src)
+					src.Dropf(logger, "Unknown member nodeType [%s] for EnumValue [src:%s].", m.Type(), ed.Src)
 				} else {
-					logger.Warnf("Unknown member nodeType for EnumValue [src:%s].", ed.Src)
+					src.Dropf(logger, "Unknown member nodeType for EnumValue [src:%s].", ed.Src)
 				}
 			}
 
@@ -67,7 +67,7 @@ func (ed *EnumDefinition) NodeID() int {
 
 func GetEnumDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*EnumDefinition, error) {
 	ed := new(EnumDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), ed); err != nil {
+	if err := src.UnmarshalAny(raw, ed); err != nil {
 		logger.Errorf("Failed to unmarshal EnumDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal EnumDefinition: [%v]", err)
 	}
@@ -90,6 +90,7 @@ func GetEnumDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 						edMember, err = GetEnumValue(gn, member, logger)
 					default:
 						logger.Warnf("Unknown member nodeType [%s] for EnumDefinition [src:%s].", memberNodeType, ed.Src)
+						gn.AddUnknownNode(memberNodeType, member.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -51,7 +51,7 @@ func (i *Identifier) NodeID() int {
 
 func GetIdentifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Identifier, error) {
 	i := new(Identifier)
-	if err := json.Unmarshal([]byte(raw.ToString()), i); err != nil {
+	if err := src.UnmarshalAny(raw, i); err != nil {
 		logger.Errorf("Failed to unmarshal for Identifier: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal for Identifier: [%v]", err)
 	}
@@ -1,11 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -43,9 +43,9 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
 			default:
 				if condition != nil {
-					logger.Warnf("Unknown condition nodeType [%s] for IfStatement [src:%s].", condition.Type(), is.Src)
+					src.Dropf(logger, "Unknown condition nodeType [%s] for IfStatement [src:%s].", condition.Type(), is.Src)
 				} else {
-					logger.Warnf("Unknown condition nodeType for IfStatement [src:%s].", is.Src)
+					src.Dropf(logger, "Unknown condition nodeType for IfStatement [src:%s].", is.Src)
 				}
 			}
 		}
@@ -71,9 +71,9 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 			// 	code = code + trueBody.SourceCode(true, true, indent+"    ", logger)
 			default:
 				if trueBody != nil {
-					logger.Warnf("Unknown trueBody nodeType [%s] for IfStatement [src:%s].", trueBody.Type(), is.Src)
+					src.Dropf(logger, "Unknown trueBody nodeType [%s] for IfStatement [src:%s].", trueBody.Type(), is.Src)
 				} else {
-					logger.Warnf("Unknown trueBody nodeType for IfStatement [src:%s].", is.Src)
+					src.Dropf(logger, "Unknown trueBody nodeType for IfStatement [src:%s].", is.Src)
 				}
 			}
 		}
@@ -112,9 +112,9 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + "}"
 			default:
 				if falseBody != nil {
-					logger.Warnf("Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBody.Type(), is.Src)
+					src.Dropf(logger, "Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBody.Type(), is.Src)
 				} else {
-					logger.Warnf("Unknown falseBody nodeType for IfStatement [src:%s].", is.Src)
+					src.Dropf(logger, "Unknown falseBody nodeType for IfStatement [src:%s].", is.Src)
 				}
 			}
 		}
@@ -137,7 +137,7 @@ func (is *IfStatement) NodeID() int {
 
 func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IfStatement, error) {
 	is := new(IfStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), is); err != nil {
+	if err := src.UnmarshalAny(raw, is); err != nil {
 		logger.Errorf("Failed to unmarshal IfStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal IfSatement: [%v]", err)
 	}
@@ -163,6 +163,7 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				isCondition, err = GetIdentifier(gn, condition, logger)
 			default:
 				logger.Warnf("Unknown condition nodeType [%s] for IfStatement [src:%s].", conditionNodeType, is.Src)
+				gn.AddUnknownNode(conditionNodeType, condition.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -192,6 +193,7 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				isFalseBody, err = GetExpressionStatement(gn, falseBody, logger)
 			default:
 				logger.Warnf("Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBodyNodeType, is.Src)
+				gn.AddUnknownNode(falseBodyNodeType, falseBody.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -227,6 +229,7 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 			// 	isTrueBody, err = GetBreak(gn, trueBody, logger)
 			default:
 				logger.Warnf("Unknown trueBody [%s] for IfStatement [src:%s].", trueBodyNodeType, is.Src)
+				gn.AddUnknownNode(trueBodyNodeType, trueBody.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -243,103 +246,3 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 
 	return is, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (is *IfStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// condition
-	{
-		if is.condition != nil {
-			switch condition := is.condition.(type) {
-			case *BinaryOperation:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *UnaryOperation:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IfStatement:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (is *IfStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseTaintOwner(opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseTaintOwner(opt, logger)
-			case *IfStatement:
-				falseBody.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseTaintOwner(opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-}
-
-func (is *IfStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseDelegatecall(opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseDelegatecall(opt, logger)
-			case *IfStatement:
-				falseBody.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseDelegatecall(opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
\ No newline at end of file
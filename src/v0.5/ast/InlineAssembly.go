@@ -1,11 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -62,7 +62,7 @@ func (ia *InlineAssembly) NodeID() int {
 
 func GetInlineAssembly(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*InlineAssembly, error) {
 	ia := new(InlineAssembly)
-	if err := json.Unmarshal([]byte(raw.ToString()), ia); err != nil {
+	if err := src.UnmarshalAny(raw, ia); err != nil {
 		logger.Errorf("Failed to unmarshal InlineAssembly: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal InlineAssembly: [%v]", err)
 	}
@@ -71,7 +71,3 @@ func GetInlineAssembly(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return ia, nil
 }
-
-func (ia *InlineAssembly) TraverseFunctionCall() {
-
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -34,9 +34,9 @@ func (m *Mapping) SourceCode(isSc bool, isIndent bool, indent string, logger log
 				code = code + " " + "(" + keyType.SourceCode(false, false, indent, logger)
 			default:
 				if keyType != nil {
-					logger.Warnf("Unknown keyType nodeType [%s] for Mapping [src:%s].", keyType.Type(), m.Src)
+					src.Dropf(logger, "Unknown keyType nodeType [%s] for Mapping [src:%s].", keyType.Type(), m.Src)
 				} else {
-					logger.Warnf("Unknown keyType nodeType for Mapping [src:%s].", m.Src)
+					src.Dropf(logger, "Unknown keyType nodeType for Mapping [src:%s].", m.Src)
 				}
 			}
 		}
@@ -56,9 +56,9 @@ func (m *Mapping) SourceCode(isSc bool, isIndent bool, indent string, logger log
 				code = code + " " + "=>" + " " + valueType.SourceCode(false, false, indent, logger) + ")"
 			default:
 				if valueType != nil {
-					logger.Warnf("Unknown valueType nodeType [%s] for Mapping [src:%s].", valueType.Type(), m.Src)
+					src.Dropf(logger, "Unknown valueType nodeType [%s] for Mapping [src:%s].", valueType.Type(), m.Src)
 				} else {
-					logger.Warnf("Unknown valueType nodeType for Mapping [src:%s].", m.Src)
+					src.Dropf(logger, "Unknown valueType nodeType for Mapping [src:%s].", m.Src)
 				}
 			}
 		}
@@ -85,7 +85,7 @@ func (m *Mapping) NodeID() int {
 
 func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapping, error) {
 	m := new(Mapping)
-	if err := json.Unmarshal([]byte(raw.ToString()), m); err != nil {
+	if err := src.UnmarshalAny(raw, m); err != nil {
 		logger.Errorf("Failed to unmarshal Mapping: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Mapping: [%v]", err)
 	}
@@ -102,6 +102,7 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 			mKeyType, err = GetElementaryTypeName(gn, keyType, logger)
 		default:
 			logger.Warnf("Unknown keyType nodeType [%s] for Mapping [src:%s].", keyTypeNodeType, m.Src)
+			gn.AddUnknownNode(keyTypeNodeType, keyType.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -131,6 +132,7 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 			mValueType, err = GetArrayTypeName(gn, valueType, logger)
 		default:
 			logger.Warnf("Unknown valueType nodeType [%s] for Mapping [src:%s].", valueTypeNodeType, m.Src)
+			gn.AddUnknownNode(valueTypeNodeType, valueType.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -149,23 +151,6 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (m *Mapping) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// keyType
-	{
-
-	}
-
-	// valueType
-	{
-		if m.valueType != nil {
-			switch valueType := m.valueType.(type) {
-			case *Mapping:
-				valueType.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (m *Mapping) SetKeyType(kt ASTNode) {
 	m.keyType = kt
 }
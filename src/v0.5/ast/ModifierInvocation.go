@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -30,9 +30,9 @@ func (mi *ModifierInvocation) SourceCode(isSc bool, isIndent bool, indent string
 			code = code + modifierName.SourceCode(false, false, indent, logger)
 		default:
 			if modifierName != nil {
-				logger.Warnf("Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierName.Type(), mi.Src)
+				src.Dropf(logger, "Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierName.Type(), mi.Src)
 			} else {
-				logger.Warnf("Unknown modifierName nodeType for ModifierInvocation [src:%s].", mi.Src)
+				src.Dropf(logger, "Unknown modifierName nodeType for ModifierInvocation [src:%s].", mi.Src)
 			}
 		}
 	}
@@ -50,9 +50,9 @@ func (mi *ModifierInvocation) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + arg.SourceCode(false, false, indent, logger)
 			default:
 				if arg != nil {
-					logger.Warnf("Unknown argument nodeType [%s] for ModifierInvocation [src:%s].", arg.Type(), mi.Src)
+					src.Dropf(logger, "Unknown argument nodeType [%s] for ModifierInvocation [src:%s].", arg.Type(), mi.Src)
 				} else {
-					logger.Warnf("Unknown argument nodeType for ModifierInvocation [src:%s].", mi.Src)
+					src.Dropf(logger, "Unknown argument nodeType for ModifierInvocation [src:%s].", mi.Src)
 				}
 			}
 
@@ -80,7 +80,7 @@ func (mi *ModifierInvocation) NodeID() int {
 
 func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ModifierInvocation, error) {
 	mi := new(ModifierInvocation)
-	if err := json.Unmarshal([]byte(raw.ToString()), mi); err != nil {
+	if err := src.UnmarshalAny(raw, mi); err != nil {
 		logger.Errorf("Failed to unmarshal ModifierInvocation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ModifierInvocation: [%v]", err)
 	}
@@ -107,6 +107,7 @@ func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 						miArgument, err = GetMemberAccess(gn, argument, logger)
 					default:
 						logger.Warnf("Unknown argument nodeType [%s] for ModifierInvocation [src:%s].", argumentNodeType, mi.Src)
+						gn.AddUnknownNode(argumentNodeType, argument.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -138,6 +139,7 @@ func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 			// 	miModifierName, err = GetIdentifierPath(gn, modifierName, logger)
 			default:
 				logger.Warnf("Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierNameNodeType, mi.Src)
+				gn.AddUnknownNode(modifierNameNodeType, modifierName.Get("src").ToString())
 			}
 
 			if err != nil {
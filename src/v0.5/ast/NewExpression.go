@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -44,9 +44,9 @@ func (ne *NewExpression) SourceCode(isSc bool, isIndent bool, indent string, log
 			code = code + " " + typeName.SourceCode(false, false, indent, logger)
 		default:
 			if typeName != nil {
-				logger.Warnf("Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeName.Type(), ne.Src)
+				src.Dropf(logger, "Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeName.Type(), ne.Src)
 			} else {
-				logger.Warnf("Unknown typeName nodeType for NewExpression [src:%s].", ne.Src)
+				src.Dropf(logger, "Unknown typeName nodeType for NewExpression [src:%s].", ne.Src)
 			}
 		}
 	}
@@ -72,7 +72,7 @@ func (ne *NewExpression) NodeID() int {
 
 func GetNewExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*NewExpression, error) {
 	ne := new(NewExpression)
-	if err := json.Unmarshal([]byte(raw.ToString()), ne); err != nil {
+	if err := src.UnmarshalAny(raw, ne); err != nil {
 		logger.Errorf("Failed to unmarshal NewExpression: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal NewExpression: [%v]", err)
 	}
@@ -92,6 +92,7 @@ func GetNewExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				neTypeName, err = GetElementaryTypeName(gn, typeName, logger)
 			default:
 				logger.Warnf("Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeNameNodeType, ne.Src)
+				gn.AddUnknownNode(typeNameNodeType, typeName.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,9 +37,9 @@ func (vds *VariableDeclarationStatement) SourceCode(isSc bool, isIndent bool, in
 				code = code + d.SourceCode(false, false, indent, logger)
 			default:
 				if d != nil {
-					logger.Warnf("Unknown declaration nodeType [%s] for VariableDeclarationStatement [src:%s]", d.Type(), vds.Src)
+					src.Dropf(logger, "Unknown declaration nodeType [%s] for VariableDeclarationStatement [src:%s]", d.Type(), vds.Src)
 				} else {
-					logger.Warnf("Unknown declaration nodeType for VariableDeclarationStatement [src:%s]", vds.Src)
+					src.Dropf(logger, "Unknown declaration nodeType for VariableDeclarationStatement [src:%s]", vds.Src)
 				}
 			}
 
@@ -73,9 +73,9 @@ func (vds *VariableDeclarationStatement) SourceCode(isSc bool, isIndent bool, in
 		// 	code = code + " = " + initialValue.SourceCode(false, false, indent, logger)
 		default:
 			if initialValue != nil {
-				logger.Warnf("Unknown initialValue nodeType [%s] for VariableDeclarationStatement [src:%s]", initialValue.Type(), vds.Src)
+				src.Dropf(logger, "Unknown initialValue nodeType [%s] for VariableDeclarationStatement [src:%s]", initialValue.Type(), vds.Src)
 			} else {
-				logger.Warnf("Unknown initialValue nodeType for VariableDeclarationStatement [src:%s]", vds.Src)
+				src.Dropf(logger, "Unknown initialValue nodeType for VariableDeclarationStatement [src:%s]", vds.Src)
 			}
 		}
 	}
@@ -101,7 +101,7 @@ func (vds *VariableDeclarationStatement) NodeID() int {
 
 func GetVariableDeclarationStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*VariableDeclarationStatement, error) {
 	vds := new(VariableDeclarationStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), vds); err != nil {
+	if err := src.UnmarshalAny(raw, vds); err != nil {
 		logger.Errorf("Failed to unmarshal VariableDeclarationStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal VariableDeclarationStatement: [%v]", err)
 	}
@@ -124,6 +124,7 @@ func GetVariableDeclarationStatement(gn *GlobalNodes, raw jsoniter.Any, logger l
 						vdsDeclaration, err = GetVariableDeclaration(gn, declaration, logger)
 					default:
 						logger.Warnf("Unknown declaration nodeType [%s] for VariableDeclarationStatement [src:%s].", declarationNodeType, vds.Src)
+						gn.AddUnknownNode(declarationNodeType, declaration.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -175,6 +176,7 @@ func GetVariableDeclarationStatement(gn *GlobalNodes, raw jsoniter.Any, logger l
 			// 	vdsInitialValue, err = GetConditional(gn, initialValue, logger)
 			default:
 				logger.Warnf("Unknown initialValue nodeType [%s] for VariableDeclarationStatement [src:%s].", initialValueNodeType, vds.Src)
+				gn.AddUnknownNode(initialValueNodeType, initialValue.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -194,41 +196,10 @@ func GetVariableDeclarationStatement(gn *GlobalNodes, raw jsoniter.Any, logger l
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (vds *VariableDeclarationStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(vds.declarations) > 0 {
-		for _, declaration := range vds.declarations {
-			switch d := declaration.(type) {
-			case *VariableDeclaration:
-				d.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	if vds.initialValue != nil {
-		switch initialValue := vds.initialValue.(type) {
-		case *FunctionCall:
-			initialValue.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *MemberAccess:
-			initialValue.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *BinaryOperation:
-			initialValue.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			initialValue.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *TupleExpression:
-			initialValue.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
+func (vds *VariableDeclarationStatement) AppendDeclaration(declaration ASTNode) {
+	vds.declarations = append(vds.declarations, declaration)
 }
 
-func (vds *VariableDeclarationStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if vds.initialValue != nil {
-		switch initialValue := vds.initialValue.(type) {
-		case *FunctionCall:
-			initialValue.TraverseDelegatecall(opt, logger)
-		case *MemberAccess:
-			initialValue.TraverseDelegatecall(opt, logger)
-		case *BinaryOperation:
-			initialValue.TraverseDelegatecall(opt, logger)
-		}
-	}
+func (vds *VariableDeclarationStatement) SetInitialValue(initialValue ASTNode) {
+	vds.initialValue = initialValue
 }
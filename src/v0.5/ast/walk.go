@@ -0,0 +1,282 @@
+package ast
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Cursor 描述 Walk 遍历到的节点以及它在语法树中的位置。
+type Cursor struct {
+	node      ASTNode
+	slot      *ASTNode
+	ancestors []ASTNode
+}
+
+// Node 返回当前节点。
+func (c *Cursor) Node() ASTNode {
+	return c.node
+}
+
+// Parent 返回当前节点的父节点，根节点的父节点为 nil。
+func (c *Cursor) Parent() ASTNode {
+	if len(c.ancestors) == 0 {
+		return nil
+	}
+	return c.ancestors[len(c.ancestors)-1]
+}
+
+// Ancestors 返回从根节点到父节点的所有祖先节点，调用方不应修改返回的切片。
+func (c *Cursor) Ancestors() []ASTNode {
+	return c.ancestors
+}
+
+// Replace 在父节点中用 node 替换当前节点；在 pre 中替换时，Walk 会继续遍历 node 的子节点。
+func (c *Cursor) Replace(node ASTNode) {
+	*c.slot = node
+	c.node = node
+}
+
+// Walk 先序遍历以 root 为根的语法树中的每个节点：进入节点时调用 pre，pre 返回 false 时跳过该节点的子节点以及 post；
+// 离开节点时调用 post，post 返回 false 时终止整个遍历。pre 与 post 都可以为 nil。
+// 子节点按照源码中的位置排列；插桩时插入的代码 (Src 为 "xxx") 同样会被遍历，排在它前面的兄弟节点之后。返回值为遍历结束后的根节点，根节点可能被 Replace 替换。
+func Walk(root ASTNode, pre func(c *Cursor) bool, post func(c *Cursor) bool) ASTNode {
+	w := &walker{pre: pre, post: post}
+	w.walk(&root, nil)
+	return root
+}
+
+// Inspect 先序遍历以 root 为根的语法树，fn 返回 false 时跳过该节点的子节点。
+func Inspect(root ASTNode, fn func(node ASTNode) bool) {
+	Walk(root, func(c *Cursor) bool {
+		return fn(c.Node())
+	}, nil)
+}
+
+// Parents 返回以 root 为根的语法树中每个节点的父节点。
+func Parents(root ASTNode) map[ASTNode]ASTNode {
+	parents := make(map[ASTNode]ASTNode)
+	Walk(root, func(c *Cursor) bool {
+		if parent := c.Parent(); parent != nil {
+			parents[c.Node()] = parent
+		}
+		return true
+	}, nil)
+	return parents
+}
+
+// Children 返回节点 node 的直接子节点，按照源码中的位置排列。
+func Children(node ASTNode) []ASTNode {
+	var children []ASTNode
+	for _, slot := range childSlots(node) {
+		children = append(children, *slot)
+	}
+	return children
+}
+
+type walker struct {
+	pre  func(c *Cursor) bool
+	post func(c *Cursor) bool
+	stop bool
+}
+
+func (w *walker) walk(slot *ASTNode, ancestors []ASTNode) {
+	if w.stop || isNil(*slot) {
+		return
+	}
+
+	c := &Cursor{node: *slot, slot: slot, ancestors: ancestors}
+	if w.pre != nil && !w.pre(c) {
+		return
+	}
+	if isNil(c.node) {
+		return
+	}
+
+	ancestors = append(ancestors[:len(ancestors):len(ancestors)], c.node)
+	for _, child := range childSlots(c.node) {
+		w.walk(child, ancestors)
+		if w.stop {
+			return
+		}
+	}
+
+	c.node = *slot
+	if w.post != nil && !w.post(c) {
+		w.stop = true
+	}
+}
+
+// childSlots 返回节点 node 中保存非空子节点的位置，通过这些位置可以替换子节点。
+func childSlots(node ASTNode) []*ASTNode {
+	var slots []*ASTNode
+	for _, slot := range fieldSlots(node) {
+		if !isNil(*slot) {
+			slots = append(slots, slot)
+		}
+	}
+
+	// 没有 src 的节点（如元组中被省略的元素）沿用前一个节点的位置，排序后仍然紧跟在前一个节点之后。
+	starts := make([]int, len(slots))
+	last := -1
+	for i, slot := range slots {
+		if start, ok := srcStart(*slot); ok {
+			last = start
+		}
+		starts[i] = last
+	}
+	sort.Stable(bySrc{slots: slots, starts: starts})
+	return slots
+}
+
+type bySrc struct {
+	slots  []*ASTNode
+	starts []int
+}
+
+func (s bySrc) Len() int           { return len(s.slots) }
+func (s bySrc) Less(i, j int) bool { return s.starts[i] < s.starts[j] }
+func (s bySrc) Swap(i, j int) {
+	s.slots[i], s.slots[j] = s.slots[j], s.slots[i]
+	s.starts[i], s.starts[j] = s.starts[j], s.starts[i]
+}
+
+// srcStart 解析节点 src 属性（start:length:file）中的起始位置。
+func srcStart(node ASTNode) (int, bool) {
+	v := reflect.ValueOf(node)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	field := v.Elem().FieldByName("Src")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return 0, false
+	}
+	start, err := strconv.Atoi(strings.SplitN(field.String(), ":", 2)[0])
+	if err != nil {
+		return 0, false
+	}
+	return start, true
+}
+
+func isNil(node ASTNode) bool {
+	if node == nil {
+		return true
+	}
+	v := reflect.ValueOf(node)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// fieldSlots 按照结构体中字段的顺序返回节点 node 所有子节点字段的位置。
+func fieldSlots(node ASTNode) []*ASTNode {
+	switch n := node.(type) {
+	case *ArrayTypeName:
+		return []*ASTNode{&n.baseType, &n.length}
+	case *Assignment:
+		return []*ASTNode{&n.leftHandSide, &n.rightHandSide}
+	case *BinaryOperation:
+		return []*ASTNode{&n.leftExpression, &n.rightExpression}
+	case *Block:
+		var slots []*ASTNode
+		for i := range n.statements {
+			slots = append(slots, &n.statements[i])
+		}
+		return slots
+	case *Conditional:
+		return []*ASTNode{&n.condition, &n.falseExpression, &n.trueExpression}
+	case *ContractDefinition:
+		var slots []*ASTNode
+		for i := range n.baseContracts {
+			slots = append(slots, &n.baseContracts[i])
+		}
+		for i := range n.nodes {
+			slots = append(slots, &n.nodes[i])
+		}
+		return slots
+	case *EmitStatement:
+		return []*ASTNode{&n.eventCall}
+	case *EnumDefinition:
+		var slots []*ASTNode
+		for i := range n.members {
+			slots = append(slots, &n.members[i])
+		}
+		return slots
+	case *EventDefinition:
+		return []*ASTNode{&n.parameters}
+	case *ExpressionStatement:
+		return []*ASTNode{&n.expression, &n.trackVariable, &n.trackMapping}
+	case *ForStatement:
+		return []*ASTNode{&n.body, &n.condition, &n.initializationExpression, &n.loopExpression}
+	case *FunctionCall:
+		slots := []*ASTNode{&n.expression}
+		for i := range n.arguments {
+			slots = append(slots, &n.arguments[i])
+		}
+		return slots
+	case *FunctionDefinition:
+		slots := []*ASTNode{&n.body, &n.parameters, &n.returnParameters}
+		for i := range n.modifiers {
+			slots = append(slots, &n.modifiers[i])
+		}
+		return slots
+	case *IfStatement:
+		return []*ASTNode{&n.condition, &n.falseBody, &n.trueBody}
+	case *IndexAccess:
+		return []*ASTNode{&n.baseExpression, &n.indexExpression}
+	case *InheritanceSpecifier:
+		return []*ASTNode{&n.baseName}
+	case *Mapping:
+		return []*ASTNode{&n.keyType, &n.valueType}
+	case *MemberAccess:
+		return []*ASTNode{&n.expression}
+	case *ModifierDefinition:
+		return []*ASTNode{&n.body, &n.parameters}
+	case *ModifierInvocation:
+		slots := []*ASTNode{&n.modifierName}
+		for i := range n.arguments {
+			slots = append(slots, &n.arguments[i])
+		}
+		return slots
+	case *NewExpression:
+		return []*ASTNode{&n.typeName}
+	case *ParameterList:
+		var slots []*ASTNode
+		for i := range n.parameters {
+			slots = append(slots, &n.parameters[i])
+		}
+		return slots
+	case *Return:
+		return []*ASTNode{&n.expression}
+	case *SourceUnit:
+		var slots []*ASTNode
+		for i := range n.nodes {
+			slots = append(slots, &n.nodes[i])
+		}
+		return slots
+	case *StructDefinition:
+		var slots []*ASTNode
+		for i := range n.members {
+			slots = append(slots, &n.members[i])
+		}
+		return slots
+	case *TupleExpression:
+		var slots []*ASTNode
+		for i := range n.components {
+			slots = append(slots, &n.components[i])
+		}
+		return slots
+	case *UnaryOperation:
+		return []*ASTNode{&n.subExpression}
+	case *UsingForDirective:
+		return []*ASTNode{&n.libraryName, &n.typeName}
+	case *VariableDeclaration:
+		return []*ASTNode{&n.typeName, &n.value}
+	case *VariableDeclarationStatement:
+		slots := []*ASTNode{&n.initialValue}
+		for i := range n.declarations {
+			slots = append(slots, &n.declarations[i])
+		}
+		return slots
+	}
+	return nil
+}
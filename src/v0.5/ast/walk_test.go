@@ -0,0 +1,133 @@
+package ast
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/logging"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestWalk(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "..", "contracts", "v0.5", "*.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := logging.MustNewLogger(logging.Option{
+		Module:         "Test",
+		FilterLevel:    logging.PanicLevel,
+		FormatSelector: "terminal",
+		Writer:         io.Discard,
+	})
+
+	for _, fixture := range fixtures {
+		jsonBytes, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gn := NewGlobalNodes()
+		su, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parents := Parents(su)
+		visited := make(map[ASTNode]bool)
+		Walk(su, func(c *Cursor) bool {
+			if visited[c.Node()] {
+				t.Errorf("%s: node [%s:%d] is visited twice", fixture, c.Node().Type(), c.Node().NodeID())
+			}
+			visited[c.Node()] = true
+			if parent := c.Parent(); parent != parents[c.Node()] {
+				t.Errorf("%s: unexpected parent of node [%s:%d]", fixture, c.Node().Type(), c.Node().NodeID())
+			}
+			if len(c.Ancestors()) > 0 && c.Ancestors()[0] != su {
+				t.Errorf("%s: the first ancestor should be the SourceUnit", fixture)
+			}
+
+			last := -1
+			for _, child := range Children(c.Node()) {
+				start, ok := srcStart(child)
+				if !ok {
+					continue
+				}
+				if start < last {
+					t.Errorf("%s: children of node [%s:%d] are not in source order", fixture, c.Node().Type(), c.Node().NodeID())
+				}
+				last = start
+			}
+			return true
+		}, nil)
+
+		for id, function := range gn.Functions() {
+			if !visited[function] {
+				t.Errorf("%s: function [%d] is not visited", fixture, id)
+			}
+		}
+	}
+}
+
+func TestWalkReplace(t *testing.T) {
+	a := &Assignment{leftHandSide: &Identifier{Name: "a", NodeType: "Identifier", Src: "0:1:0"}, rightHandSide: &Identifier{Name: "b", NodeType: "Identifier", Src: "4:1:0"}, NodeType: "Assignment", Src: "0:5:0"}
+	root := ASTNode(&ExpressionStatement{expression: a, NodeType: "ExpressionStatement", Src: "0:6:0"})
+
+	var pre, post []string
+	root = Walk(root, func(c *Cursor) bool {
+		pre = append(pre, c.Node().Type())
+		if identifier, ok := c.Node().(*Identifier); ok && identifier.Name == "b" {
+			c.Replace(&Literal{Value: "1", NodeType: "Literal", Src: "4:1:0"})
+		}
+		return true
+	}, func(c *Cursor) bool {
+		post = append(post, c.Node().Type())
+		return true
+	})
+
+	if _, ok := a.rightHandSide.(*Literal); !ok {
+		t.Errorf("rightHandSide is not replaced")
+	}
+	if root.(*ExpressionStatement).expression != a {
+		t.Errorf("root should not be changed")
+	}
+	if expected := []string{"ExpressionStatement", "Assignment", "Identifier", "Identifier"}; !equal(pre, expected) {
+		t.Errorf("pre = %v, expected %v", pre, expected)
+	}
+	if expected := []string{"Identifier", "Literal", "Assignment", "ExpressionStatement"}; !equal(post, expected) {
+		t.Errorf("post = %v, expected %v", post, expected)
+	}
+
+	// post 返回 false 时终止遍历，pre 返回 false 时跳过子节点。
+	var visited []string
+	Walk(root, func(c *Cursor) bool {
+		visited = append(visited, c.Node().Type())
+		return c.Node().Type() != "Identifier"
+	}, func(c *Cursor) bool {
+		return c.Node().Type() != "Literal"
+	})
+	if expected := []string{"ExpressionStatement", "Assignment", "Identifier", "Literal"}; !equal(visited, expected) {
+		t.Errorf("visited = %v, expected %v", visited, expected)
+	}
+
+	root = Walk(root, func(c *Cursor) bool {
+		c.Replace(&Identifier{Name: "c"})
+		return false
+	}, nil)
+	if identifier, ok := root.(*Identifier); !ok || identifier.Name != "c" {
+		t.Errorf("root is not replaced")
+	}
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -5,44 +5,28 @@ import (
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/layout"
+	"github.com/geistwelt/taintguard/src/types"
 	"github.com/geistwelt/taintguard/src/v0.5/ast"
 )
 
-func IsInheritFromOwnableContract(contract *ast.ContractDefinition, gn *ast.GlobalNodes, variables []string) (bool, *ast.ContractDefinition) {
-	for _, node := range contract.Nodes() {
-		switch n := node.(type) {
-		case *ast.VariableDeclaration:
-			// Here the parameter names can be defined via the command line.
-			for _, variable := range variables {
-				if n.Name == variable {
-					return false, nil
-				}
-			}
+// OwnerVariable 通过符号表 symbols 查找合约 contract（包括继承来的）中名字在 variables 中的状态变量，
+// 多个变量匹配时按照 C3 线性化的顺序返回声明在最接近 contract 的合约中的那个，没有匹配的变量时返回 nil。
+func OwnerVariable(contract *ast.ContractDefinition, variables []string, symbols *ast.SymbolTable) *ast.Symbol {
+	order := make(map[int]int, len(contract.LinearizedBaseContracts))
+	for index, base := range contract.LinearizedBaseContracts {
+		order[base] = index
+	}
+	var owner *ast.Symbol
+	for _, variable := range variables {
+		symbol := symbols.StateVariable(contract, variable)
+		if symbol != nil && (owner == nil || order[symbol.Contract.ID] < order[owner.Contract.ID]) {
+			owner = symbol
 		}
 	}
-
-	for _, contractNodeID := range contract.LinearizedBaseContracts {
-		if contractNodeID == contract.NodeID() {
-			continue
-		}
-		baseContract := gn.ContractsByID()[contractNodeID].(*ast.ContractDefinition)
-		if baseContract.Name == "Ownable" {
-			return true, baseContract
-		} else {
-			for _, node := range baseContract.Nodes() {
-				switch n := node.(type) {
-				case *ast.VariableDeclaration:
-					for _, variable := range variables {
-						if n.Name == variable {
-							return true, baseContract
-						}
-					}
-				}
-			}
-		}
-	}
-
-	return false, nil
+	return owner
 }
 
 func IsOwnableOnlyHasBytesPosition(contract *ast.ContractDefinition, variables []string) (bool, string) {
@@ -50,7 +34,7 @@ func IsOwnableOnlyHasBytesPosition(contract *ast.ContractDefinition, variables [
 		if node.Type() == "VariableDeclaration" {
 			vdNode, _ := node.(*ast.VariableDeclaration)
 			for _, variable := range variables {
-				if vdNode.Name == variable && vdNode.TypeDescriptions.TypeString == "address" {
+				if vdNode.Name == variable && types.IsAddress(vdNode.TypeDescriptions.TypeIdentifier) {
 					return false, ""
 				}
 			}
@@ -61,7 +45,7 @@ func IsOwnableOnlyHasBytesPosition(contract *ast.ContractDefinition, variables [
 	for _, node := range contract.Nodes() {
 		if node.Type() == "VariableDeclaration" {
 			vdNode, _ := node.(*ast.VariableDeclaration)
-			if vdNode.TypeDescriptions.TypeString == "bytes32" {
+			if t, err := types.Parse(vdNode.TypeDescriptions.TypeIdentifier); err == nil && t.Category == types.FixedBytes && t.Size == 32 {
 				for _, variable := range variables {
 					if strings.Contains(strings.ToUpper(vdNode.Name), strings.ToUpper(variable)) {
 						ok = true
@@ -89,7 +73,7 @@ func LookupSetRepresentOwnerName(contract *ast.ContractDefinition, representOwne
 					if len(plParameters.GetParameters()) == 1 {
 						if parameter := plParameters.GetParameters()[0]; parameter.Type() == "VariableDeclaration" {
 							vdParameter, _ := parameter.(*ast.VariableDeclaration)
-							if vdParameter.TypeDescriptions.TypeString == "address" {
+							if types.IsAddress(vdParameter.TypeDescriptions.TypeIdentifier) {
 								// Insert the code that records the modification of the contract permissions in this position of the function.
 								InsertRepresentOwnerNameInContract(contract, representOwnerName)
 								InsertTrackCodeInFunction(fdNode, representOwnerName, vdParameter.Name)
@@ -119,7 +103,7 @@ func LookupGetRepresentOwnerName(contract *ast.ContractDefinition, representOwne
 							if len(plReturnParameters.GetParameters()) == 1 {
 								if returnParameter := plReturnParameters.GetParameters()[0]; returnParameter.Type() == "VariableDeclaration" {
 									vdReturnParameter, _ := returnParameter.(*ast.VariableDeclaration)
-									if vdReturnParameter.TypeDescriptions.TypeString == "address" {
+									if types.IsAddress(vdReturnParameter.TypeDescriptions.TypeIdentifier) {
 										return true, fdNode.Name
 									}
 								}
@@ -133,7 +117,7 @@ func LookupGetRepresentOwnerName(contract *ast.ContractDefinition, representOwne
 	return false, ""
 }
 
-func InsertCodeForAssert(representOwnerName string, contract *ast.ContractDefinition, getOwner string) {
+func InsertCodeForAssert(representOwnerName string, contract *ast.ContractDefinition, getOwner string, guard string, logger logging.Logger) {
 	expressionStatement := &ast.ExpressionStatement{
 		NodeType: "ExpressionStatement",
 		Src:      "xxx",
@@ -148,7 +132,7 @@ func InsertCodeForAssert(representOwnerName string, contract *ast.ContractDefini
 			TypeIdentifier string `json:"typeIdentifier"`
 			TypeString     string `json:"typeString"`
 		}{{TypeIdentifier: "t_bool", TypeString: "bool"}},
-		Name:     "assert",
+		Name:     guard,
 		NodeType: "Identifier",
 		Src:      "xxx",
 	}
@@ -190,7 +174,7 @@ func InsertCodeForAssert(representOwnerName string, contract *ast.ContractDefini
 	binaryOperationLeftExpression.SetIndexExpression(binaryOperationLeftExpressionIndexExpression)
 	functionCall.AppendArgument(functionCallArgument)
 
-	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logging.MustNewLogger())
+	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logger)
 }
 
 func InsertTrackCodeInFunction(fd *ast.FunctionDefinition, representOwnerName string, variableName string) {
@@ -306,8 +290,8 @@ func InsertRepresentOwnerNameInContract(contract *ast.ContractDefinition, repres
 
 	for _, node := range contract.Nodes() {
 		if node.Type() == "VariableDeclaration" {
-			if node.SourceCode(false, false, "", nil) == vd.SourceCode(false, false, "", nil) ||
-				node.SourceCode(false, false, "", nil) == trackMapVd.SourceCode(false, false, "", nil) {
+			if node.SourceCode(false, false, "", src.SilentLogger) == vd.SourceCode(false, false, "", src.SilentLogger) ||
+				node.SourceCode(false, false, "", src.SilentLogger) == trackMapVd.SourceCode(false, false, "", src.SilentLogger) {
 				isInserted = true
 				break
 			}
@@ -320,20 +304,16 @@ func InsertRepresentOwnerNameInContract(contract *ast.ContractDefinition, repres
 	}
 }
 
-func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string) string {
+// InstrumentCodeForOwner 为 owner 变量（见 OwnerVariable）在声明它的合约中添加记录修改的状态变量，并在每处修改之后记录修改后的值，
+// 所有修改由过程间的写入分析（见 analysis.WriteSet）找出。
+func InstrumentCodeForOwner(owner *ast.Symbol, a *analysis.Analysis, layouts []*layout.Layout, gn *ast.GlobalNodes, logger logging.Logger) string {
+	contract := owner.Contract
 	var ownerVariableName string
 	for _, node := range contract.Nodes() {
 		if node.Type() == "VariableDeclaration" {
 			// check
 			vdNode, _ := node.(*ast.VariableDeclaration)
-			// Here the parameter names can be defined via the command line.
-			var ok bool
-			for _, variable := range variables {
-				if vdNode.Name == variable {
-					ok = true
-				}
-			}
-			if ok {
+			if node == owner.Node {
 				ownerVariableName = vdNode.Name
 
 				instReturnOwnerFunction := &ast.FunctionDefinition{
@@ -432,7 +412,7 @@ func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string
 				var isExist bool = false
 				for _, node_ := range contract.Nodes() {
 					if node_.Type() == "VariableDeclaration" {
-						if node_.SourceCode(false, false, "", nil) == protect1.SourceCode(false, false, "", nil) {
+						if node_.SourceCode(false, false, "", src.SilentLogger) == protect1.SourceCode(false, false, "", src.SilentLogger) {
 							isExist = true
 							break
 						}
@@ -442,11 +422,7 @@ func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string
 					contract.AppendNode(protect1)
 					contract.AppendNode(protect2)
 
-					contract.TraverseTaintOwner(&ast.Option{
-						TrackOwnerVariableName:   fmt.Sprintf("xxx_track_%s", vdNode.Name),
-						TrackOwnerMappingName:    fmt.Sprintf("xxx_track_mapping_%s", vdNode.Name),
-						SimilarOwnerVariableName: vdNode.Name,
-					}, logging.MustNewLogger())
+					InstrumentCodeForWrites(vdNode.Name, a.WriteSet(contract.Name, vdNode.Name, layouts), gn, logger)
 				}
 			}
 		}
@@ -454,7 +430,7 @@ func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string
 	return ownerVariableName
 }
 
-func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDefinition) {
+func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDefinition, guard string, logger logging.Logger) {
 	expressionStatement := &ast.ExpressionStatement{
 		NodeType: "ExpressionStatement",
 		Src:      "xxx",
@@ -469,7 +445,7 @@ func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDef
 			TypeIdentifier string `json:"typeIdentifier"`
 			TypeString     string `json:"typeString"`
 		}{{TypeIdentifier: "t_bool", TypeString: "bool"}},
-		Name:     "assert",
+		Name:     guard,
 		NodeType: "Identifier",
 		Src:      "xxx",
 	}
@@ -511,7 +487,7 @@ func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDef
 	binaryOperationLeftExpression.SetIndexExpression(binaryOperationLeftExpressionIndexExpression)
 	functionCall.AppendArgument(functionCallArgument)
 
-	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logging.MustNewLogger())
+	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logger)
 }
 
 func VerifyVariableDeclarationOrder(callerContract, calleeContract *ast.ContractDefinition, gn *ast.GlobalNodes, variables []string) bool {
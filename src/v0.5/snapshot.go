@@ -0,0 +1,133 @@
+package v05
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/v0.5/ast"
+)
+
+// InstrumentCodeForSnapshot 在合约的每个 delegatecall 语句之前把 states 中每个状态的值保存到局部变量中，
+// 并在语句之后用 guard 检查它们没有被修改；返回插入的快照，合约中没有 delegatecall 语句时返回 nil。
+func InstrumentCodeForSnapshot(states []*analysis.State, contract *ast.ContractDefinition, guard string, logger logging.Logger) []*analysis.Snapshot {
+	if len(states) == 0 {
+		return nil
+	}
+	found := false
+	for _, node := range contract.Nodes() {
+		f, ok := node.(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+		for _, b := range ast.StatementBlocks(f, ast.IsDelegatecall) {
+			found = instrumentBlock(b, f, states, guard, contract, logger) || found
+		}
+	}
+	if !found {
+		return nil
+	}
+	snapshots := make([]*analysis.Snapshot, len(states))
+	for i, s := range states {
+		snapshots[i] = &analysis.Snapshot{State: s}
+	}
+	return snapshots
+}
+
+// instrumentBlock 在语句块 b 中每个调用了 delegatecall 的语句前后插入快照与检查，返回 b 中是否有这样的语句。
+func instrumentBlock(b *ast.Block, f *ast.FunctionDefinition, states []*analysis.State, guard string, contract *ast.ContractDefinition, logger logging.Logger) bool {
+	found := false
+	statements := append([]ast.ASTNode(nil), b.Nodes()...)
+	// 检查插入在 owner 的断言等已经插入的语句之后，使它们在重复插桩时仍然紧跟在 delegatecall 语句之后；
+	// 位置在插入之前确定，不会越过后一个语句的快照。
+	nexts := make([]int, len(statements))
+	for i := range statements {
+		next := i + 1
+		for next < len(statements) && inserted(statements[next]) && !ast.Calls(statements[next], ast.IsDelegatecall) {
+			next++
+		}
+		nexts[i] = next
+	}
+	// 从后往前插入，插入的语句不会影响前面语句的位置。
+	for i := len(statements) - 1; i >= 0; i-- {
+		if !ast.Calls(statements[i], ast.IsDelegatecall) {
+			continue
+		}
+		found = true
+		snapshots := make([]ast.ASTNode, len(states))
+		checks := make([]ast.ASTNode, len(states))
+		for j, s := range states {
+			name := fmt.Sprintf("xxx_snapshot_%s_%d", snapshotName.ReplaceAllString(s.Expression, "_"), statements[i].NodeID())
+			snapshots[j] = snapshotStatement(s.Type, name, s.Expression)
+			checks[j] = checkStatement(guard, s.Expression, name)
+		}
+		// 同一个合约可能被多个检测结果插桩，已经保存过快照的语句不再重复插入。
+		if i > 0 && statements[i-1].SourceCode(false, false, "", src.SilentLogger) == snapshots[len(snapshots)-1].SourceCode(false, false, "", src.SilentLogger) {
+			continue
+		}
+		next := nexts[i]
+		if r, ok := statements[i].(*ast.Return); ok {
+			// return 语句返回之后无法再检查，先把返回值保存在局部变量中，检查插入在声明与返回之间。
+			if declaration := ast.SplitReturn(r, f); declaration != nil {
+				b.InsertStatement(declaration, i)
+				next = i + 1
+			}
+		}
+		logger.Debugf("Snapshot %d states around the delegatecall statement [%d] of contract [%s].", len(states), statements[i].NodeID(), contract.Name)
+		for j := len(checks) - 1; j >= 0; j-- {
+			b.InsertStatement(checks[j], next)
+		}
+		for j := len(snapshots) - 1; j >= 0; j-- {
+			b.InsertStatement(snapshots[j], i)
+		}
+	}
+	return found
+}
+
+// inserted 判断 statement 是否是插桩时插入的语句。
+func inserted(statement ast.ASTNode) bool {
+	es, ok := statement.(*ast.ExpressionStatement)
+	return ok && es.Src == "xxx"
+}
+
+// snapshotName 匹配表达式中不能出现在变量名中的字符。
+var snapshotName = regexp.MustCompile(`[^A-Za-z0-9_$]+`)
+
+// snapshotStatement 返回 typeName name = expression;
+func snapshotStatement(typeName string, name string, expression string) *ast.VariableDeclarationStatement {
+	declaration := &ast.VariableDeclaration{
+		Name:            name,
+		NodeType:        "VariableDeclaration",
+		Src:             "xxx",
+		StorageLocation: "default",
+		Visibility:      "internal",
+	}
+	declaration.SetTypeName(&ast.ElementaryTypeName{Name: typeName, NodeType: "ElementaryTypeName", Src: "xxx"})
+	statement := &ast.VariableDeclarationStatement{NodeType: "VariableDeclarationStatement", Src: "xxx"}
+	statement.AppendDeclaration(declaration)
+	statement.SetInitialValue(&ast.Identifier{Name: expression, NodeType: "Identifier", Src: "xxx"})
+	return statement
+}
+
+// checkStatement 返回 guard(expression == name);
+func checkStatement(guard string, expression string, name string) *ast.ExpressionStatement {
+	comparison := &ast.BinaryOperation{NodeType: "BinaryOperation", Operator: "==", Src: "xxx"}
+	comparison.SetLeftExpression(&ast.Identifier{Name: expression, NodeType: "Identifier", Src: "xxx"})
+	comparison.SetRightExpression(&ast.Identifier{Name: name, NodeType: "Identifier", Src: "xxx"})
+	call := &ast.FunctionCall{Kind: "functionCall", NodeType: "FunctionCall", Src: "xxx"}
+	call.SetExpression(&ast.Identifier{
+		ArgumentTypes: []struct {
+			TypeIdentifier string `json:"typeIdentifier"`
+			TypeString     string `json:"typeString"`
+		}{{TypeIdentifier: "t_bool", TypeString: "bool"}},
+		Name:     guard,
+		NodeType: "Identifier",
+		Src:      "xxx",
+	})
+	call.AppendArgument(comparison)
+	statement := &ast.ExpressionStatement{NodeType: "ExpressionStatement", Src: "xxx"}
+	statement.SetExpression(call)
+	return statement
+}
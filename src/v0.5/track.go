@@ -0,0 +1,121 @@
+package v05
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/v0.5/ast"
+)
+
+// InstrumentCodeForWrites 在每个修改 owner 变量的外部入口中，于直接或者间接（经过内部函数）修改它的语句之后，
+// 记录修改后的值以及入口的签名；修饰器中的修改记录在函数体的开头，此时修饰器中 _ 之前的部分已经执行完毕。
+func InstrumentCodeForWrites(ownerVariableName string, writes []*analysis.Write, gn *ast.GlobalNodes, logger logging.Logger) {
+	sites := make(map[int]map[int]bool)
+	modifiers := make(map[int]bool)
+	entries := make([]int, 0)
+	for _, w := range writes {
+		if sites[w.Entry.ID] == nil {
+			sites[w.Entry.ID] = make(map[int]bool)
+			entries = append(entries, w.Entry.ID)
+		}
+		logger.Debugf("Track the write to [%s]: %s.", ownerVariableName, w)
+		if w.Site.Op == ir.OpModifier {
+			modifiers[w.Entry.ID] = true
+			continue
+		}
+		sites[w.Entry.ID][w.Site.NodeID] = true
+	}
+
+	for _, id := range entries {
+		f, ok := gn.Functions()[id].(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+		var body *ast.Block
+		for _, child := range ast.Children(f) {
+			if block, ok := child.(*ast.Block); ok {
+				body = block
+			}
+		}
+		if body == nil {
+			continue
+		}
+		placed := make(map[int]bool)
+		trackStatements(body, sites[id], placed, ownerVariableName, f.Signature(), logger)
+		if modifiers[id] {
+			insertTrack(body, 0, ownerVariableName, f.Signature())
+		}
+		for nodeID := range sites[id] {
+			if !placed[nodeID] {
+				logger.Warnf("Failed to track the write to [%s] at node [%d] in function [%s], it is not inside a statement of a block.", ownerVariableName, nodeID, f.Signature())
+			}
+		}
+	}
+}
+
+// trackStatements 在 b 中每个包含 sites 的语句之后插入记录，复合语句先在其内部的块中插入，无法插入时（如修改发生在条件中）
+// 在复合语句之后插入；placed 记录已经处理过的节点。
+func trackStatements(b *ast.Block, sites map[int]bool, placed map[int]bool, ownerVariableName string, signature string, logger logging.Logger) {
+	statements := append([]ast.ASTNode(nil), b.Nodes()...)
+	// 从后往前插入，插入的语句不会影响前面语句的位置。
+	for i := len(statements) - 1; i >= 0; i-- {
+		hits := make([]int, 0)
+		ast.Inspect(statements[i], func(node ast.ASTNode) bool {
+			if sites[node.NodeID()] && !placed[node.NodeID()] {
+				hits = append(hits, node.NodeID())
+			}
+			return true
+		})
+		if len(hits) == 0 {
+			continue
+		}
+		switch statement := statements[i].(type) {
+		case *ast.Return:
+			logger.Warnf("Failed to track the write to [%s] in function [%s], it happens in a return statement.", ownerVariableName, signature)
+			for _, hit := range hits {
+				placed[hit] = true
+			}
+			continue
+		case *ast.Block:
+			trackStatements(statement, sites, placed, ownerVariableName, signature, logger)
+		default:
+			// if、for、try 等复合语句中最外层的块，如 else if 中的块以及 catch 子句中的块。
+			ast.Inspect(statement, func(node ast.ASTNode) bool {
+				if block, ok := node.(*ast.Block); ok {
+					trackStatements(block, sites, placed, ownerVariableName, signature, logger)
+					return false
+				}
+				return true
+			})
+		}
+		remaining := false
+		for _, hit := range hits {
+			remaining = remaining || !placed[hit]
+			placed[hit] = true
+		}
+		if remaining {
+			insertTrack(b, i+1, ownerVariableName, signature)
+		}
+	}
+}
+
+// insertTrack 在 b 的第 index 个位置插入 xxx_track_owner = "signature"; xxx_track_mapping_owner["signature"] = owner;
+func insertTrack(b *ast.Block, index int, ownerVariableName string, signature string) {
+	name := &ast.Literal{Kind: "string", NodeType: "Literal", Src: "xxx", Value: signature}
+	mapping := &ast.IndexAccess{NodeType: "IndexAccess", Src: "xxx"}
+	mapping.SetBaseExpression(&ast.Identifier{Name: fmt.Sprintf("xxx_track_mapping_%s", ownerVariableName), NodeType: "Identifier", Src: "xxx"})
+	mapping.SetIndexExpression(name)
+	b.InsertStatement(trackStatement(mapping, &ast.Identifier{Name: ownerVariableName, NodeType: "Identifier", Src: "xxx"}), index)
+	b.InsertStatement(trackStatement(&ast.Identifier{Name: fmt.Sprintf("xxx_track_%s", ownerVariableName), NodeType: "Identifier", Src: "xxx"}, name), index)
+}
+
+func trackStatement(left ast.ASTNode, right ast.ASTNode) *ast.ExpressionStatement {
+	assignment := &ast.Assignment{NodeType: "Assignment", Operator: "=", Src: "xxx"}
+	assignment.SetLeft(left)
+	assignment.SetRight(right)
+	statement := &ast.ExpressionStatement{NodeType: "ExpressionStatement", Src: "xxx"}
+	statement.SetExpression(assignment)
+	return statement
+}
@@ -20,7 +20,7 @@ type Option struct {
 	delegatecallUnknownContractCh chan struct{}
 
 	// search object that delegatecall known contract
-	delegatecallKnownContractCh chan string
+	delegatecallKnownContractCh chan int
 
 	indirectDelegatecallCh chan struct{}
 
@@ -28,9 +28,11 @@ type Option struct {
 	TrackFunctionDefinitionName string
 	TrackOwnerVariableName      string
 	TrackOwnerMappingName       string
-	SimilarOwnerVariableName    string
-	IsTainted                   bool
-	TrackAssignment             ASTNode
+	// Symbols 用于解析赋值的左边，只有解析到 owner 状态变量的声明 OwnerVariable 的赋值才需要追踪，同名的局部变量与参数不受影响
+	Symbols         *SymbolTable
+	OwnerVariable   *Symbol
+	IsTainted       bool
+	TrackAssignment ASTNode
 
 	// search sentence that use delegatecall
 	ExpressionStatement ASTNode
@@ -41,7 +43,7 @@ func (opt *Option) MakeDelegatecallUnknownContractCh(size int) {
 }
 
 func (opt *Option) MakeDelegatecallKnownContractCh(size int) {
-	opt.delegatecallKnownContractCh = make(chan string, size)
+	opt.delegatecallKnownContractCh = make(chan int, size)
 }
 
 func (opt *Option) MakeIndirectDelegatecallCh(size int) {
@@ -52,7 +54,7 @@ func (opt *Option) DelegatecallUnknownContractCh() <-chan struct{} {
 	return opt.delegatecallUnknownContractCh
 }
 
-func (opt *Option) DelegatecallKnownContractCh() <-chan string {
+func (opt *Option) DelegatecallKnownContractCh() <-chan int {
 	return opt.delegatecallKnownContractCh
 }
 
@@ -60,121 +62,22 @@ func (opt *Option) IndirectDelegatecallCh() <-chan struct{} {
 	return opt.indirectDelegatecallCh
 }
 
-type traverseFunctionCall interface {
-	TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger)
-}
-
-var _ traverseFunctionCall = (*Assignment)(nil)
-var _ traverseFunctionCall = (*BinaryOperation)(nil)
-var _ traverseFunctionCall = (*Block)(nil)
-// var _ traverseFunctionCall = (*Conditional)(nil)
-// var _ traverseFunctionCall = (*EmitStatement)(nil)
-var _ traverseFunctionCall = (*EventDefinition)(nil)
-var _ traverseFunctionCall = (*ExpressionStatement)(nil)
-var _ traverseFunctionCall = (*ForStatement)(nil)
-var _ traverseFunctionCall = (*FunctionCall)(nil)
-var _ traverseFunctionCall = (*FunctionDefinition)(nil)
-var _ traverseFunctionCall = (*IfStatement)(nil)
-var _ traverseFunctionCall = (*IndexAccess)(nil)
-var _ traverseFunctionCall = (*Mapping)(nil)
-var _ traverseFunctionCall = (*MemberAccess)(nil)
-var _ traverseFunctionCall = (*ParameterList)(nil)
-var _ traverseFunctionCall = (*Return)(nil)
-var _ traverseFunctionCall = (*TupleExpression)(nil)
-var _ traverseFunctionCall = (*UnaryOperation)(nil)
-var _ traverseFunctionCall = (*VariableDeclaration)(nil)
-var _ traverseFunctionCall = (*VariableDeclarationStatement)(nil)
-// var _ traverseFunctionCall = (*UncheckedBlock)(nil)
-// var _ traverseFunctionCall = (*WhileStatement)(nil)
-// var _ traverseFunctionCall = (*FunctionCallOptions)(nil)
-// var _ traverseFunctionCall = (*TryCatchClause)(nil)
-// var _ traverseFunctionCall = (*TryStatement)(nil)
-// var _ traverseFunctionCall = (*DoWhileStatement)(nil)
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-type traverseTaintOwner interface {
-	TraverseTaintOwner(opt *Option, logger logging.Logger)
-}
-
-var _ traverseTaintOwner = (*ContractDefinition)(nil)
-var _ traverseTaintOwner = (*FunctionDefinition)(nil)
-var _ traverseTaintOwner = (*Block)(nil)
-var _ traverseTaintOwner = (*ExpressionStatement)(nil)
-var _ traverseTaintOwner = (*IfStatement)(nil)
-var _ traverseTaintOwner = (*ForStatement)(nil)
-// var _ traverseTaintOwner = (*UncheckedBlock)(nil)
-// var _ traverseTaintOwner = (*WhileStatement)(nil)
-// var _ traverseTaintOwner = (*TryStatement)(nil)
-// var _ traverseTaintOwner = (*DoWhileStatement)(nil)
-var _ traverseTaintOwner = (*Assignment)(nil)
-// var _ traverseTaintOwner = (*TryCatchClause)(nil)
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-type traverseDelegatecall interface {
-	TraverseDelegatecall(opt *Option, logger logging.Logger)
-}
-
-var _ traverseDelegatecall = (*ContractDefinition)(nil)
-var _ traverseDelegatecall = (*FunctionDefinition)(nil)
-var _ traverseDelegatecall = (*Block)(nil)
-var _ traverseDelegatecall = (*ExpressionStatement)(nil)
-var _ traverseDelegatecall = (*IfStatement)(nil)
-var _ traverseDelegatecall = (*ForStatement)(nil)
-// var _ traverseDelegatecall = (*UncheckedBlock)(nil)
-// var _ traverseDelegatecall = (*WhileStatement)(nil)
-// var _ traverseDelegatecall = (*TryStatement)(nil)
-// var _ traverseDelegatecall = (*DoWhileStatement)(nil)
-var _ traverseDelegatecall = (*VariableDeclarationStatement)(nil)
-var _ traverseDelegatecall = (*FunctionCall)(nil)
-// var _ traverseDelegatecall = (*FunctionCallOptions)(nil)
-var _ traverseDelegatecall = (*MemberAccess)(nil)
-var _ traverseDelegatecall = (*BinaryOperation)(nil)
-// var _ traverseDelegatecall = (*TryCatchClause)(nil)
-var _ traverseDelegatecall = (*Assignment)(nil)
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-type traverseIndirectDelegatecall interface {
-	TraverseIndirectDelegatecall(opt *Option, logger logging.Logger)
-}
-
-var _ traverseIndirectDelegatecall = (*ContractDefinition)(nil)
-var _ traverseIndirectDelegatecall = (*FunctionDefinition)(nil)
-var _ traverseIndirectDelegatecall = (*Block)(nil)
-var _ traverseIndirectDelegatecall = (*ExpressionStatement)(nil)
-var _ traverseIndirectDelegatecall = (*IfStatement)(nil)
-var _ traverseIndirectDelegatecall = (*ForStatement)(nil)
-// var _ traverseDelegatecall = (*UncheckedBlock)(nil)
-// var _ traverseDelegatecall = (*WhileStatement)(nil)
-// var _ traverseDelegatecall = (*TryStatement)(nil)
-// var _ traverseDelegatecall = (*DoWhileStatement)(nil)
-var _ traverseIndirectDelegatecall = (*VariableDeclarationStatement)(nil)
-var _ traverseIndirectDelegatecall = (*FunctionCall)(nil)
-// var _ traverseDelegatecall = (*FunctionCallOptions)(nil)
-var _ traverseIndirectDelegatecall = (*MemberAccess)(nil)
-var _ traverseIndirectDelegatecall = (*BinaryOperation)(nil)
-// var _ traverseDelegatecall = (*TryCatchClause)(nil)
-var _ traverseIndirectDelegatecall = (*Assignment)(nil)
-
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Analysis
 
 type GlobalNodes struct {
-	nodes           map[int]ASTNode    // id => all ASTNode
-	contractsByID       map[int]ASTNode    // id => all ContractDefinition
-	contractsByName map[string]ASTNode // name => all ContractDefinition
-	functions       map[int]ASTNode    // id => all FunctionDefinition
-	mu              sync.RWMutex
+	nodes         map[int]ASTNode // id => all ASTNode
+	contractsByID map[int]ASTNode // id => all ContractDefinition
+	functions     map[int]ASTNode // id => all FunctionDefinition
+	unknownNodes  []UnknownNode   // nodes skipped because their nodeType is not supported
+	mu            sync.RWMutex
 }
 
 func NewGlobalNodes() *GlobalNodes {
 	gn := new(GlobalNodes)
 	gn.nodes = make(map[int]ASTNode)
 	gn.contractsByID = make(map[int]ASTNode)
-	gn.contractsByName = make(map[string]ASTNode)
 	gn.functions = make(map[int]ASTNode)
 	return gn
 }
@@ -182,13 +85,12 @@ func NewGlobalNodes() *GlobalNodes {
 func (gn *GlobalNodes) AddASTNode(node ASTNode) {
 	gn.mu.Lock()
 	gn.nodes[node.NodeID()] = node
-	if node.Type() == "FunctionDefinition" {
+	// 按照具体类型而不是 nodeType 区分，jsoniter 不区分字段名的大小写，nodeType 可能与节点的类型不一致。
+	switch node.(type) {
+	case *FunctionDefinition:
 		gn.functions[node.NodeID()] = node
-	}
-	if node.Type() == "ContractDefinition" {
+	case *ContractDefinition:
 		gn.contractsByID[node.NodeID()] = node
-		cd := node.(*ContractDefinition)
-		gn.contractsByName[cd.Name] = node
 	}
 	gn.mu.Unlock()
 }
@@ -205,8 +107,20 @@ func (gn *GlobalNodes) ContractsByID() map[int]ASTNode {
 	return gn.contractsByID
 }
 
-func (gn *GlobalNodes) ContractsByName() map[string]ASTNode {
-	return gn.contractsByName
+// UnknownNode 记录解析时因 nodeType 不受支持而被跳过的节点。
+type UnknownNode struct {
+	NodeType string
+	Src      string
+}
+
+func (gn *GlobalNodes) AddUnknownNode(nodeType string, src string) {
+	gn.mu.Lock()
+	gn.unknownNodes = append(gn.unknownNodes, UnknownNode{NodeType: nodeType, Src: src})
+	gn.mu.Unlock()
+}
+
+func (gn *GlobalNodes) UnknownNodes() []UnknownNode {
+	return gn.unknownNodes
 }
 
 type NormalCallPath struct {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -55,9 +55,9 @@ func (bo *BinaryOperation) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + leftExpression.SourceCode(false, false, indent, logger)
 		default:
 			if leftExpression != nil {
-				logger.Warnf("Unknown leftExpression nodeType [%s] for BinaryOperation [src:%s].", leftExpression.Type(), bo.Src)
+				src.Dropf(logger, "Unknown leftExpression nodeType [%s] for BinaryOperation [src:%s].", leftExpression.Type(), bo.Src)
 			} else {
-				logger.Warnf("Unknown leftExpression nodeType for BinaryOperation [src:%s].", bo.Src)
+				src.Dropf(logger, "Unknown leftExpression nodeType for BinaryOperation [src:%s].", bo.Src)
 			}
 		}
 	}
@@ -88,9 +88,9 @@ func (bo *BinaryOperation) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
 		default:
 			if rightExpression != nil {
-				logger.Warnf("Unknown rightExpression nodeType [%s] for BinaryOperation [src:%s].", rightExpression.Type(), bo.Src)
+				src.Dropf(logger, "Unknown rightExpression nodeType [%s] for BinaryOperation [src:%s].", rightExpression.Type(), bo.Src)
 			} else {
-				logger.Warnf("Unknown rightExpression nodeType for BinaryOperation [src:%s].", bo.Src)
+				src.Dropf(logger, "Unknown rightExpression nodeType for BinaryOperation [src:%s].", bo.Src)
 			}
 		}
 	}
@@ -116,7 +116,7 @@ func (bo *BinaryOperation) NodeID() int {
 
 func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*BinaryOperation, error) {
 	bo := new(BinaryOperation)
-	if err := json.Unmarshal([]byte(raw.ToString()), bo); err != nil {
+	if err := src.UnmarshalAny(raw, bo); err != nil {
 		logger.Errorf("Failed to unmarshal BinaryOperation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal BinaryOperation: [%v]", err)
 	}
@@ -147,6 +147,7 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				boLeftExpression, err = GetIndexAccess(gn, leftExpression, logger)
 			default:
 				logger.Warnf("Unknown leftExpression nodeType [%s] for BinaryOperation [src:%s].", leftExpressionNodeType, bo.Src)
+				gn.AddUnknownNode(leftExpressionNodeType, leftExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -185,6 +186,7 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				boRightExpression, err = GetIndexAccess(gn, rightExpression, logger)
 			default:
 				logger.Warnf("Unknown rightExpression nodeType [%s] for BinaryOperation [src:%s].", rightExpressionNodeType, bo.Src)
+				gn.AddUnknownNode(rightExpressionNodeType, rightExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -203,64 +205,6 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (bo *BinaryOperation) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *BinaryOperation:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *MemberAccess:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *MemberAccess:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *TupleExpression:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (bo *BinaryOperation) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *BinaryOperation:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		case *FunctionCall:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		case *MemberAccess:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		case *FunctionCall:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		case *MemberAccess:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
 func (bo *BinaryOperation) SetLeftExpression(leftExpression ASTNode) {
 	bo.leftExpression = leftExpression
 }
@@ -268,27 +212,3 @@ func (bo *BinaryOperation) SetLeftExpression(leftExpression ASTNode) {
 func (bo *BinaryOperation) SetRightExpression(rightExpression ASTNode) {
 	bo.rightExpression = rightExpression
 }
-
-func (bo *BinaryOperation) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *BinaryOperation:
-			leftExpression.TraverseIndirectDelegatecall(opt, logger)
-		case *FunctionCall:
-			leftExpression.TraverseIndirectDelegatecall(opt, logger)
-		case *MemberAccess:
-			leftExpression.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-
-	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			rightExpression.TraverseIndirectDelegatecall(opt, logger)
-		case *FunctionCall:
-			rightExpression.TraverseIndirectDelegatecall(opt, logger)
-		case *MemberAccess:
-			rightExpression.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-}
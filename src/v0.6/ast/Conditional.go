@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -42,9 +42,9 @@ func (c *Conditional) SourceCode(isSc bool, isIndent bool, indent string, logger
 			code = code + condition.SourceCode(false, false, indent, logger)
 		default:
 			if condition != nil {
-				logger.Warnf("Unknown condition nodeType [%s] for Conditional [src:%s].", condition.Type(), c.Src)
+				src.Dropf(logger, "Unknown condition nodeType [%s] for Conditional [src:%s].", condition.Type(), c.Src)
 			} else {
-				logger.Warnf("Unknown condition nodeType for Conditional [src:%s].", c.Src)
+				src.Dropf(logger, "Unknown condition nodeType for Conditional [src:%s].", c.Src)
 			}
 		}
 	} else {
@@ -69,9 +69,9 @@ func (c *Conditional) SourceCode(isSc bool, isIndent bool, indent string, logger
 			code = code + trueExpression.SourceCode(false, false, indent, logger)
 		default:
 			if trueExpression != nil {
-				logger.Warnf("Unknown trueExpression nodeType [%s] for Conditional [src:%s].", trueExpression.Type(), c.Src)
+				src.Dropf(logger, "Unknown trueExpression nodeType [%s] for Conditional [src:%s].", trueExpression.Type(), c.Src)
 			} else {
-				logger.Warnf("Unknown trueExpression nodeType for Conditional [src:%s].", c.Src)
+				src.Dropf(logger, "Unknown trueExpression nodeType for Conditional [src:%s].", c.Src)
 			}
 		}
 	} else {
@@ -94,9 +94,9 @@ func (c *Conditional) SourceCode(isSc bool, isIndent bool, indent string, logger
 			code = code + falseExpression.SourceCode(false, false, indent, logger)
 		default:
 			if falseExpression != nil {
-				logger.Warnf("Unknown falseExpression nodeType [%s] for Conditional [src:%s].", falseExpression.Type(), c.Src)
+				src.Dropf(logger, "Unknown falseExpression nodeType [%s] for Conditional [src:%s].", falseExpression.Type(), c.Src)
 			} else {
-				logger.Warnf("Unknown falseExpression nodeType for Conditional [src:%s].", c.Src)
+				src.Dropf(logger, "Unknown falseExpression nodeType for Conditional [src:%s].", c.Src)
 			}
 		}
 	} else {
@@ -120,7 +120,7 @@ func (c *Conditional) NodeID() int {
 
 func GetConditional(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Conditional, error) {
 	c := new(Conditional)
-	if err := json.Unmarshal([]byte(raw.ToString()), c); err != nil {
+	if err := src.UnmarshalAny(raw, c); err != nil {
 		logger.Errorf("Failed to unmarshal Conditional: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Conditional: [%v]", err)
 	}
@@ -142,6 +142,7 @@ func GetConditional(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				cCondition, err = GetIdentifier(gn, condition, logger)
 			default:
 				logger.Warnf("Unknown condition nodeType [%s] for Conditional [src:%s].", conditionNodeType, c.Src)
+				gn.AddUnknownNode(conditionNodeType, condition.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -175,6 +176,7 @@ func GetConditional(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				cFalseExpression, err = GetIndexAccess(gn, falseExpression, logger)
 			default:
 				logger.Warnf("Unknown faleExpression nodeType [%s] for Conditional [src:%s].", falseExpressionNodeType, c.Src)
+				gn.AddUnknownNode(falseExpressionNodeType, falseExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -210,6 +212,7 @@ func GetConditional(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				cTrueExpression, err = GetIndexAccess(gn, trueExpression, logger)
 			default:
 				logger.Warnf("Unknown trueExpression nodeType [%s] for Conditional [src:%s].", trueExpressionNodeType, c.Src)
+				gn.AddUnknownNode(trueExpressionNodeType, trueExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -226,23 +229,3 @@ func GetConditional(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 
 	return c, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (c *Conditional) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if c.condition != nil {
-		switch condition := c.condition.(type) {
-		case *TupleExpression:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *BinaryOperation:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if c.falseExpression != nil {
-		switch falseExpression := c.falseExpression.(type) {
-		case *FunctionCall:
-			falseExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
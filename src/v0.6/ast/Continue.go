@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -43,7 +43,7 @@ func (b *Continue) NodeID() int {
 
 func GetContinue(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Continue, error) {
 	b := new(Continue)
-	if err := json.Unmarshal([]byte(raw.ToString()), b); err != nil {
+	if err := src.UnmarshalAny(raw, b); err != nil {
 		logger.Errorf("Failed to unmarshal Continue: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Continue: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -70,7 +70,7 @@ func (cd *ContractDefinition) SourceCode(isSc bool, isIndent bool, indent string
 			case "EnumDefinition":
 				code = code + node.SourceCode(false, true, indent+"    ", logger) + "\n"
 			default:
-				logger.Warnf("Unknown nodeType in ContractDefinition: [%s].", node.Type())
+				src.Dropf(logger, "Unknown nodeType in ContractDefinition: [%s].", node.Type())
 			}
 		}
 	}
@@ -98,7 +98,7 @@ func (cd *ContractDefinition) NodeID() int {
 
 func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ContractDefinition, error) {
 	cd := new(ContractDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), cd); err != nil {
+	if err := src.UnmarshalAny(raw, cd); err != nil {
 		logger.Errorf("Failed to unmarshal ContractDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ContractDefinition: [%v]", err)
 	}
@@ -120,6 +120,7 @@ func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 					cd.baseContracts = append(cd.baseContracts, bc)
 				default:
 					logger.Warnf("Unknown baseContract nodeType: [%v-%s]", baseContractNodeType, baseContract.Get("src").ToString())
+					gn.AddUnknownNode(baseContractNodeType, baseContract.Get("src").ToString())
 				}
 			}
 		}
@@ -147,8 +148,9 @@ func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 					cdNode, err = GetModifierDefinition(gn, node, logger)
 				case "FunctionDefinition":
 					cdNode, err = GetFunctionDefinition(gn, node, logger)
-					fd, _ := cdNode.(*FunctionDefinition)
-					fd.MakeSignature(cd.Name, logger)
+					if fd, ok := cdNode.(*FunctionDefinition); ok && err == nil {
+						fd.MakeSignature(cd.Name, logger)
+					}
 				case "StructDefinition":
 					cdNode, err = GetStructDefinition(gn, node, logger)
 				// case "ErrorDefinition":
@@ -157,6 +159,7 @@ func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 					cdNode, err = GetEnumDefinition(gn, node, logger)
 				default:
 					logger.Warnf("Unknown nodes nodeType: [%v-%s]", nodeNodeType, node.Get("src").ToString())
+					gn.AddUnknownNode(nodeNodeType, node.Get("src").ToString())
 				}
 
 				if err != nil {
@@ -184,7 +187,7 @@ func (cd *ContractDefinition) InsertReturnOwnerFunction(fd *FunctionDefinition)
 		for _, node := range cd.nodes {
 			switch node.Type() {
 			case "FunctionDefinition":
-				if node.SourceCode(false, false, "", nil) == fd.SourceCode(false, false, "", nil) {
+				if node.SourceCode(false, false, "", src.SilentLogger) == fd.SourceCode(false, false, "", src.SilentLogger) {
 					isExisted = true
 				}
 			}
@@ -195,35 +198,33 @@ func (cd *ContractDefinition) InsertReturnOwnerFunction(fd *FunctionDefinition)
 	}
 }
 
+// TraverseTaintOwner 遍历合约中每个函数的所有语句，在修改 owner 的赋值语句前后插入追踪修改者的语句。
 func (cd *ContractDefinition) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if len(cd.nodes) > 0 {
-		for _, node := range cd.nodes {
-			switch n := node.(type) {
-			case *FunctionDefinition:
-				n.TraverseTaintOwner(opt, logger)
-			}
+	for _, node := range cd.nodes {
+		fd, ok := node.(*FunctionDefinition)
+		if !ok || fd.body == nil {
+			continue
 		}
+		opt.TrackFunctionDefinitionName = fd.Signature()
+		Inspect(fd.body, func(node ASTNode) bool {
+			if es, ok := node.(*ExpressionStatement); ok {
+				es.TraverseTaintOwner(opt, logger)
+			}
+			return true
+		})
 	}
 }
 
+// TraverseDelegatecall 在合约中每个调用了 delegatecall 的语句之后插入 opt.ExpressionStatement。
 func (cd *ContractDefinition) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if len(cd.nodes) > 0 {
-		for _, node := range cd.nodes {
-			switch n := node.(type) {
-			case *FunctionDefinition:
-				n.TraverseDelegatecall(opt, logger)
-			}
-		}
+	if opt.ExpressionStatement != nil {
+		insertAfterCalls(cd, opt.ExpressionStatement, IsDelegatecall)
 	}
 }
 
+// TraverseIndirectDelegatecall 在合约中每个通过 delegateCall 之类的函数间接发起 delegatecall 的语句之后插入 opt.ExpressionStatement。
 func (cd *ContractDefinition) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	if len(cd.nodes) > 0 {
-		for _, node := range cd.nodes {
-			switch n := node.(type) {
-			case *FunctionDefinition:
-				n.TraverseIndirectDelegatecall(opt, logger)
-			}
-		}
+	if opt.ExpressionStatement != nil {
+		insertAfterCalls(cd, opt.ExpressionStatement, IsIndirectDelegatecall)
 	}
 }
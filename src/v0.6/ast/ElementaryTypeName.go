@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -52,7 +52,7 @@ func (etn *ElementaryTypeName) NodeID() int {
 
 func GetElementaryTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ElementaryTypeName, error) {
 	etn := new(ElementaryTypeName)
-	if err := json.Unmarshal([]byte(raw.ToString()), etn); err != nil {
+	if err := src.UnmarshalAny(raw, etn); err != nil {
 		logger.Errorf("Failed to unmarshal ElementaryTypeName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ElementaryTypeName: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -29,9 +29,9 @@ func (es *EmitStatement) SourceCode(isSc bool, isIndent bool, indent string, log
 			code = code + " " + eventCall.SourceCode(false, false, indent, logger)
 		default:
 			if eventCall != nil {
-				logger.Warnf("Unknown eventCall nodeType [%s] for EmitStatement [src:%s].", eventCall.Type(), es.Src)
+				src.Dropf(logger, "Unknown eventCall nodeType [%s] for EmitStatement [src:%s].", eventCall.Type(), es.Src)
 			} else {
-				logger.Warnf("Unknown eventCall nodeType for EmitStatement [src:%s].", es.Src)
+				src.Dropf(logger, "Unknown eventCall nodeType for EmitStatement [src:%s].", es.Src)
 			}
 		}
 	}
@@ -57,7 +57,7 @@ func (es *EmitStatement) NodeID() int {
 
 func GetEmitStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*EmitStatement, error) {
 	es := new(EmitStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), es); err != nil {
+	if err := src.UnmarshalAny(raw, es); err != nil {
 		logger.Errorf("Failed to unmarshal EmitStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal EmitStatement: [%v]", err)
 	}
@@ -75,6 +75,7 @@ func GetEmitStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				esEventCall, err = GetFunctionCall(gn, eventCall, logger)
 			default:
 				logger.Warnf("Unknown eventCall nodeType [%s] for EmitStatement [src:%s].", eventCallNodeType, es.Src)
+				gn.AddUnknownNode(eventCallNodeType, eventCall.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -91,14 +92,3 @@ func GetEmitStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return es, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (es *EmitStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if es.eventCall != nil {
-		switch eventCall := es.eventCall.(type) {
-		case *FunctionCall:
-			eventCall.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -41,7 +41,7 @@ func (ev *EnumValue) NodeID() int {
 
 func GetEnumValue(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*EnumValue, error) {
 	ev := new(EnumValue)
-	if err := json.Unmarshal([]byte(raw.ToString()), ev); err != nil {
+	if err := src.UnmarshalAny(raw, ev); err != nil {
 		logger.Errorf("Failed to unmarshal EnumValue: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal EnumValue: [%v]", err)
 	}
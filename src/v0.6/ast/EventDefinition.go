@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -31,9 +31,9 @@ func (ed *EventDefinition) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 		default:
 			if parameters != nil {
-				logger.Warnf("Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parameters.Type(), ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parameters.Type(), ed.Src)
 			} else {
-				logger.Warnf("Unknown parameters nodeType for EventDefinition [src:%s].", ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType for EventDefinition [src:%s].", ed.Src)
 			}
 		}
 	}
@@ -59,7 +59,7 @@ func (ed *EventDefinition) NodeID() int {
 
 func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*EventDefinition, error) {
 	ed := new(EventDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), ed); err != nil {
+	if err := src.UnmarshalAny(raw, ed); err != nil {
 		logger.Errorf("Failed to unmarshal EventDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal EventDefinition: [%v]", err)
 	}
@@ -75,6 +75,7 @@ func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 			edParameters, err = GetParameterList(gn, parameters, logger)
 		default:
 			logger.Warnf("Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parametersNodeType, ed.Src)
+			gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -89,14 +90,3 @@ func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 	return ed, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (ed *EventDefinition) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if ed.parameters != nil {
-		switch parameters := ed.parameters.(type) {
-		case *ParameterList:
-			parameters.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,9 +37,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + initializationExpression.SourceCode(false, false, indent, logger)
 		default:
 			if initializationExpression != nil {
-				logger.Warnf("Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpression.Type(), fs.Src)
+				src.Dropf(logger, "Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpression.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown initializationExpression nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown initializationExpression nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -52,9 +52,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + " " + condition.SourceCode(false, false, indent, logger)
 		default:
 			if condition != nil {
-				logger.Warnf("Unknown condition nodeType [%s] for ForStatement [src:%s].", condition.Type(), fs.Src)
+				src.Dropf(logger, "Unknown condition nodeType [%s] for ForStatement [src:%s].", condition.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown condition nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown condition nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -67,9 +67,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + " " + loopExpression.SourceCode(false, false, indent, logger)
 		default:
 			if loopExpression != nil {
-				logger.Warnf("Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpression.Type(), fs.Src)
+				src.Dropf(logger, "Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpression.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown loopExpression nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown loopExpression nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -82,9 +82,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + body.SourceCode(false, false, indent, logger)
 		default:
 			if body != nil {
-				logger.Warnf("Unknown body nodeType [%s] for ForStatement [src:%s].", body.Type(), fs.Src)
+				src.Dropf(logger, "Unknown body nodeType [%s] for ForStatement [src:%s].", body.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown body nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown body nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -114,7 +114,7 @@ func (fs *ForStatement) NodeID() int {
 
 func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ForStatement, error) {
 	fs := new(ForStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), fs); err != nil {
+	if err := src.UnmarshalAny(raw, fs); err != nil {
 		logger.Errorf("Failed to unmarshal ForStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ForStatement: [%v]", err)
 	}
@@ -132,6 +132,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsInitializationExpression, err = GetVariableDeclarationStatement(gn, initializationExpression, logger)
 			default:
 				logger.Warnf("Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpressionNodeType, fs.Src)
+				gn.AddUnknownNode(initializationExpressionNodeType, initializationExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -157,6 +158,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsCondition, err = GetBinaryOperation(gn, condition, logger)
 			default:
 				logger.Warnf("Unknown condition nodeType [%s] for ForStatement [src:%s].", conditionNodeType, fs.Src)
+				gn.AddUnknownNode(conditionNodeType, condition.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -182,6 +184,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsLoopExpression, err = GetExpressionStatement(gn, loopExpression, logger)
 			default:
 				logger.Warnf("Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpressionNodeType, fs.Src)
+				gn.AddUnknownNode(loopExpressionNodeType, loopExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -207,6 +210,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsBody, err = GetBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for ForStatement [src:%s].", bodyNodeType, fs.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -223,111 +227,3 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 	return fs, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (fs *ForStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if fs.initializationExpression != nil {
-		switch initializationExpression := fs.initializationExpression.(type) {
-		case *VariableDeclarationStatement:
-			initializationExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (fs *ForStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseTaintOwner(opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
-	}
-}
-
-func (fs *ForStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if fs.initializationExpression != nil {
-		switch initializationExpression := fs.initializationExpression.(type) {
-		case *VariableDeclarationStatement:
-			initializationExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			condition.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
-func (fs *ForStatement) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	if fs.initializationExpression != nil {
-		switch initializationExpression := fs.initializationExpression.(type) {
-		case *VariableDeclarationStatement:
-			initializationExpression.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			condition.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-}
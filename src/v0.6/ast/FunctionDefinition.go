@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -48,9 +48,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -71,9 +71,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + " " + m.SourceCode(false, false, indent, logger)
 				default:
 					if m != nil {
-						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 
@@ -95,9 +95,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + " " + overrides.SourceCode(false, false, indent, logger)
 			default:
 				if overrides != nil {
-					logger.Warnf("Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overrides.Type(), fd.Src)
+					src.Dropf(logger, "Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overrides.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown overrides nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown overrides nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 		}
@@ -116,9 +116,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				}
 			default:
 				if returnParameters != nil {
-					logger.Warnf("Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParameters.Type(), fd.Src)
+					src.Dropf(logger, "Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParameters.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown returnParameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown returnParameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 		}
@@ -133,9 +133,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -151,9 +151,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + " " + m.SourceCode(false, false, indent, logger)
 				default:
 					if m != nil {
-						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 
@@ -179,9 +179,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -226,9 +226,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for FunctionDefinition [src:%s].", body.Type(), fd.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for FunctionDefinition [src:%s].", body.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown body nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown body nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 
@@ -265,7 +265,7 @@ func (fd *FunctionDefinition) NodeID() int {
 
 func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionDefinition, error) {
 	fd := new(FunctionDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), fd); err != nil {
+	if err := src.UnmarshalAny(raw, fd); err != nil {
 		logger.Errorf("Failed to unmarshal FunctionDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal FunctionDefinition: [%v]", err)
 	}
@@ -288,6 +288,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 						fdModifier, err = GetModifierInvocation(gn, modifier, logger)
 					default:
 						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", modifierNodeType, fd.Src)
+						gn.AddUnknownNode(modifierNodeType, modifier.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -317,6 +318,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdBody, err = GetBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for FunctionDefinition [src:%s].", bodyNodeType, fd.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -342,6 +344,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdOverrides, err = GetOverrideSpecifier(gn, overrides, logger)
 			default:
 				logger.Warnf("Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overridesNodeType, fd.Src)
+				gn.AddUnknownNode(overridesNodeType, overrides.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -367,6 +370,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parametersNodeType, fd.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -392,6 +396,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdReturnParameters, err = GetParameterList(gn, returnParameters, logger)
 			default:
 				logger.Warnf("Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParametersNodeType, fd.Src)
+				gn.AddUnknownNode(returnParametersNodeType, returnParameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -419,6 +424,8 @@ func (fd *FunctionDefinition) MakeSignature(contractName string, logger logging.
 		signature = signature + "." + "constructor"
 	} else if fd.Kind == "receive" {
 		signature = signature + "." + "receive"
+	} else if fd.Kind == "fallback" {
+		signature = signature + "." + "fallback"
 	}
 
 	signature = signature + "("
@@ -445,25 +452,18 @@ func (fd *FunctionDefinition) Signature() string {
 	return fd.signature
 }
 
+// TraverseFunctionCall 把函数体中的每个函数调用记录为 ncp 的被调用者，并通过 opt 报告其中的 delegatecall。
 func (fd *FunctionDefinition) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
 	ncp.SetCaller(fd.Signature(), fd.NodeID())
 
 	// Function call statements are generally inside functions.
 	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (fd *FunctionDefinition) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	opt.TrackFunctionDefinitionName = fd.Signature()
-	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
+		Inspect(fd.body, func(node ASTNode) bool {
+			if fc, ok := node.(*FunctionCall); ok {
+				fc.TraverseFunctionCall(ncp, gn, opt, logger)
+			}
+			return true
+		})
 	}
 }
 
@@ -474,23 +474,3 @@ func (fd *FunctionDefinition) SetBody(body ASTNode) {
 func (fd *FunctionDefinition) SetReturnParameters(returnParameters ASTNode) {
 	fd.returnParameters = returnParameters
 }
-
-func (fd *FunctionDefinition) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	opt.TrackFunctionDefinitionName = fd.Signature()
-	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
-func (fd *FunctionDefinition) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	opt.TrackFunctionDefinitionName = fd.Signature()
-	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseIndirectDelegatecall(opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -49,9 +49,9 @@ func (ma *MemberAccess) SourceCode(isSc bool, isIndent bool, indent string, logg
 				code = code + expression.SourceCode(false, false, indent, logger)
 			default:
 				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for MemberAccess [src:%s].", expression.Type(), ma.Src)
+					src.Dropf(logger, "Unknown expression nodeType [%s] for MemberAccess [src:%s].", expression.Type(), ma.Src)
 				} else {
-					logger.Warnf("Unknown expression nodeType for MemberAccess [src:%s].", ma.Src)
+					src.Dropf(logger, "Unknown expression nodeType for MemberAccess [src:%s].", ma.Src)
 				}
 			}
 		}
@@ -84,7 +84,7 @@ func (ma *MemberAccess) NodeID() int {
 
 func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*MemberAccess, error) {
 	ma := new(MemberAccess)
-	if err := json.Unmarshal([]byte(raw.ToString()), ma); err != nil {
+	if err := src.UnmarshalAny(raw, ma); err != nil {
 		logger.Errorf("Failed to unmarshal MemberAccess: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal MemberAccess: [%v]", err)
 	}
@@ -108,6 +108,7 @@ func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				maExpression, err = GetMemberAccess(gn, expression, logger)
 			default:
 				logger.Warnf("Unknown expression nodeType [%s] for MemberAccess [src:%s].", expressionNodeType, ma.Src)
+				gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -124,49 +125,3 @@ func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 	return ma, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (ma *MemberAccess) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *IndexAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *FunctionCall:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (ma *MemberAccess) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *FunctionCall:
-				expression.TraverseDelegatecall(opt, logger)
-			case *MemberAccess:
-				expression.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
-
-func (ma *MemberAccess) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *FunctionCall:
-				expression.TraverseIndirectDelegatecall(opt, logger)
-			case *MemberAccess:
-				expression.TraverseIndirectDelegatecall(opt, logger)
-			}
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,9 +37,9 @@ func (md *ModifierDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 			default:
 				if parameters != nil {
-					logger.Warnf("Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parameters.Type(), md.Src)
+					src.Dropf(logger, "Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parameters.Type(), md.Src)
 				} else {
-					logger.Warnf("Unknown parameters nodeType for ModifierDefinition [src:%s].", md.Src)
+					src.Dropf(logger, "Unknown parameters nodeType for ModifierDefinition [src:%s].", md.Src)
 				}
 			}
 		}
@@ -55,7 +55,7 @@ func (md *ModifierDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for ModifierDefinition [src:%s].", body.Type(), md.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for ModifierDefinition [src:%s].", body.Type(), md.Src)
 				} else {
 					logger.Errorf("Unknown body nodeType for ModifierDefinition [src:%s].", md.Src)
 				}
@@ -88,7 +88,7 @@ func (md *ModifierDefinition) NodeID() int {
 
 func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ModifierDefinition, error) {
 	md := new(ModifierDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), md); err != nil {
+	if err := src.UnmarshalAny(raw, md); err != nil {
 		logger.Errorf("Failed to unmarshal ModifierDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ModifierDefinition: [%v]", err)
 	}
@@ -106,6 +106,7 @@ func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				mdParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parametersNodeType, md.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -131,6 +132,7 @@ func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				mdBody, err = GetBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for ModifierDefinition [src:%s].", bodyNodeType, md.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -29,9 +29,9 @@ func (pl *ParameterList) SourceCode(isSc bool, isIndent bool, indent string, log
 				code = code + p.SourceCode(false, false, indent, logger)
 			default:
 				if p != nil {
-					logger.Warnf("Unknown parameter nodeType [%s] for ParameterList [src:%s].", p.Type(), pl.Src)
+					src.Dropf(logger, "Unknown parameter nodeType [%s] for ParameterList [src:%s].", p.Type(), pl.Src)
 				} else {
-					logger.Warnf("Unknown parameter nodeType for ParameterList [src:%s].", pl.Src)
+					src.Dropf(logger, "Unknown parameter nodeType for ParameterList [src:%s].", pl.Src)
 				}
 			}
 			if index < len(pl.parameters)-1 {
@@ -61,7 +61,7 @@ func (pl *ParameterList) NodeID() int {
 
 func GetParameterList(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ParameterList, error) {
 	pl := new(ParameterList)
-	if err := json.Unmarshal([]byte(raw.ToString()), pl); err != nil {
+	if err := src.UnmarshalAny(raw, pl); err != nil {
 		logger.Errorf("Failed to unmarshal ParameterList: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ParameterList: [%v]", err)
 	}
@@ -82,6 +82,7 @@ func GetParameterList(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 			plParameter, err = GetVariableDeclaration(gn, parameter, logger)
 		default:
 			logger.Warnf("Unknown parameter nodeType [%s] for ParameterList [src:%s].", parameterNodeType, pl.Src)
+			gn.AddUnknownNode(parameterNodeType, parameter.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -97,21 +98,10 @@ func GetParameterList(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 	return pl, nil
 }
 
-func (pl *ParameterList) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(pl.parameters) > 0 {
-		for _, parameter := range pl.parameters {
-			switch p := parameter.(type) {
-			case *VariableDeclaration:
-				p.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (pl *ParameterList) AppendParameter(parameter ASTNode) {
 	if pl.parameters == nil {
 		pl.parameters = make([]ASTNode, 0)
 	}
 
 	pl.parameters = append(pl.parameters, parameter)
-}
\ No newline at end of file
+}
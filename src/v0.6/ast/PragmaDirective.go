@@ -1,12 +1,12 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -55,7 +55,7 @@ func (pd *PragmaDirective) NodeID() int {
 
 func GetPragmaDirective(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*PragmaDirective, error) {
 	pd := new(PragmaDirective)
-	if err := json.Unmarshal([]byte(raw.ToString()), pd); err != nil {
+	if err := src.UnmarshalAny(raw, pd); err != nil {
 		logger.Errorf("Failed to unmarshal PragmaDirective: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal PragmaDirective: [%v]", err)
 	}
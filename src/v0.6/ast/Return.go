@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -47,9 +47,9 @@ func (r *Return) SourceCode(isSc bool, isIndent bool, indent string, logger logg
 				code = code + " " + expression.SourceCode(false, false, indent, logger)
 			default:
 				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for Return [src:%s].", expression.Type(), r.Src)
+					src.Dropf(logger, "Unknown expression nodeType [%s] for Return [src:%s].", expression.Type(), r.Src)
 				} else {
-					logger.Warnf("Unknown expression nodeType for Return [src:%s].", r.Src)
+					src.Dropf(logger, "Unknown expression nodeType for Return [src:%s].", r.Src)
 				}
 			}
 		}
@@ -76,7 +76,7 @@ func (r *Return) NodeID() int {
 
 func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Return, error) {
 	r := new(Return)
-	if err := json.Unmarshal([]byte(raw.ToString()), r); err != nil {
+	if err := src.UnmarshalAny(raw, r); err != nil {
 		logger.Errorf("Failed to unmarshal Return: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Return: [%v]", err)
 	}
@@ -108,6 +108,7 @@ func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Retur
 				rExpression, err = GetTupleExpression(gn, expression, logger)
 			default:
 				logger.Warnf("Unknown expression nodeType [%s] for Return [src:%s].", expressionNodeType, r.Src)
+				gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -127,32 +128,6 @@ func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Retur
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (r *Return) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if r.expression != nil {
-			switch expression := r.expression.(type) {
-			case *IndexAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			// case *Conditional:
-			// 	expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *BinaryOperation:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *FunctionCall:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *TupleExpression:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (r *Return) SetExpression(expression ASTNode) {
 	r.expression = expression
 }
-
-func (r *Return) TraverseIndirectDelegatecall(opt *Option, logger logging.Logger) {
-	
-}
\ No newline at end of file
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -31,7 +31,7 @@ func (su *SourceUnit) SourceCode(isSc bool, isIndent bool, indent string, logger
 		case "VariableDeclaration":
 			code = code + node.SourceCode(true, false, indent, logger) + "\n"
 		default:
-			logger.Warnf("Unknown node nodeType [%s] for SourceUnit [src:%s].", node.Type(), su.Src)
+			src.Dropf(logger, "Unknown node nodeType [%s] for SourceUnit [src:%s].", node.Type(), su.Src)
 		}
 	}
 
@@ -58,8 +58,14 @@ func (su *SourceUnit) AppendNode(node ASTNode) {
 }
 
 func GetSourceUnit(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*SourceUnit, error) {
+	raw, err := src.Preload(raw)
+	if err != nil {
+		logger.Errorf("Failed to load SourceUnit: [%v].", err)
+		return nil, fmt.Errorf("failed to load SourceUnit: [%v]", err)
+	}
+
 	su := new(SourceUnit)
-	if err := json.Unmarshal([]byte(raw.ToString()), su); err != nil {
+	if err := src.UnmarshalAny(raw, su); err != nil {
 		logger.Errorf("Failed to unmarshal SourceUnit: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal SourceUnit: [%v]", err)
 	}
@@ -95,6 +101,7 @@ func GetSourceUnit(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*S
 			su.AppendNode(variableDeclaration)
 		default:
 			logger.Warnf("Unknown node nodeType [%s] for SourceUnit [src:%s].", sourceUnitChildType, su.Src)
+			gn.AddUnknownNode(sourceUnitChildType, sourceUnitChild.Get("src").ToString())
 		}
 
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -36,9 +36,9 @@ func (sd *StructDefinition) SourceCode(isSc bool, isIndent bool, indent string,
 				code = code + m.SourceCode(true, true, indent+"    ", logger)
 			default:
 				if m != nil {
-					logger.Warnf("Unknown member nodeType [%s] for StructDefinition [src:%s].", m.Type(), sd.Src)
+					src.Dropf(logger, "Unknown member nodeType [%s] for StructDefinition [src:%s].", m.Type(), sd.Src)
 				} else {
-					logger.Warnf("Unknown member nodeType for StructDefinition [src:%s].", sd.Src)
+					src.Dropf(logger, "Unknown member nodeType for StructDefinition [src:%s].", sd.Src)
 				}
 			}
 			code = code + "\n"
@@ -67,7 +67,7 @@ func (sd *StructDefinition) NodeID() int {
 
 func GetStructDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*StructDefinition, error) {
 	sd := new(StructDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), sd); err != nil {
+	if err := src.UnmarshalAny(raw, sd); err != nil {
 		logger.Errorf("Failed to unmarshal StructDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal StructDefinition: [%v]", err)
 	}
@@ -90,6 +90,7 @@ func GetStructDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logge
 						sdMember, err = GetVariableDeclaration(gn, member, logger)
 					default:
 						logger.Warnf("Unknown member nodeType [%s] for StructDefinition [src:%s].", memberNodeType, sd.Src)
+						gn.AddUnknownNode(memberNodeType, member.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -108,3 +109,7 @@ func GetStructDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logge
 
 	return sd, nil
 }
+
+func (sd *StructDefinition) AppendMember(member ASTNode) {
+	sd.members = append(sd.members, member)
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -59,9 +59,9 @@ func (te *TupleExpression) SourceCode(isSc bool, isIndent bool, indent string, l
 				code = code + c.SourceCode(false, false, indent, logger)
 			default:
 				if c != nil {
-					logger.Warnf("Unknown component nodeType [%s] for TupleExpression [src:%s].", c.Type(), te.Src)
+					src.Dropf(logger, "Unknown component nodeType [%s] for TupleExpression [src:%s].", c.Type(), te.Src)
 				} else {
-					logger.Warnf("Unknown component nodeType for TupleExpression [src:%s].", te.Src)
+					src.Dropf(logger, "Unknown component nodeType for TupleExpression [src:%s].", te.Src)
 				}
 			}
 			if index < len(te.components)-1 {
@@ -95,7 +95,7 @@ func (te *TupleExpression) NodeID() int {
 
 func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*TupleExpression, error) {
 	te := new(TupleExpression)
-	if err := json.Unmarshal([]byte(raw.ToString()), te); err != nil {
+	if err := src.UnmarshalAny(raw, te); err != nil {
 		logger.Errorf("Failed to unmarshal TupleExpression: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal TupleExpression: [%v]", err)
 	}
@@ -134,6 +134,7 @@ func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 						teComponent, err = GetFunctionCall(gn, component, logger)
 					default:
 						logger.Warnf("Unknown component nodeType [%s] for TupleExpression [src:%s].", componentNodeType, te.Src)
+						gn.AddUnknownNode(componentNodeType, component.Get("src").ToString())
 					}
 				} else {
 					teComponent = &Literal{
@@ -160,16 +161,3 @@ func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 	return te, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (te *TupleExpression) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(te.components) > 0 {
-		for _, component := range te.components {
-			switch c := component.(type) {
-			case *BinaryOperation:
-				c.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
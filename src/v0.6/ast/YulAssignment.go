@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -31,9 +31,9 @@ func (ya *YulAssignment) SourceCode(isSc bool, isIndent bool, indent string, log
 					code = code + variable.SourceCode(false, false, indent, logger)
 				default:
 					if variable != nil {
-						logger.Warnf("Unknown variable nodeType [%s] for YulAssignment [src:%s].", variable.Type(), ya.Src)
+						src.Dropf(logger, "Unknown variable nodeType [%s] for YulAssignment [src:%s].", variable.Type(), ya.Src)
 					} else {
-						logger.Warnf("Unknown variable nodeType for YulAssignment [src:%s].", ya.Src)
+						src.Dropf(logger, "Unknown variable nodeType for YulAssignment [src:%s].", ya.Src)
 					}
 				}
 				if index < len(ya.variableNames)-1 {
@@ -57,9 +57,9 @@ func (ya *YulAssignment) SourceCode(isSc bool, isIndent bool, indent string, log
 				code = code + value.SourceCode(false, false, indent, logger)
 			default:
 				if value != nil {
-					logger.Warnf("Unknown value nodeType [%s] for YulAssignment [src:%s].", value.Type(), ya.Src)
+					src.Dropf(logger, "Unknown value nodeType [%s] for YulAssignment [src:%s].", value.Type(), ya.Src)
 				} else {
-					logger.Warnf("Unknown value nodeType for YulAssignment [src:%s].", ya.Src)
+					src.Dropf(logger, "Unknown value nodeType for YulAssignment [src:%s].", ya.Src)
 				}
 			}
 		}
@@ -82,7 +82,7 @@ func (ya *YulAssignment) NodeID() int {
 
 func GetYulAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulAssignment, error) {
 	ya := new(YulAssignment)
-	if err := json.Unmarshal([]byte(raw.ToString()), ya); err != nil {
+	if err := src.UnmarshalAny(raw, ya); err != nil {
 		logger.Errorf("Failed to unmarshal YulAssignment: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulAssignment: [%v]", err)
 	}
@@ -103,6 +103,7 @@ func GetYulAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 						yaVariableName, err = GetYulIdentifier(gn, variableName, logger)
 					default:
 						logger.Warnf("Unknown variableName [%s] for YulIdentifier [src:%s].", variableNameNodeType, ya.Src)
+						gn.AddUnknownNode(variableNameNodeType, variableName.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -134,6 +135,7 @@ func GetYulAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				yaValue, err = GetYulIdentifier(gn, value, logger)
 			default:
 				logger.Warnf("Unknown value nodeType [%s] for YulAssignment [src:%s].", valueNodeType, ya.Src)
+				gn.AddUnknownNode(valueNodeType, value.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -148,3 +150,11 @@ func GetYulAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return ya, nil
 }
+
+func (ya *YulAssignment) AppendVariableName(variableName ASTNode) {
+	ya.variableNames = append(ya.variableNames, variableName)
+}
+
+func (ya *YulAssignment) SetValue(value ASTNode) {
+	ya.value = value
+}
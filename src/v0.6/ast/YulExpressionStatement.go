@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -26,9 +26,9 @@ func (yes *YulExpressionStatement) SourceCode(isSc bool, isIndent bool, indent s
 			code = code + expression.SourceCode(false, false, indent, logger)
 		default:
 			if expression != nil {
-				logger.Warnf("Unknown expression nodeType [%s] for YulExpressionStatement [src:%s].", expression.Type(), yes.Src)
+				src.Dropf(logger, "Unknown expression nodeType [%s] for YulExpressionStatement [src:%s].", expression.Type(), yes.Src)
 			} else {
-				logger.Warnf("Unknown expression nodeType for YulExpressionStatement [src:%s].", yes.Src)
+				src.Dropf(logger, "Unknown expression nodeType for YulExpressionStatement [src:%s].", yes.Src)
 			}
 		}
 	}
@@ -50,7 +50,7 @@ func (yes *YulExpressionStatement) NodeID() int {
 
 func GetYulExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulExpressionStatement, error) {
 	yes := new(YulExpressionStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), yes); err != nil {
+	if err := src.UnmarshalAny(raw, yes); err != nil {
 		logger.Errorf("Failed to unmarshal YulExpressionStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulExpressionStatement: [%v]", err)
 	}
@@ -66,6 +66,7 @@ func GetYulExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging
 			yesExpression, err = GetYulFunctionCall(gn, expression, logger)
 		default:
 			logger.Warnf("Unknown expression nodeType [%s] for YulExpressionStatement [src:%s].", expressionNodeType, yes.Src)
+			gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 		}
 
 		if err != nil {
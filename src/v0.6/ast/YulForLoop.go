@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -33,9 +33,9 @@ func (yfl *YulForLoop) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + pre.SourceCode(false, false, indent, logger)
 			default:
 				if pre != nil {
-					logger.Warnf("Unknown pre nodeType [%s] for YulForLoop [src:%s].", pre.Type(), yfl.Src)
+					src.Dropf(logger, "Unknown pre nodeType [%s] for YulForLoop [src:%s].", pre.Type(), yfl.Src)
 				} else {
-					logger.Warnf("Unknown pre nodeType for YulForLoop [src:%s].", yfl.Src)
+					src.Dropf(logger, "Unknown pre nodeType for YulForLoop [src:%s].", yfl.Src)
 				}
 			}
 		}
@@ -57,9 +57,9 @@ func (yfl *YulForLoop) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + " " + condition.SourceCode(false, false, indent, logger)
 			default:
 				if condition != nil {
-					logger.Warnf("Unknown condition nodeType [%s] for YulForLoop [src:%s].", condition.Type(), yfl.Src)
+					src.Dropf(logger, "Unknown condition nodeType [%s] for YulForLoop [src:%s].", condition.Type(), yfl.Src)
 				} else {
-					logger.Warnf("Unknown condition nodeType for YulForLoop [src:%s].", yfl.Src)
+					src.Dropf(logger, "Unknown condition nodeType for YulForLoop [src:%s].", yfl.Src)
 				}
 			}
 		}
@@ -75,9 +75,9 @@ func (yfl *YulForLoop) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + post.SourceCode(false, false, indent, logger)
 			default:
 				if post != nil {
-					logger.Warnf("Unknown post nodeType [%s] for YulForLoop [src:%s].", post.Type(), yfl.Src)
+					src.Dropf(logger, "Unknown post nodeType [%s] for YulForLoop [src:%s].", post.Type(), yfl.Src)
 				} else {
-					logger.Warnf("Unknown post nodeType for YulForLoop [src:%s].", yfl.Src)
+					src.Dropf(logger, "Unknown post nodeType for YulForLoop [src:%s].", yfl.Src)
 				}
 			}
 		}
@@ -97,9 +97,9 @@ func (yfl *YulForLoop) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for YulForLoop [src:%s].", body.Type(), yfl.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for YulForLoop [src:%s].", body.Type(), yfl.Src)
 				} else {
-					logger.Warnf("Unknown body nodeType for YulForLoop [src:%s].", yfl.Src)
+					src.Dropf(logger, "Unknown body nodeType for YulForLoop [src:%s].", yfl.Src)
 				}
 			}
 		}
@@ -128,7 +128,7 @@ func (yfl *YulForLoop) NodeID() int {
 
 func GetYulForLoop(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulForLoop, error) {
 	yfl := new(YulForLoop)
-	if err := json.Unmarshal([]byte(raw.ToString()), yfl); err != nil {
+	if err := src.UnmarshalAny(raw, yfl); err != nil {
 		logger.Errorf("Failed to unmarshal YulForLoop: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulForLoop: [%v]", err)
 	}
@@ -146,6 +146,7 @@ func GetYulForLoop(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Y
 				yflBody, err = GetYulBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for YulForLoop [src:%s].", bodyNodeType, yfl.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -173,6 +174,7 @@ func GetYulForLoop(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Y
 				yflCondition, err = GetYulLiteral(gn, condition, logger)
 			default:
 				logger.Warnf("Unknown condition nodeType [%s] for YulForLoop [src:%s].", conditionNodeType, yfl.Src)
+				gn.AddUnknownNode(conditionNodeType, condition.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -198,6 +200,7 @@ func GetYulForLoop(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Y
 				yflPost, err = GetYulBlock(gn, post, logger)
 			default:
 				logger.Warnf("Unknown post nodeType [%s] for YulForLoop [src:%s].", postNodeType, yfl.Src)
+				gn.AddUnknownNode(postNodeType, post.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -223,6 +226,7 @@ func GetYulForLoop(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Y
 				yflPre, err = GetYulBlock(gn, pre, logger)
 			default:
 				logger.Warnf("Unknown pre nodeType [%s] for YulForLoop [src:%s].", preNodeType, yfl.Src)
+				gn.AddUnknownNode(preNodeType, pre.Get("src").ToString())
 			}
 
 			if err != nil {
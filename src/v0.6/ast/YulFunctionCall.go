@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -29,9 +29,9 @@ func (yfc *YulFunctionCall) SourceCode(isSc bool, isIndent bool, indent string,
 				code = code + functionName.SourceCode(false, false, indent, logger)
 			default:
 				if functionName != nil {
-					logger.Warnf("Unknown functionName nodeType [%s] for YulFunctionCall [src:%s].", functionName.Type(), yfc.Src)
+					src.Dropf(logger, "Unknown functionName nodeType [%s] for YulFunctionCall [src:%s].", functionName.Type(), yfc.Src)
 				} else {
-					logger.Warnf("Unknown functionName nodeType for YulFunctionCall [src:%s].", yfc.Src)
+					src.Dropf(logger, "Unknown functionName nodeType for YulFunctionCall [src:%s].", yfc.Src)
 				}
 			}
 		}
@@ -52,9 +52,9 @@ func (yfc *YulFunctionCall) SourceCode(isSc bool, isIndent bool, indent string,
 					code = code + arg.SourceCode(false, false, indent, logger)
 				default:
 					if arg != nil {
-						logger.Warnf("Unknown argument nodeType [%s] for YulFunctionCall [src:%s].", arg.Type(), yfc.Src)
+						src.Dropf(logger, "Unknown argument nodeType [%s] for YulFunctionCall [src:%s].", arg.Type(), yfc.Src)
 					} else {
-						logger.Warnf("Unknown argument nodeType for YulFunctionCall [src:%s].", yfc.Src)
+						src.Dropf(logger, "Unknown argument nodeType for YulFunctionCall [src:%s].", yfc.Src)
 					}
 				}
 
@@ -84,7 +84,7 @@ func (yfc *YulFunctionCall) NodeID() int {
 
 func GetYulFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulFunctionCall, error) {
 	yfc := new(YulFunctionCall)
-	if err := json.Unmarshal([]byte(raw.ToString()), yfc); err != nil {
+	if err := src.UnmarshalAny(raw, yfc); err != nil {
 		logger.Errorf("Failed to unmarshal YulFunctionCall: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulFunctionCall: [%v]", err)
 	}
@@ -110,6 +110,7 @@ func GetYulFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 					yfcArgument, err = GetYulFunctionCall(gn, argument, logger)
 				default:
 					logger.Warnf("Unknown argument nodeType [%s] for YulFunctionCall [src:%s].", argumentNodeType, yfc.Src)
+					gn.AddUnknownNode(argumentNodeType, argument.Get("src").ToString())
 				}
 
 				if err != nil {
@@ -136,6 +137,7 @@ func GetYulFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				fn, err = GetYulIdentifier(gn, functionName, logger)
 			default:
 				logger.Warnf("Unknown functionName nodeType [%s] for YulIdentifier [src:%s].", functionNameNodeType, yfc.Src)
+				gn.AddUnknownNode(functionNameNodeType, functionName.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -40,7 +40,7 @@ func (yi *YulIdentifier) NodeID() int {
 
 func GetYulIdentifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulIdentifier, error) {
 	yi := new(YulIdentifier)
-	if err := json.Unmarshal([]byte(raw.ToString()), yi); err != nil {
+	if err := src.UnmarshalAny(raw, yi); err != nil {
 		logger.Errorf("Failed to unmarshal YulIdentifier: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulIdentifier: [%v]", err)
 	}
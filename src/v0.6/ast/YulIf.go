@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -35,9 +35,9 @@ func (yi *YulIf) SourceCode(isSc bool, isIndent bool, indent string, logger logg
 				code = code + " " + condition.SourceCode(false, false, indent, logger)
 			default:
 				if condition != nil {
-					logger.Warnf("Unknown condition nodeType [%s] for YulIf [src:%s].", condition.Type(), yi.Src)
+					src.Dropf(logger, "Unknown condition nodeType [%s] for YulIf [src:%s].", condition.Type(), yi.Src)
 				} else {
-					logger.Warnf("Unknown condition nodeType for YulIf [src:%s].", yi.Src)
+					src.Dropf(logger, "Unknown condition nodeType for YulIf [src:%s].", yi.Src)
 				}
 			}
 		}
@@ -53,9 +53,9 @@ func (yi *YulIf) SourceCode(isSc bool, isIndent bool, indent string, logger logg
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for YulIf [src:%s].", body.Type(), yi.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for YulIf [src:%s].", body.Type(), yi.Src)
 				} else {
-					logger.Warnf("Unknown body nodeType for YulIf [src:%s].", yi.Src)
+					src.Dropf(logger, "Unknown body nodeType for YulIf [src:%s].", yi.Src)
 				}
 			}
 		}
@@ -84,7 +84,7 @@ func (yi *YulIf) NodeID() int {
 
 func GetYulIf(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulIf, error) {
 	yi := new(YulIf)
-	if err := json.Unmarshal([]byte(raw.ToString()), yi); err != nil {
+	if err := src.UnmarshalAny(raw, yi); err != nil {
 		logger.Errorf("Failed to unmarshal YulIf: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulIf: [%v]", err)
 	}
@@ -106,6 +106,7 @@ func GetYulIf(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulIf,
 				yiCondition, err = GetYulIdentifier(gn, condition, logger)
 			default:
 				logger.Warnf("Unknown condition nodeType [%s] for YulIf [src:%s].", conditionNodeType, yi.Src)
+				gn.AddUnknownNode(conditionNodeType, condition.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -131,6 +132,7 @@ func GetYulIf(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulIf,
 				yiBody, err = GetYulBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for YulIf [src:%s].", bodyNodeType, yi.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -0,0 +1,35 @@
+package ast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func BenchmarkGetSourceUnit(b *testing.B) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "..", "contracts", "v0.6", "*.sol_json.ast"))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	logger := src.SilentLogger
+
+	for _, fixture := range fixtures {
+		jsonBytes, err := os.ReadFile(fixture)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(filepath.Base(fixture), func(b *testing.B) {
+			b.SetBytes(int64(len(jsonBytes)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := GetSourceUnit(NewGlobalNodes(), jsoniter.Get(jsonBytes), logger); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package v05
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/v0.6/ast"
+)
+
+func init() {
+	Register(delegatecallUnknownTarget{})
+	Register(delegatecallIndirectTarget{})
+	Register(delegatecallOwnerSlotCollision{})
+}
+
+// delegatecallUnknownTarget 检测 delegatecall 到未知地址的函数，并为合约插入 owner 的追踪与校验代码。
+type delegatecallUnknownTarget struct{}
+
+func (delegatecallUnknownTarget) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-unknown-target",
+		Description: "Delegatecall to an address that is not a known contract, the callee can overwrite the owner of the caller.",
+		Severity:    src.SeverityHigh,
+		Confidence:  src.ConfidenceMedium,
+	}
+}
+
+func (d delegatecallUnknownTarget) Run(ctx *AnalysisContext) {
+	if !ctx.UnknownDelegatecall {
+		return
+	}
+	contract := ctx.Contract
+	if contract == nil {
+		ctx.Logger.Warnf("Free function [%s] uses delegatecall, there is no contract to instrument.", ctx.Function.Signature())
+		return
+	}
+	ctx.Logger.Infof("Contract [%s] may should be instrumented directly, because it delegatecall to unknown contract.", contract.Name)
+	finding := ctx.Report(d.Info(), contract.Name, "delegatecall to an address that is not a known contract")
+	guard(ctx, finding, contract, "the target of the delegatecall is not a known contract and the callee runs with the storage of the caller", InstrumentCodeForAssert)
+}
+
+// delegatecallIndirectTarget 检测间接 delegatecall 到未知地址的函数，并为合约插入 owner 的追踪与校验代码。
+type delegatecallIndirectTarget struct{}
+
+func (delegatecallIndirectTarget) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-indirect-target",
+		Description: "Indirect delegatecall to an address that is not a known contract, the callee can overwrite the owner of the caller.",
+		Severity:    src.SeverityHigh,
+		Confidence:  src.ConfidenceLow,
+	}
+}
+
+func (d delegatecallIndirectTarget) Run(ctx *AnalysisContext) {
+	if !ctx.IndirectDelegatecall {
+		return
+	}
+	contract := ctx.Contract
+	if contract == nil {
+		ctx.Logger.Warnf("Free function [%s] uses delegatecall, there is no contract to instrument.", ctx.Function.Signature())
+		return
+	}
+	ctx.Logger.Infof("Contract [%s] may should be instrumented directly, because it delegatecall to unknown contract.", contract.Name)
+	finding := ctx.Report(d.Info(), contract.Name, "indirect delegatecall to an address that is not a known contract")
+	guard(ctx, finding, contract, "the target of the indirect delegatecall is not a known contract and the callee runs with the storage of the caller", InsertAssertCode)
+}
+
+// delegatecallOwnerSlotCollision 检测被 delegatecall 调用的合约中 owner 变量是否与调用者的同一个 storage 槽重合。
+type delegatecallOwnerSlotCollision struct{}
+
+func (delegatecallOwnerSlotCollision) Info() *src.DetectorInfo {
+	return &src.DetectorInfo{
+		ID:          "delegatecall-owner-slot-collision",
+		Description: "Delegatecall to a known contract whose owner variable shares a storage slot with the caller.",
+		Severity:    src.SeverityMedium,
+		Confidence:  src.ConfidenceHigh,
+	}
+}
+
+func (d delegatecallOwnerSlotCollision) Run(ctx *AnalysisContext) {
+	if ctx.KnownDelegatecall == 0 {
+		return
+	}
+	callerContract := ctx.Contract
+	if callerContract == nil {
+		ctx.Logger.Warnf("Free function [%s] uses delegatecall, there is no contract to instrument.", ctx.Function.Signature())
+		return
+	}
+	calleeContract, ok := ctx.GlobalNodes.ContractsByID()[ctx.KnownDelegatecall].(*ast.ContractDefinition)
+	if !ok {
+		ctx.Logger.Warnf("Contract [%d] called by delegatecall is not defined in [%s].", ctx.KnownDelegatecall, ctx.SolFileName)
+		return
+	}
+	if ctx.Settings.Trusted(calleeContract.Name) {
+		ctx.Logger.Infof("Contract [%s] called by delegatecall in [%s] is a trusted target.", calleeContract.Name, ctx.Function.Signature())
+		return
+	}
+	if !VerifyVariableDeclarationOrder(callerContract, calleeContract, ctx.GlobalNodes, ctx.Settings.Variables) {
+		ctx.Logger.Debug("No instrumentation protection required.")
+		return
+	}
+	finding := ctx.Report(d.Info(), callerContract.Name, fmt.Sprintf("delegatecall to contract [%s] whose owner variable shares a storage slot with the caller", calleeContract.Name))
+	guard(ctx, finding, callerContract, fmt.Sprintf("the owner variable of [%s] shares a storage slot with the caller", calleeContract.Name), InstrumentCodeForAssert)
+}
+
+// guard 在合约 contract 的每个 delegatecall 语句之后插入 owner 的校验与其余特权状态的快照比较，并在 finding 中说明插入的检查，
+// reason 为插入的原因；函数不可达时只上报检测结果而不插桩。
+func guard(ctx *AnalysisContext, finding *src.Finding, contract *ast.ContractDefinition, reason string, insertAssert func(ownerVariableName string, contract *ast.ContractDefinition, guard string, namespaced bool, logger logging.Logger)) {
+	if !ctx.Reachable() {
+		ctx.Logger.Infof("Function [%s] is not reachable from any external entry, skip instrumenting contract [%s].", ctx.Function.Signature(), contract.Name)
+		finding.Explanation.Guard = "nothing inserted: the function is not reachable from any external entry"
+		return
+	}
+	var ownerVariableName string
+	if !ctx.Settings.SnapshotOwner(false) {
+		ownerVariableName = guardOwner(ctx, contract, insertAssert)
+	}
+	snapshots := InstrumentCodeForSnapshot(ctx.Protected(contract.Name, ownerVariableName, nil), contract, ctx.Settings.Template, ctx.Logger)
+	ctx.Explain(finding, ownerVariableName, snapshots, contract.Name, reason)
+}
+
+// guardOwner 追踪 owner 变量（通过符号表解析到合约 contract 或者它继承的合约中的声明，见 OwnerVariable）的修改，并在 contract 的 delegatecall 语句之后校验它，
+// 返回 owner 变量名，没有匹配的变量时返回空字符串。
+func guardOwner(ctx *AnalysisContext, contract *ast.ContractDefinition, insertAssert func(ownerVariableName string, contract *ast.ContractDefinition, guard string, namespaced bool, logger logging.Logger)) string {
+	owner := OwnerVariable(contract, ctx.Settings.Variables, ctx.Symbols)
+	if owner == nil {
+		return ""
+	}
+	ownerVariableName := InstrumentCodeForOwner(owner, ctx.Analysis, ctx.Layouts, ctx.Settings.Namespaced(), ctx.GlobalNodes, ctx.Logger)
+	if ownerVariableName != "" {
+		insertAssert(ownerVariableName, contract, ctx.Settings.Template, ctx.Settings.Namespaced(), ctx.Logger)
+	}
+	return ownerVariableName
+}
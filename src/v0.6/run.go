@@ -1,7 +1,14 @@
 package v05
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/layout"
 	"github.com/geistwelt/taintguard/src/v0.6/ast"
 	"github.com/geistwelt/taintguard/src/v0.6/cfg"
 
@@ -9,19 +16,58 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
-func Run(jsonBytes []byte, isCfg bool, logger logging.Logger, solFileName string, dirName string, variables []string) (ast.ASTNode, error) {
+// Run 解析语法树并完成检测与插桩；source 为预加载的同一棵语法树，reachability 为在其上构建的过程间分析，
+// 由调用者对每个文件只构建一次。解析或分析过程中出现的 panic 会被转换为错误返回。
+func Run(source jsoniter.Any, reachability *analysis.Analysis, isCfg bool, logger logging.Logger, solFileName string, dirName string, conf *config.Config, strict bool) (node ast.ASTNode, findings []*src.Finding, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Failed to analyze [%s]: [%v].", solFileName, r)
+			node, findings, err = nil, nil, src.Errorf(src.AnalysisError, "failed to analyze [%s]: [%v]", solFileName, r)
+		}
+	}()
+
+	return run(source, reachability, isCfg, logger, solFileName, dirName, conf, strict)
+}
+
+func run(source jsoniter.Any, reachability *analysis.Analysis, isCfg bool, logger logging.Logger, solFileName string, dirName string, conf *config.Config, strict bool) (ast.ASTNode, []*src.Finding, error) {
 	gn := ast.NewGlobalNodes()
-	fullFile := jsoniter.Get(jsonBytes)
-	sourceUnit, err := ast.GetSourceUnit(gn, fullFile, logger)
+	sourceUnit, err := ast.GetSourceUnit(gn, source, logger)
+	if err != nil {
+		return nil, nil, src.WrapError(src.ParseError, err)
+	}
+	if err = CheckUnknownNodes(gn, strict, logger); err != nil {
+		return nil, nil, src.WrapError(src.ParseError, err)
+	}
+
+	symbols := ast.NewSymbolTable(sourceUnit)
+	layouts, err := layout.Compute(source)
 	if err != nil {
-		return nil, err
+		logger.Warnf("Failed to compute the storage layout, the explanation of findings will not contain storage slots: [%v].", err)
 	}
+	callers := Callers(gn, logger)
+	findings := make([]*src.Finding, 0)
+	original := StorageLayout(gn)
 
 	// Get the call path of each function.
 	ncps := make([]*ast.NormalCallPath, 0)
-	for _, function := range gn.Functions() {
+	functionIDs := make([]int, 0, len(gn.Functions()))
+	for id := range gn.Functions() {
+		functionIDs = append(functionIDs, id)
+	}
+	sort.Ints(functionIDs)
+	for _, id := range functionIDs {
+		function := gn.Functions()[id]
 		ncp := ast.NewNormalCallPath()
 		f, _ := function.(*ast.FunctionDefinition)
+		contract, _ := gn.ContractsByID()[f.Scope].(*ast.ContractDefinition)
+		contractName := ""
+		if contract != nil {
+			contractName = contract.Name
+		}
+		if !conf.Included(contractName, f.Name) {
+			logger.Debugf("Skip function [%s], it is excluded by the configuration.", f.Signature())
+			continue
+		}
 		opt := &ast.Option{}
 		opt.MakeDelegatecallUnknownContractCh(1)
 		opt.MakeDelegatecallKnownContractCh(1)
@@ -29,49 +75,45 @@ func Run(jsonBytes []byte, isCfg bool, logger logging.Logger, solFileName string
 
 		f.TraverseFunctionCall(ncp, gn, opt, logger)
 		ncps = append(ncps, ncp)
+
+		explanation := reachability.Explain(f.NodeID(), contractName, layouts, conf.For(contractName).Variables)
+		if chain := CallChain(callers, gn, f.NodeID()); len(chain) > 0 {
+			explanation.Entry = chain[0].Signature()
+			for _, function := range chain {
+				explanation.Calls = append(explanation.Calls, function.Signature())
+			}
+		}
+		ctx := &AnalysisContext{GlobalNodes: gn, Symbols: symbols, Function: f, Contract: contract}
+		ctx.FunctionContext = analysis.FunctionContext{Settings: conf.For(contractName), SolFileName: solFileName, Logger: logger, Signature: f.Signature(), Entries: reachability.EntriesOf(f.NodeID()), Explanation: explanation, Analysis: reachability, Layouts: layouts}
 		select {
 		case <-opt.DelegatecallUnknownContractCh():
-			contract := gn.ContractsByID()[f.Scope].(*ast.ContractDefinition)
-			logger.Infof("Contract [%s] may should be instrumented directly, because it delegatecall to unknown contract.", contract.Name)
-			if ok, c := IsInheritFromOwnableContract(contract, gn, variables); ok {
-				ownerVariableName := InstrumentCodeForOwner(c, variables)
-				InstrumentCodeForAssert(ownerVariableName, contract)
-			} else {
-				ownerVariableName := InstrumentCodeForOwner(contract, variables)
-				if ownerVariableName != "" {
-					InstrumentCodeForAssert(ownerVariableName, contract)
-				}
-			}
+			ctx.UnknownDelegatecall = true
+		default:
+		}
+		select {
 		case <-opt.IndirectDelegatecallCh():
-			contract := gn.ContractsByID()[f.Scope].(*ast.ContractDefinition)
-			logger.Infof("Contract [%s] may should be instrumented directly, because it delegatecall to unknown contract.", contract.Name)
-			if ok, c := IsInheritFromOwnableContract(contract, gn, variables); ok {
-				ownerVariableName := InstrumentCodeForOwner(c, variables)
-				InsertAssertCode(ownerVariableName, contract)
-			} else {
-				ownerVariableName := InstrumentCodeForOwner(contract, variables)
-				if ownerVariableName != "" {
-					InstrumentCodeForAssert(ownerVariableName, contract)
-				}
-			}
-		case calleeContractName := <-opt.DelegatecallKnownContractCh():
-			callerContract := gn.ContractsByID()[f.Scope].(*ast.ContractDefinition)
-			calleeContract := gn.ContractsByName()[calleeContractName].(*ast.ContractDefinition)
-			if VerifyVariableDeclarationOrder(callerContract, calleeContract, gn, variables) {
-				if ok, c := IsInheritFromOwnableContract(callerContract, gn, variables); ok {
-					ownerVariableName := InstrumentCodeForOwner(c, variables)
-					InstrumentCodeForAssert(ownerVariableName, callerContract)
-				} else {
-					ownerVariableName := InstrumentCodeForOwner(callerContract, variables)
-					InstrumentCodeForAssert(ownerVariableName, callerContract)
-					logger.Debug("在本地插桩")
-				}
-			} else {
-				logger.Debug("No instrumentation protection required.")
+			ctx.IndirectDelegatecall = true
+		default:
+		}
+		select {
+		case ctx.KnownDelegatecall = <-opt.DelegatecallKnownContractCh():
+		default:
+		}
+		for _, detector := range Detectors() {
+			if ctx.Settings.DetectorEnabled(detector.Info().ID) {
+				detector.Run(ctx)
 			}
+		}
+		findings = append(findings, ctx.Findings()...)
+	}
 
-		default:
+	// 插桩不应该改变合约原有的 storage 布局，否则会破坏升级合约（以及继承被插桩的合约的合约）中已有的数据。
+	for _, change := range layout.Diff(original, StorageLayout(gn)) {
+		if conf.For(change.Contract).Namespaced() {
+			logger.Errorf("Failed to keep the storage layout: [%s].", change)
+			return nil, nil, src.Errorf(src.InstrumentationError, "failed to keep the storage layout: [%s]", change)
 		}
+		logger.Warnf("Instrumentation changes the storage layout, set track-storage to namespaced for upgradeable contracts: [%s].", change)
 	}
 
 	if isCfg {
@@ -84,11 +126,15 @@ func Run(jsonBytes []byte, isCfg bool, logger logging.Logger, solFileName string
 		}
 	}
 
-	return sourceUnit, nil
+	return sourceUnit, findings, nil
 }
 
 func TraverseFunctionCallAll(ncps []*ast.NormalCallPath, gn *ast.GlobalNodes, logger logging.Logger) {
 	for _, ncp := range ncps {
+		if isRecursiveCall(ncp) {
+			logger.Debugf("Stop expanding recursive call to function [%s].", ncp.Name())
+			continue
+		}
 		fd := gn.Functions()[ncp.ID()]
 		f, ok := fd.(*ast.FunctionDefinition)
 		if ok {
@@ -99,3 +145,110 @@ func TraverseFunctionCallAll(ncps []*ast.NormalCallPath, gn *ast.GlobalNodes, lo
 		}
 	}
 }
+
+// Callers 根据每个函数的 NormalCallPath 记录它的直接调用者，键与值均为函数的节点 id，调用者按 id 排序。
+func Callers(gn *ast.GlobalNodes, logger logging.Logger) map[int][]int {
+	ids := make([]int, 0, len(gn.Functions()))
+	for id := range gn.Functions() {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	callers := make(map[int][]int)
+	for _, id := range ids {
+		f, ok := gn.Functions()[id].(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+		ncp := ast.NewNormalCallPath()
+		f.TraverseFunctionCall(ncp, gn, nil, logger)
+		seen := make(map[int]bool)
+		for _, callee := range ncp.Callees() {
+			if seen[callee.ID()] {
+				continue
+			}
+			seen[callee.ID()] = true
+			callers[callee.ID()] = append(callers[callee.ID()], id)
+		}
+	}
+	return callers
+}
+
+// CallChain 沿着调用者向上查找离函数 id 最近的外部入口，返回从入口到该函数的调用链（包括两端）；找不到入口时返回 nil。
+func CallChain(callers map[int][]int, gn *ast.GlobalNodes, id int) []*ast.FunctionDefinition {
+	next := map[int]int{id: id}
+	queue := []int{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		f, ok := gn.Functions()[current].(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+		if isEntry(f) {
+			chain := []*ast.FunctionDefinition{f}
+			for current != id {
+				current = next[current]
+				function, _ := gn.Functions()[current].(*ast.FunctionDefinition)
+				chain = append(chain, function)
+			}
+			return chain
+		}
+		for _, caller := range callers[current] {
+			if _, ok := next[caller]; !ok {
+				next[caller] = current
+				queue = append(queue, caller)
+			}
+		}
+	}
+	return nil
+}
+
+// isEntry 判断函数能否从外部直接调用，构造函数、fallback、receive、public 与 external 函数都是入口。
+func isEntry(f *ast.FunctionDefinition) bool {
+	switch f.Kind {
+	case "constructor", "fallback", "receive":
+		return true
+	}
+	return f.Visibility == "public" || f.Visibility == "external"
+}
+
+// isRecursiveCall 判断 ncp 对应的函数是否已经出现在它的调用链上，避免递归调用导致无限展开。
+func isRecursiveCall(ncp *ast.NormalCallPath) bool {
+	for caller := ncp.Caller(); caller != nil; caller = caller.Caller() {
+		if caller.ID() == ncp.ID() {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckUnknownNodes 汇总解析时被跳过的节点类型；strict 模式下只要存在被跳过的节点就返回错误。
+func CheckUnknownNodes(gn *ast.GlobalNodes, strict bool, logger logging.Logger) error {
+	unknownNodes := gn.UnknownNodes()
+	logger.Infof("Coverage: parsed [%d] nodes, skipped [%d] nodes.", len(gn.Nodes()), len(unknownNodes))
+	if len(unknownNodes) == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	firstSrc := make(map[string]string)
+	nodeTypes := make([]string, 0)
+	for _, unknownNode := range unknownNodes {
+		if _, ok := counts[unknownNode.NodeType]; !ok {
+			nodeTypes = append(nodeTypes, unknownNode.NodeType)
+			firstSrc[unknownNode.NodeType] = unknownNode.Src
+		}
+		counts[unknownNode.NodeType]++
+	}
+	sort.Strings(nodeTypes)
+
+	for _, nodeType := range nodeTypes {
+		logger.Warnf("Coverage: skipped [%d] node(s) of nodeType [%s], first at [src:%s].", counts[nodeType], nodeType, firstSrc[nodeType])
+	}
+
+	if strict {
+		return fmt.Errorf("strict mode: unsupported nodeType [%s] at [src:%s]", unknownNodes[0].NodeType, unknownNodes[0].Src)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package v05
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/golden"
+)
+
+func TestRunGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", "v0.6", "*.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		solFileName := strings.TrimSuffix(filepath.Base(fixture), "_json.ast")
+		t.Run(solFileName, func(t *testing.T) {
+			source, reachability := golden.Load(t, fixture)
+
+			var log bytes.Buffer
+			logger := golden.NewLogger(&log)
+			dirName := t.TempDir()
+
+			node, findings, err := Run(source, reachability, true, logger, solFileName, dirName, config.Default(), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			code := node.SourceCode(false, false, "", logger)
+
+			cg, err := golden.CallGraph(filepath.Join(dirName, "call-graph", solFileName))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := filepath.Join("testdata", solFileName)
+			golden.Assert(t, filepath.Join(dir, "findings.golden"), golden.Findings(log.Bytes(), findings))
+			golden.Assert(t, filepath.Join(dir, "instrumented.sol.golden"), []byte(code))
+			golden.Assert(t, filepath.Join(dir, "callgraph.dot.golden"), cg)
+		})
+	}
+}
+
+// TestRunNamespaced 检查把 owner 的追踪信息保存在命名空间中时，插桩不改变合约原有的 storage 布局。
+func TestRunNamespaced(t *testing.T) {
+	solFileName := "1.sol"
+	source, reachability := golden.Load(t, filepath.Join("..", "..", "contracts", "v0.6", solFileName+"_json.ast"))
+	conf := config.Default()
+	conf.TrackStorage = "namespaced"
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	node, _, err := Run(source, reachability, false, logger, solFileName, t.TempDir(), conf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(log.String(), "storage layout") {
+		t.Errorf("unexpected storage layout changes:\n%s", log.String())
+	}
+	code := node.SourceCode(false, false, "", logger)
+	// solidity 0.6 中 storage 指针的 slot 写作 s_slot。
+	for _, expected := range []string{"xxx_track_storage_owner().trackMapping[xxx_track_storage_owner().track]", "s_slot := 0x"} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("instrumented code should contain [%s]", expected)
+		}
+	}
+	if strings.Contains(code, "bytes xxx_track_owner") {
+		t.Errorf("instrumented code should not append track state variables")
+	}
+}
@@ -0,0 +1,40 @@
+package v05
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/golden"
+	"github.com/geistwelt/taintguard/src/v0.6/ast"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestInstrumentCodeForSnapshot 检查 delegatecall 出现在不带花括号的分支中设置了 gas 的调用以及 return 语句中时，
+// 快照插入在语句之前，检查插入在语句之后；return 语句先把返回值保存在局部变量中，检查插入在返回之前。
+func TestInstrumentCodeForSnapshot(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("testdata", "delegatecall.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	sourceUnit, err := ast.GetSourceUnit(ast.NewGlobalNodes(), jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := sourceUnit.Nodes()[1].(*ast.ContractDefinition)
+	states := []*analysis.State{{Expression: "owner", Type: "address"}}
+	for i := 0; i < 2; i++ {
+		// 重复插桩时不再插入。
+		if snapshots := InstrumentCodeForSnapshot(states, contract, "require", logger); len(snapshots) != 1 {
+			t.Fatalf("expected 1 snapshot, got %d", len(snapshots))
+		}
+	}
+	if log.Len() > 0 {
+		t.Errorf("unexpected warnings:\n%s", log.String())
+	}
+	golden.Assert(t, filepath.Join("testdata", "delegatecall.sol.golden"), []byte(sourceUnit.SourceCode(false, false, "", logger)))
+}
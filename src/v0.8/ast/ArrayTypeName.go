@@ -1,16 +1,17 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
 type ArrayTypeName struct {
 	baseType         ASTNode
-	ID               int    `json:"id"`
+	ID               int `json:"id"`
+	length           ASTNode
 	NodeType         string `json:"nodeType"`
 	Src              string `json:"src"`
 	TypeDescriptions struct {
@@ -31,16 +32,28 @@ func (atn *ArrayTypeName) SourceCode(isSc bool, isIndent bool, indent string, lo
 			code = code + baseType.SourceCode(false, false, indent, logger)
 		case *UserDefinedTypeName:
 			code = code + baseType.SourceCode(false, false, indent, logger)
+		case *ArrayTypeName:
+			code = code + baseType.SourceCode(false, false, indent, logger)
+		case *FunctionTypeName:
+			code = code + baseType.SourceCode(false, false, indent, logger)
+		case *Mapping:
+			code = code + baseType.SourceCode(false, false, indent, logger)
 		default:
 			if baseType != nil {
-				logger.Warnf("Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseType.Type(), atn.Src)
+				src.Dropf(logger, "Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseType.Type(), atn.Src)
 			} else {
-				logger.Warnf("Unknown baseType nodeType for ArrayTypeName [src:%s].", atn.Src)
+				src.Dropf(logger, "Unknown baseType nodeType for ArrayTypeName [src:%s].", atn.Src)
 			}
 		}
 	}
 
-	code = code + "[]"
+	code = code + "["
+
+	if atn.length != nil {
+		code = code + ExpressionCode(atn.length, indent, "length", "ArrayTypeName", atn.Src, logger)
+	}
+
+	code = code + "]"
 
 	return code
 }
@@ -59,7 +72,7 @@ func (atn *ArrayTypeName) NodeID() int {
 
 func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ArrayTypeName, error) {
 	atn := new(ArrayTypeName)
-	if err := json.Unmarshal([]byte(raw.ToString()), atn); err != nil {
+	if err := src.UnmarshalAny(raw, atn); err != nil {
 		logger.Errorf("Failed to unmarshal ArrayTypeName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ArrayTypeName: [%v]", err)
 	}
@@ -77,8 +90,15 @@ func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				atnBaseType, err = GetElementaryTypeName(gn, baseType, logger)
 			case "UserDefinedTypeName":
 				atnBaseType, err = GetUserDefinedTypeName(gn, baseType, logger)
+			case "ArrayTypeName":
+				atnBaseType, err = GetArrayTypeName(gn, baseType, logger)
+			case "FunctionTypeName":
+				atnBaseType, err = GetFunctionTypeName(gn, baseType, logger)
+			case "Mapping":
+				atnBaseType, err = GetMapping(gn, baseType, logger)
 			default:
 				logger.Warnf("Unknown baseType nodeType [%s] for ArrayTypeName [src:%s].", baseTypeNodeType, atn.Src)
+				gn.AddUnknownNode(baseTypeNodeType, baseType.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -91,6 +111,25 @@ func GetArrayTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 		}
 	}
 
+	// length
+	{
+		length := raw.Get("length")
+		if length.Size() > 0 {
+			var atnLength ASTNode
+			var err error
+
+			atnLength, err = GetExpression(gn, length, "length", "ArrayTypeName", atn.Src, logger)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if atnLength != nil {
+				atn.length = atnLength
+			}
+		}
+	}
+
 	gn.AddASTNode(atn)
 
 	return atn, nil
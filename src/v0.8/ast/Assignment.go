@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -34,22 +34,7 @@ func (a *Assignment) SourceCode(isSc bool, isIndent bool, indent string, logger
 	// leftHandSide
 	{
 		if a.leftHandSide != nil {
-			switch leftHandSide := a.leftHandSide.(type) {
-			case *Identifier:
-				code = code + leftHandSide.SourceCode(false, false, indent, logger)
-			case *IndexAccess:
-				code = code + leftHandSide.SourceCode(false, false, indent, logger)
-			case *TupleExpression:
-				code = code + leftHandSide.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + leftHandSide.SourceCode(false, false, indent, logger)
-			default:
-				if leftHandSide != nil {
-					logger.Warnf("Unknown leftHandSide nodeType [%s] for Assignment [src:%s].", leftHandSide.Type(), a.Src)
-				} else {
-					logger.Warnf("Unknown leftHandSide nodeType for Assignment [src:%s].", a.Src)
-				}
-			}
+			code = code + ExpressionCode(a.leftHandSide, indent, "leftHandSide", "Assignment", a.Src, logger)
 		}
 	}
 
@@ -58,28 +43,7 @@ func (a *Assignment) SourceCode(isSc bool, isIndent bool, indent string, logger
 	//rightHandSide
 	{
 		if a.rightHandSide != nil {
-			switch rightHandSide := a.rightHandSide.(type) {
-			case *Literal:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *Identifier:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *FunctionCall:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *BinaryOperation:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *IndexAccess:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			case *UnaryOperation:
-				code = code + " " + rightHandSide.SourceCode(false, false, indent, logger)
-			default:
-				if rightHandSide != nil {
-					logger.Warnf("Unknown rightHandSide nodeType [%s] for Assignment [src:%s].", rightHandSide.Type(), a.Src)
-				} else {
-					logger.Warnf("Unknown rightHandSide nodeType for Assignment [src:%s].", a.Src)
-				}
-			}
+			code = code + " " + ExpressionCode(a.rightHandSide, indent, "rightHandSide", "Assignment", a.Src, logger)
 		}
 	}
 
@@ -104,7 +68,7 @@ func (a *Assignment) NodeID() int {
 
 func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Assignment, error) {
 	a := new(Assignment)
-	if err := json.Unmarshal([]byte(raw.ToString()), a); err != nil {
+	if err := src.UnmarshalAny(raw, a); err != nil {
 		logger.Errorf("Failed to unmarshal Assignment: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Assignment: [%v]", err)
 	}
@@ -113,22 +77,10 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 	{
 		leftHandSide := raw.Get("leftHandSide")
 		if leftHandSide.Size() > 0 {
-			leftHandSideNodeType := leftHandSide.Get("nodeType").ToString()
 			var aLeftHandSide ASTNode
 			var err error
 
-			switch leftHandSideNodeType {
-			case "Identifier":
-				aLeftHandSide, err = GetIdentifier(gn, leftHandSide, logger)
-			case "IndexAccess":
-				aLeftHandSide, err = GetIndexAccess(gn, leftHandSide, logger)
-			case "TupleExpression":
-				aLeftHandSide, err = GetTupleExpression(gn, leftHandSide, logger)
-			case "MemberAccess":
-				aLeftHandSide, err = GetMemberAccess(gn, leftHandSide, logger)
-			default:
-				logger.Warnf("Unknown leftHandSide nodeType [%s] for Assignment [src:%s].", leftHandSideNodeType, a.Src)
-			}
+			aLeftHandSide, err = GetExpression(gn, leftHandSide, "leftHandSide", "Assignment", a.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -144,28 +96,10 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 	{
 		rightHandSide := raw.Get("rightHandSide")
 		if rightHandSide.Size() > 0 {
-			rightHandSideNodeType := rightHandSide.Get("nodeType").ToString()
 			var aRightHandSide ASTNode
 			var err error
 
-			switch rightHandSideNodeType {
-			case "Literal":
-				aRightHandSide, err = GetLiteral(gn, rightHandSide, logger)
-			case "Identifier":
-				aRightHandSide, err = GetIdentifier(gn, rightHandSide, logger)
-			case "FunctionCall":
-				aRightHandSide, err = GetFunctionCall(gn, rightHandSide, logger)
-			case "MemberAccess":
-				aRightHandSide, err = GetMemberAccess(gn, rightHandSide, logger)
-			case "BinaryOperation":
-				aRightHandSide, err = GetBinaryOperation(gn, rightHandSide, logger)
-			case "IndexAccess":
-				aRightHandSide, err = GetIndexAccess(gn, rightHandSide, logger)
-			case "UnaryOperation":
-				aRightHandSide, err = GetUnaryOperation(gn, rightHandSide, logger)
-			default:
-				logger.Warnf("Unknown rightHandSide nodeType [%s] for Assignment [src:%s].", rightHandSideNodeType, a.Src)
-			}
+			aRightHandSide, err = GetExpression(gn, rightHandSide, "rightHandSide", "Assignment", a.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -184,43 +118,13 @@ func GetAssignment(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*A
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (a *Assignment) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// leftHandSide
-	{
-		if a.leftHandSide != nil {
-			switch leftHandSide := a.leftHandSide.(type) {
-			case *IndexAccess:
-				leftHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				leftHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	//rightHandSide
-	{
-		if a.rightHandSide != nil {
-			switch rightHandSide := a.rightHandSide.(type) {
-			case *FunctionCall:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *BinaryOperation:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (a *Assignment) TraverseTaintOwner(opt *Option, logger logging.Logger) {
 	// leftHandSide
 	{
 		if a.leftHandSide != nil {
 			switch leftHandSide := a.leftHandSide.(type) {
 			case *Identifier:
-				if leftHandSide.Name == opt.SimilarOwnerVariableName {
+				if opt.OwnerVariable != nil && opt.Symbols.Resolve(leftHandSide) == opt.OwnerVariable {
 					opt.IsTainted = true
 					trackAssignment := &Assignment{
 						leftHandSide: &IndexAccess{
@@ -249,46 +153,11 @@ func (a *Assignment) TraverseTaintOwner(opt *Option, logger logging.Logger) {
 			}
 		}
 	}
-
-	// //rightHandSide
-	// {
-	// 	if a.rightHandSide != nil {
-	// 		switch rightHandSide := a.rightHandSide.(type) {
-	// 		case *FunctionCall:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *MemberAccess:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *BinaryOperation:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		case *IndexAccess:
-	// 			rightHandSide.TraverseFunctionCall(ncp, gn, opt, logger)
-	// 		}
-	// 	}
-	// }
+}
+func (a *Assignment) SetLeft(left ASTNode) {
+	a.leftHandSide = left
 }
 
-func (a *Assignment) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	// leftHandSide
-	{
-		if a.leftHandSide != nil {
-			switch leftHandSide := a.leftHandSide.(type) {
-			case *MemberAccess:
-				leftHandSide.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-
-	//rightHandSide
-	{
-		if a.rightHandSide != nil {
-			switch rightHandSide := a.rightHandSide.(type) {
-			case *FunctionCall:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			case *MemberAccess:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			case *BinaryOperation:
-				rightHandSide.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
\ No newline at end of file
+func (a *Assignment) SetRight(right ASTNode) {
+	a.rightHandSide = right
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -36,30 +36,7 @@ func (bo *BinaryOperation) SourceCode(isSc bool, isIndent bool, indent string, l
 	}
 
 	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *Literal:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *Identifier:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *BinaryOperation:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *UnaryOperation:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *FunctionCall:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *MemberAccess:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *TupleExpression:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		case *IndexAccess:
-			code = code + leftExpression.SourceCode(false, false, indent, logger)
-		default:
-			if leftExpression != nil {
-				logger.Warnf("Unknown leftExpression nodeType [%s] for BinaryOperation [src:%s].", leftExpression.Type(), bo.Src)
-			} else {
-				logger.Warnf("Unknown leftExpression nodeType for BinaryOperation [src:%s].", bo.Src)
-			}
-		}
+		code = code + ExpressionCode(bo.leftExpression, indent, "leftExpression", "BinaryOperation", bo.Src, logger)
 	}
 
 	if bo.Operator != "" {
@@ -69,30 +46,7 @@ func (bo *BinaryOperation) SourceCode(isSc bool, isIndent bool, indent string, l
 	}
 
 	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *Identifier:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *FunctionCall:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *Literal:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *UnaryOperation:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *MemberAccess:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *TupleExpression:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		case *IndexAccess:
-			code = code + " " + rightExpression.SourceCode(false, false, indent, logger)
-		default:
-			if rightExpression != nil {
-				logger.Warnf("Unknown rightExpression nodeType [%s] for BinaryOperation [src:%s].", rightExpression.Type(), bo.Src)
-			} else {
-				logger.Warnf("Unknown rightExpression nodeType for BinaryOperation [src:%s].", bo.Src)
-			}
-		}
+		code = code + " " + ExpressionCode(bo.rightExpression, indent, "rightExpression", "BinaryOperation", bo.Src, logger)
 	}
 
 	if isSc {
@@ -116,7 +70,7 @@ func (bo *BinaryOperation) NodeID() int {
 
 func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*BinaryOperation, error) {
 	bo := new(BinaryOperation)
-	if err := json.Unmarshal([]byte(raw.ToString()), bo); err != nil {
+	if err := src.UnmarshalAny(raw, bo); err != nil {
 		logger.Errorf("Failed to unmarshal BinaryOperation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal BinaryOperation: [%v]", err)
 	}
@@ -124,30 +78,10 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 	{
 		leftExpression := raw.Get("leftExpression")
 		if leftExpression.Size() > 0 {
-			leftExpressionNodeType := leftExpression.Get("nodeType").ToString()
 			var boLeftExpression ASTNode
 			var err error
 
-			switch leftExpressionNodeType {
-			case "Literal":
-				boLeftExpression, err = GetLiteral(gn, leftExpression, logger)
-			case "Identifier":
-				boLeftExpression, err = GetIdentifier(gn, leftExpression, logger)
-			case "BinaryOperation":
-				boLeftExpression, err = GetBinaryOperation(gn, leftExpression, logger)
-			case "UnaryOperation":
-				boLeftExpression, err = GetUnaryOperation(gn, leftExpression, logger)
-			case "FunctionCall":
-				boLeftExpression, err = GetFunctionCall(gn, leftExpression, logger)
-			case "MemberAccess":
-				boLeftExpression, err = GetMemberAccess(gn, leftExpression, logger)
-			case "TupleExpression":
-				boLeftExpression, err = GetTupleExpression(gn, leftExpression, logger)
-			case "IndexAccess":
-				boLeftExpression, err = GetIndexAccess(gn, leftExpression, logger)
-			default:
-				logger.Warnf("Unknown leftExpression nodeType [%s] for BinaryOperation [src:%s].", leftExpressionNodeType, bo.Src)
-			}
+			boLeftExpression, err = GetExpression(gn, leftExpression, "leftExpression", "BinaryOperation", bo.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -162,30 +96,10 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 	{
 		rightExpression := raw.Get("rightExpression")
 		if rightExpression.Size() > 0 {
-			rightExpressionNodeType := rightExpression.Get("nodeType").ToString()
 			var boRightExpression ASTNode
 			var err error
 
-			switch rightExpressionNodeType {
-			case "Identifier":
-				boRightExpression, err = GetIdentifier(gn, rightExpression, logger)
-			case "BinaryOperation":
-				boRightExpression, err = GetBinaryOperation(gn, rightExpression, logger)
-			case "FunctionCall":
-				boRightExpression, err = GetFunctionCall(gn, rightExpression, logger)
-			case "Literal":
-				boRightExpression, err = GetLiteral(gn, rightExpression, logger)
-			case "UnaryOperation":
-				boRightExpression, err = GetUnaryOperation(gn, rightExpression, logger)
-			case "MemberAccess":
-				boRightExpression, err = GetMemberAccess(gn, rightExpression, logger)
-			case "TupleExpression":
-				boRightExpression, err = GetTupleExpression(gn, rightExpression, logger)
-			case "IndexAccess":
-				boRightExpression, err = GetIndexAccess(gn, rightExpression, logger)
-			default:
-				logger.Warnf("Unknown rightExpression nodeType [%s] for BinaryOperation [src:%s].", rightExpressionNodeType, bo.Src)
-			}
+			boRightExpression, err = GetExpression(gn, rightExpression, "rightExpression", "BinaryOperation", bo.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -203,64 +117,6 @@ func GetBinaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (bo *BinaryOperation) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *BinaryOperation:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *MemberAccess:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			leftExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *MemberAccess:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *TupleExpression:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			rightExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (bo *BinaryOperation) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if bo.leftExpression != nil {
-		switch leftExpression := bo.leftExpression.(type) {
-		case *BinaryOperation:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		case *FunctionCall:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		case *MemberAccess:
-			leftExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if bo.rightExpression != nil {
-		switch rightExpression := bo.rightExpression.(type) {
-		case *BinaryOperation:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		case *FunctionCall:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		case *MemberAccess:
-			rightExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
 func (bo *BinaryOperation) SetLeftExpression(leftExpression ASTNode) {
 	bo.leftExpression = leftExpression
 }
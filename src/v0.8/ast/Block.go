@@ -1,11 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -50,11 +49,15 @@ func (b *Block) SourceCode(isSc bool, isIndent bool, indent string, logger loggi
 				code = code + stat.SourceCode(false, true, indent+"    ", logger)
 			case *DoWhileStatement:
 				code = code + stat.SourceCode(true, true, indent+"    ", logger)
+			case *Break:
+				code = code + stat.SourceCode(true, true, indent+"    ", logger)
+			case *Continue:
+				code = code + stat.SourceCode(true, true, indent+"    ", logger)
 			default:
 				if stat != nil {
-					logger.Warnf("Unknown statement nodeType [%s] for Block [src:%s].", stat.Type(), b.Src)
+					src.Dropf(logger, "Unknown statement nodeType [%s] for Block [src:%s].", stat.Type(), b.Src)
 				} else {
-					logger.Warnf("Unknown statement nodeType for Block [src:%s].", b.Src)
+					src.Dropf(logger, "Unknown statement nodeType for Block [src:%s].", b.Src)
 				}
 			}
 			if index < len(b.statements)-1 {
@@ -80,7 +83,7 @@ func (b *Block) NodeID() int {
 
 func GetBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Block, error) {
 	b := new(Block)
-	if err := json.Unmarshal([]byte(raw.ToString()), b); err != nil {
+	if err := src.UnmarshalAny(raw, b); err != nil {
 		logger.Errorf("Failed to unmarshal Block: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Block: [%v]", err)
 	}
@@ -126,8 +129,13 @@ func GetBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Block,
 					bStatement, err = GetTryStatement(gn, statement, logger)
 				case "DoWhileStatement":
 					bStatement, err = GetDoWhileStatement(gn, statement, logger)
+				case "Break":
+					bStatement, err = GetBreak(gn, statement, logger)
+				case "Continue":
+					bStatement, err = GetContinue(gn, statement, logger)
 				default:
 					logger.Warnf("Unknown statement nodeType [%s] for Block [src:%s].", statementNodeType, b.Src)
+					gn.AddUnknownNode(statementNodeType, statement.Get("src").ToString())
 				}
 
 				if err != nil {
@@ -147,64 +155,6 @@ func GetBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Block,
 
 //////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (b *Block) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(b.statements) > 0 {
-		for _, statement := range b.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *Return:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *EmitStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IfStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *VariableDeclarationStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *ForStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *RevertStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *Block:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *UncheckedBlock:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *WhileStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *TryStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *DoWhileStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (b *Block) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if len(b.statements) > 0 {
-		for _, statement := range b.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			case *IfStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			case *ForStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			case *Block:
-				stat.TraverseTaintOwner(opt, logger)
-			case *UncheckedBlock:
-				stat.TraverseTaintOwner(opt, logger)
-			case *WhileStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			case *TryStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			case *DoWhileStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-}
-
 func (b *Block) AppendStatement(stat ASTNode) {
 	if b.statements == nil {
 		b.statements = make([]ASTNode, 0)
@@ -215,7 +165,7 @@ func (b *Block) AppendStatement(stat ASTNode) {
 
 func (b *Block) InsertStatement(stat ASTNode, index int) {
 	if index < len(b.statements) {
-		if b.statements[index].SourceCode(false, false, "", nil) == stat.SourceCode(false, false, "", nil) {
+		if b.statements[index].SourceCode(false, false, "", src.SilentLogger) == stat.SourceCode(false, false, "", src.SilentLogger) {
 			return
 		}
 	}
@@ -225,38 +175,3 @@ func (b *Block) InsertStatement(stat ASTNode, index int) {
 	copy(statements[index+1:], b.statements[index:])
 	b.statements = statements
 }
-
-func (b *Block) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if len(b.statements) > 0 {
-		for index, statement := range b.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				if strings.Contains(stat.SourceCode(false, false, "", logger), ".delegatecall(") {
-					if opt.ExpressionStatement != nil {
-						b.InsertStatement(opt.ExpressionStatement, index+1)
-					}
-				}
-			case *IfStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *ForStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *Block:
-				stat.TraverseDelegatecall(opt, logger)
-			case *UncheckedBlock:
-				stat.TraverseDelegatecall(opt, logger)
-			case *WhileStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *TryStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *DoWhileStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *VariableDeclarationStatement:
-				if strings.Contains(stat.SourceCode(false, false, "", logger), ".delegatecall(") {
-					if opt.ExpressionStatement != nil {
-						b.InsertStatement(opt.ExpressionStatement, index+1)
-					}
-				}
-			}
-		}
-	}
-}
@@ -0,0 +1,52 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type Continue struct {
+	ID       int    `json:"id"`
+	NodeType string `json:"nodeType"`
+	Src      string `json:"src"`
+}
+
+func (c *Continue) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "continue"
+
+	if isSc {
+		code = code + ";"
+	}
+
+	return code
+}
+
+func (c *Continue) Type() string {
+	return c.NodeType
+}
+
+func (c *Continue) Nodes() []ASTNode {
+	return nil
+}
+
+func (c *Continue) NodeID() int {
+	return c.ID
+}
+
+func GetContinue(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Continue, error) {
+	c := new(Continue)
+	if err := src.UnmarshalAny(raw, c); err != nil {
+		logger.Errorf("Failed to unmarshal Continue: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal Continue: [%v]", err)
+	}
+
+	return c, nil
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -14,6 +14,7 @@ type ContractDefinition struct {
 	CanonicalName           string `json:"canonicalName"`
 	ContractDependencies    []int  `json:"contractDependencies"`
 	ContractKind            string `json:"contractKind"`
+	documentation           ASTNode
 	FullyImplemented        bool   `json:"fullyImplemented"`
 	ID                      int    `json:"id"`
 	LinearizedBaseContracts []int  `json:"linearizedBaseContracts"`
@@ -29,6 +30,23 @@ type ContractDefinition struct {
 
 func (cd *ContractDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
 	var code string
+
+	// documentation
+	{
+		if cd.documentation != nil {
+			switch documentation := cd.documentation.(type) {
+			case *StructuredDocumentation:
+				code = code + documentation.SourceCode(false, isIndent, indent, logger) + "\n"
+			default:
+				if documentation != nil {
+					src.Dropf(logger, "Unknown documentation nodeType [%s] for ContractDefinition [src:%s].", documentation.Type(), cd.Src)
+				} else {
+					src.Dropf(logger, "Unknown documentation nodeType for ContractDefinition [src:%s].", cd.Src)
+				}
+			}
+		}
+	}
+
 	if isIndent {
 		code = code + indent
 	}
@@ -73,8 +91,10 @@ func (cd *ContractDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + node.SourceCode(true, true, indent+"    ", logger) + "\n"
 			case "EnumDefinition":
 				code = code + node.SourceCode(false, true, indent+"    ", logger) + "\n"
+			case "UserDefinedValueTypeDefinition":
+				code = code + node.SourceCode(true, true, indent+"    ", logger) + "\n"
 			default:
-				logger.Warnf("Unknown nodeType in ContractDefinition: [%s].", node.Type())
+				src.Dropf(logger, "Unknown nodeType in ContractDefinition: [%s].", node.Type())
 			}
 		}
 	}
@@ -102,11 +122,37 @@ func (cd *ContractDefinition) NodeID() int {
 
 func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ContractDefinition, error) {
 	cd := new(ContractDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), cd); err != nil {
+	if err := src.UnmarshalAny(raw, cd); err != nil {
 		logger.Errorf("Failed to unmarshal ContractDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ContractDefinition: [%v]", err)
 	}
 
+	// documentation
+	{
+		documentation := raw.Get("documentation")
+		if documentation.Size() > 0 {
+			documentationNodeType := documentation.Get("nodeType").ToString()
+			var cdDocumentation ASTNode
+			var err error
+
+			switch documentationNodeType {
+			case "StructuredDocumentation":
+				cdDocumentation, err = GetStructuredDocumentation(gn, documentation, logger)
+			default:
+				logger.Warnf("Unknown documentation nodeType [%s] for ContractDefinition [src:%s].", documentationNodeType, cd.Src)
+				gn.AddUnknownNode(documentationNodeType, documentation.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if cdDocumentation != nil {
+				cd.documentation = cdDocumentation
+			}
+		}
+	}
+
 	// baseContracts
 	{
 		baseContracts := raw.Get("baseContracts")
@@ -124,6 +170,7 @@ func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 					cd.baseContracts = append(cd.baseContracts, bc)
 				default:
 					logger.Warnf("Unknown baseContract nodeType: [%v-%s]", baseContractNodeType, baseContract.Get("src").ToString())
+					gn.AddUnknownNode(baseContractNodeType, baseContract.Get("src").ToString())
 				}
 			}
 		}
@@ -151,16 +198,20 @@ func GetContractDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 					cdNode, err = GetModifierDefinition(gn, node, logger)
 				case "FunctionDefinition":
 					cdNode, err = GetFunctionDefinition(gn, node, logger)
-					fd, _ := cdNode.(*FunctionDefinition)
-					fd.MakeSignature(cd.Name, logger)
+					if fd, ok := cdNode.(*FunctionDefinition); ok && err == nil {
+						fd.MakeSignature(cd.Name, logger)
+					}
 				case "StructDefinition":
 					cdNode, err = GetStructDefinition(gn, node, logger)
 				case "ErrorDefinition":
 					cdNode, err = GetErrorDefinition(gn, node, logger)
 				case "EnumDefinition":
 					cdNode, err = GetEnumDefinition(gn, node, logger)
+				case "UserDefinedValueTypeDefinition":
+					cdNode, err = GetUserDefinedValueTypeDefinition(gn, node, logger)
 				default:
 					logger.Warnf("Unknown nodes nodeType: [%v-%s]", nodeNodeType, node.Get("src").ToString())
+					gn.AddUnknownNode(nodeNodeType, node.Get("src").ToString())
 				}
 
 				if err != nil {
@@ -188,7 +239,7 @@ func (cd *ContractDefinition) InsertReturnOwnerFunction(fd *FunctionDefinition)
 		for _, node := range cd.nodes {
 			switch node.Type() {
 			case "FunctionDefinition":
-				if node.SourceCode(false, false, "", nil) == fd.SourceCode(false, false, "", nil) {
+				if node.SourceCode(false, false, "", src.SilentLogger) == fd.SourceCode(false, false, "", src.SilentLogger) {
 					isExisted = true
 				}
 			}
@@ -199,24 +250,26 @@ func (cd *ContractDefinition) InsertReturnOwnerFunction(fd *FunctionDefinition)
 	}
 }
 
+// TraverseTaintOwner 遍历合约中每个函数的所有语句，在修改 owner 的赋值语句前后插入追踪修改者的语句。
 func (cd *ContractDefinition) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if len(cd.nodes) > 0 {
-		for _, node := range cd.nodes {
-			switch n := node.(type) {
-			case *FunctionDefinition:
-				n.TraverseTaintOwner(opt, logger)
-			}
+	for _, node := range cd.nodes {
+		fd, ok := node.(*FunctionDefinition)
+		if !ok || fd.body == nil {
+			continue
 		}
+		opt.TrackFunctionDefinitionName = fd.Signature()
+		Inspect(fd.body, func(node ASTNode) bool {
+			if es, ok := node.(*ExpressionStatement); ok {
+				es.TraverseTaintOwner(opt, logger)
+			}
+			return true
+		})
 	}
 }
 
+// TraverseDelegatecall 在合约中每个调用了 delegatecall 的语句之后插入 opt.ExpressionStatement。
 func (cd *ContractDefinition) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if len(cd.nodes) > 0 {
-		for _, node := range cd.nodes {
-			switch n := node.(type) {
-			case *FunctionDefinition:
-				n.TraverseDelegatecall(opt, logger)
-			}
-		}
+	if opt.ExpressionStatement != nil {
+		insertAfterCalls(cd, opt.ExpressionStatement, IsDelegatecall)
 	}
 }
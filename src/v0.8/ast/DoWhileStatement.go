@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -28,11 +28,23 @@ func (dws *DoWhileStatement) SourceCode(isSc bool, isIndent bool, indent string,
 		switch body := dws.body.(type) {
 		case *Block:
 			code = code + body.SourceCode(false, false, indent, logger)
+		case *ExpressionStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *RevertStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Return:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *IfStatement:
+			code = code + body.SourceCode(false, true, indent+"    ", logger)
+		case *Break:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Continue:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
 		default:
 			if body != nil {
-				logger.Warnf("Unknown body nodeType [%s] for DoWhileStatement [src:%s].", body.Type(), dws.Src)
+				src.Dropf(logger, "Unknown body nodeType [%s] for DoWhileStatement [src:%s].", body.Type(), dws.Src)
 			} else {
-				logger.Warnf("Unknown body nodeType for DoWhileStatement [src:%s].", dws.Src)
+				src.Dropf(logger, "Unknown body nodeType for DoWhileStatement [src:%s].", dws.Src)
 			}
 		}
 	}
@@ -44,20 +56,7 @@ func (dws *DoWhileStatement) SourceCode(isSc bool, isIndent bool, indent string,
 	code = code + "} while"
 
 	if dws.condition != nil {
-		switch condition := dws.condition.(type) {
-		case *FunctionCall:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ")"
-		case *BinaryOperation:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ")"
-		case *Literal:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ")"
-		default:
-			if condition != nil {
-				logger.Warnf("Unknown condition nodeType [%s] for DoWhileStatement [src:%s].", condition.Type(), dws.Src)
-			} else {
-				logger.Warnf("Unknown condition nodeType for DoWhileStatement [src:%s].", dws.Src)
-			}
-		}
+		code = code + " (" + ExpressionCode(dws.condition, indent, "condition", "DoWhileStatement", dws.Src, logger) + ")"
 	}
 
 	if isSc {
@@ -81,7 +80,7 @@ func (dws *DoWhileStatement) NodeID() int {
 
 func GetDoWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*DoWhileStatement, error) {
 	dws := new(DoWhileStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), dws); err != nil {
+	if err := src.UnmarshalAny(raw, dws); err != nil {
 		logger.Errorf("Failed to unmarshal DoWhileStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal DoWhileStatement: [%v]", err)
 	}
@@ -97,8 +96,21 @@ func GetDoWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logge
 			switch bodyNodeType {
 			case "Block":
 				dwsBody, err = GetBlock(gn, body, logger)
+			case "ExpressionStatement":
+				dwsBody, err = GetExpressionStatement(gn, body, logger)
+			case "RevertStatement":
+				dwsBody, err = GetRevertStatement(gn, body, logger)
+			case "Return":
+				dwsBody, err = GetReturn(gn, body, logger)
+			case "IfStatement":
+				dwsBody, err = GetIfStatement(gn, body, logger)
+			case "Break":
+				dwsBody, err = GetBreak(gn, body, logger)
+			case "Continue":
+				dwsBody, err = GetContinue(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for DoWhileStatement [src:%s].", bodyNodeType, dws.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -115,20 +127,10 @@ func GetDoWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logge
 	{
 		condition := raw.Get("condition")
 		if condition.Size() > 0 {
-			conditionNodeType := condition.Get("nodeType").ToString()
 			var dwsCondition ASTNode
 			var err error
 
-			switch conditionNodeType {
-			case "FunctionCall":
-				dwsCondition, err = GetFunctionCall(gn, condition, logger)
-			case "BinaryOperation":
-				dwsCondition, err = GetBinaryOperation(gn, condition, logger)
-			case "Literal":
-				dwsCondition, err = GetLiteral(gn, condition, logger)
-			default:
-				logger.Warnf("Unknown condition nodeType [%s] for DoWhileStatement [src:%s].", conditionNodeType, dws.Src)
-			}
+			dwsCondition, err = GetExpression(gn, condition, "condition", "DoWhileStatement", dws.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -143,45 +145,4 @@ func GetDoWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logge
 	gn.AddASTNode(dws)
 
 	return dws, nil
-}
-
-func (dws *DoWhileStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if dws.body != nil {
-		switch body := dws.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if dws.condition != nil {
-		switch condition := dws.condition.(type) {
-		case *FunctionCall:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (dws *DoWhileStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if dws.body != nil {
-		switch body := dws.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
-	}
-}
-
-func (dws *DoWhileStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if dws.body != nil {
-		switch body := dws.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if dws.condition != nil {
-		switch condition := dws.condition.(type) {
-		case *FunctionCall:
-			condition.TraverseDelegatecall(opt, logger)
-		}
-	}
 }
\ No newline at end of file
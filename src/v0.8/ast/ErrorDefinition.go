@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -32,9 +32,9 @@ func (ed *ErrorDefinition) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 		default:
 			if parameters != nil {
-				logger.Warnf("Unknown parameters nodeType [%s] for ErrorDefinition [src:%s].", parameters.Type(), ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType [%s] for ErrorDefinition [src:%s].", parameters.Type(), ed.Src)
 			} else {
-				logger.Warnf("Unknown parameters nodeType for ErrorDefinition [src:%s].", ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType for ErrorDefinition [src:%s].", ed.Src)
 			}
 		}
 	}
@@ -60,7 +60,7 @@ func (ed *ErrorDefinition) NodeID() int {
 
 func GetErrorDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ErrorDefinition, error) {
 	ed := new(ErrorDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), ed); err != nil {
+	if err := src.UnmarshalAny(raw, ed); err != nil {
 		logger.Errorf("Failed to unmarshal ErrorDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ErrorDefinition: [%v]", err)
 	}
@@ -78,6 +78,7 @@ func GetErrorDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				edParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for ErrorDefinition [src:%s].", parametersNodeType, ed.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
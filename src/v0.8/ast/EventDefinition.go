@@ -1,15 +1,16 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
 type EventDefinition struct {
-	Anonymous     bool   `json:"anonymous"`
+	Anonymous     bool `json:"anonymous"`
+	documentation ASTNode
 	EventSelector string `json:"eventSelector"`
 	ID            int    `json:"id"`
 	Name          string `json:"name"`
@@ -21,6 +22,23 @@ type EventDefinition struct {
 
 func (ed *EventDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
 	var code string
+
+	// documentation
+	{
+		if ed.documentation != nil {
+			switch documentation := ed.documentation.(type) {
+			case *StructuredDocumentation:
+				code = code + documentation.SourceCode(false, isIndent, indent, logger) + "\n"
+			default:
+				if documentation != nil {
+					src.Dropf(logger, "Unknown documentation nodeType [%s] for EventDefinition [src:%s].", documentation.Type(), ed.Src)
+				} else {
+					src.Dropf(logger, "Unknown documentation nodeType for EventDefinition [src:%s].", ed.Src)
+				}
+			}
+		}
+	}
+
 	if isIndent {
 		code = code + indent
 	}
@@ -33,9 +51,9 @@ func (ed *EventDefinition) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 		default:
 			if parameters != nil {
-				logger.Warnf("Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parameters.Type(), ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parameters.Type(), ed.Src)
 			} else {
-				logger.Warnf("Unknown parameters nodeType for EventDefinition [src:%s].", ed.Src)
+				src.Dropf(logger, "Unknown parameters nodeType for EventDefinition [src:%s].", ed.Src)
 			}
 		}
 	}
@@ -61,11 +79,37 @@ func (ed *EventDefinition) NodeID() int {
 
 func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*EventDefinition, error) {
 	ed := new(EventDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), ed); err != nil {
+	if err := src.UnmarshalAny(raw, ed); err != nil {
 		logger.Errorf("Failed to unmarshal EventDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal EventDefinition: [%v]", err)
 	}
 
+	// documentation
+	{
+		documentation := raw.Get("documentation")
+		if documentation.Size() > 0 {
+			documentationNodeType := documentation.Get("nodeType").ToString()
+			var edDocumentation ASTNode
+			var err error
+
+			switch documentationNodeType {
+			case "StructuredDocumentation":
+				edDocumentation, err = GetStructuredDocumentation(gn, documentation, logger)
+			default:
+				logger.Warnf("Unknown documentation nodeType [%s] for EventDefinition [src:%s].", documentationNodeType, ed.Src)
+				gn.AddUnknownNode(documentationNodeType, documentation.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if edDocumentation != nil {
+				ed.documentation = edDocumentation
+			}
+		}
+	}
+
 	parameters := raw.Get("parameters")
 	if parameters.Size() > 0 {
 		var edParameters ASTNode
@@ -77,6 +121,7 @@ func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 			edParameters, err = GetParameterList(gn, parameters, logger)
 		default:
 			logger.Warnf("Unknown parameters nodeType [%s] for EventDefinition [src:%s].", parametersNodeType, ed.Src)
+			gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -91,14 +136,3 @@ func GetEventDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 	return ed, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (ed *EventDefinition) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if ed.parameters != nil {
-		switch parameters := ed.parameters.(type) {
-		case *ParameterList:
-			parameters.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
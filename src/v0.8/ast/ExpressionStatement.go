@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -29,22 +29,7 @@ func (es *ExpressionStatement) SourceCode(isSc bool, isIndent bool, indent strin
 
 	// expression
 	if es.expression != nil {
-		switch expression := es.expression.(type) {
-		case *Assignment:
-			code = code + expression.SourceCode(false, false, indent, logger)
-		case *FunctionCall:
-			code = code + expression.SourceCode(false, false, indent, logger)
-		case *UnaryOperation:
-			code = code + expression.SourceCode(false, false, indent, logger)
-		case *Identifier:
-			code = code + expression.SourceCode(false, false, indent, logger)
-		default:
-			if expression != nil {
-				logger.Warnf("Unknown expression nodeType [%s] for ExpressionStatement [src:%s].", expression.Type(), es.Src)
-			} else {
-				logger.Warnf("Unknown expression nodeType for ExpressionStatement [src:%s].", es.Src)
-			}
-		}
+		code = code + ExpressionCode(es.expression, indent, "expression", "ExpressionStatement", es.Src, logger)
 
 		if es.trackMapping != nil && !instrumentedTrack[es.trackMapping.SourceCode(false, true, indent, logger)] {
 			code = code + ";"
@@ -92,7 +77,7 @@ func (es *ExpressionStatement) NodeID() int {
 
 func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ExpressionStatement, error) {
 	es := new(ExpressionStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), es); err != nil {
+	if err := src.UnmarshalAny(raw, es); err != nil {
 		logger.Errorf("Failed to unmarshal ExpressionStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ExpressionStatement: [%v]", err)
 	}
@@ -101,22 +86,10 @@ func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 	{
 		expression := raw.Get("expression")
 		if expression.Size() > 0 {
-			var expressionNodeType = expression.Get("nodeType").ToString()
 			var esExpression ASTNode
 			var err error
 
-			switch expressionNodeType {
-			case "Assignment":
-				esExpression, err = GetAssignment(gn, expression, logger)
-			case "FunctionCall":
-				esExpression, err = GetFunctionCall(gn, expression, logger)
-			case "UnaryOperation":
-				esExpression, err = GetUnaryOperation(gn, expression, logger)
-			case "Identifier":
-				esExpression, err = GetIdentifier(gn, expression, logger)
-			default:
-				logger.Warnf("Unknown expression nodeType [%s] for ExpressionStatement [src:%s].", expressionNodeType, es.Src)
-			}
+			esExpression, err = GetExpression(gn, expression, "expression", "ExpressionStatement", es.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -135,20 +108,9 @@ func GetExpressionStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (es *ExpressionStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if es.expression != nil {
-		switch expression := es.expression.(type) {
-		case *Assignment:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *UnaryOperation:
-			expression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
+// TraverseTaintOwner 只处理语句本身，语句块中的语句由 ContractDefinition.TraverseTaintOwner 遍历。
 func (es *ExpressionStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
+	opt.IsTainted = false
 	if es.expression != nil {
 		switch expression := es.expression.(type) {
 		case *Assignment:
@@ -190,15 +152,6 @@ func (es *ExpressionStatement) TraverseTaintOwner(opt *Option, logger logging.Lo
 	}
 }
 
-func (es *ExpressionStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if es.expression != nil {
-		switch expression := es.expression.(type) {
-		case *Assignment:
-			expression.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
-
 func (es *ExpressionStatement) SetExpression(expression ASTNode) {
 	es.expression = expression
 }
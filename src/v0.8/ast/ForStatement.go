@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,9 +37,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + initializationExpression.SourceCode(false, false, indent, logger)
 		default:
 			if initializationExpression != nil {
-				logger.Warnf("Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpression.Type(), fs.Src)
+				src.Dropf(logger, "Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpression.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown initializationExpression nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown initializationExpression nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -47,16 +47,7 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 	code = code + ";"
 
 	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			code = code + " " + condition.SourceCode(false, false, indent, logger)
-		default:
-			if condition != nil {
-				logger.Warnf("Unknown condition nodeType [%s] for ForStatement [src:%s].", condition.Type(), fs.Src)
-			} else {
-				logger.Warnf("Unknown condition nodeType for ForStatement [src:%s].", fs.Src)
-			}
-		}
+		code = code + " " + ExpressionCode(fs.condition, indent, "condition", "ForStatement", fs.Src, logger)
 	}
 
 	code = code + ";"
@@ -67,9 +58,9 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + " " + loopExpression.SourceCode(false, false, indent, logger)
 		default:
 			if loopExpression != nil {
-				logger.Warnf("Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpression.Type(), fs.Src)
+				src.Dropf(logger, "Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpression.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown loopExpression nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown loopExpression nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -80,11 +71,23 @@ func (fs *ForStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 		switch body := fs.body.(type) {
 		case *Block:
 			code = code + body.SourceCode(false, false, indent, logger)
+		case *ExpressionStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *RevertStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Return:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *IfStatement:
+			code = code + body.SourceCode(false, true, indent+"    ", logger)
+		case *Break:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Continue:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
 		default:
 			if body != nil {
-				logger.Warnf("Unknown body nodeType [%s] for ForStatement [src:%s].", body.Type(), fs.Src)
+				src.Dropf(logger, "Unknown body nodeType [%s] for ForStatement [src:%s].", body.Type(), fs.Src)
 			} else {
-				logger.Warnf("Unknown body nodeType for ForStatement [src:%s].", fs.Src)
+				src.Dropf(logger, "Unknown body nodeType for ForStatement [src:%s].", fs.Src)
 			}
 		}
 	}
@@ -114,7 +117,7 @@ func (fs *ForStatement) NodeID() int {
 
 func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ForStatement, error) {
 	fs := new(ForStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), fs); err != nil {
+	if err := src.UnmarshalAny(raw, fs); err != nil {
 		logger.Errorf("Failed to unmarshal ForStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ForStatement: [%v]", err)
 	}
@@ -132,6 +135,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsInitializationExpression, err = GetVariableDeclarationStatement(gn, initializationExpression, logger)
 			default:
 				logger.Warnf("Unknown initializationExpression nodeType [%s] for ForStatement [src:%s].", initializationExpressionNodeType, fs.Src)
+				gn.AddUnknownNode(initializationExpressionNodeType, initializationExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -148,16 +152,10 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 	{
 		condition := raw.Get("condition")
 		if condition.Size() > 0 {
-			conditionNodeType := condition.Get("nodeType").ToString()
 			var fsCondition ASTNode
 			var err error
 
-			switch conditionNodeType {
-			case "BinaryOperation":
-				fsCondition, err = GetBinaryOperation(gn, condition, logger)
-			default:
-				logger.Warnf("Unknown condition nodeType [%s] for ForStatement [src:%s].", conditionNodeType, fs.Src)
-			}
+			fsCondition, err = GetExpression(gn, condition, "condition", "ForStatement", fs.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -182,6 +180,7 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				fsLoopExpression, err = GetExpressionStatement(gn, loopExpression, logger)
 			default:
 				logger.Warnf("Unknown loopExpression nodeType [%s] for ForStatement [src:%s].", loopExpressionNodeType, fs.Src)
+				gn.AddUnknownNode(loopExpressionNodeType, loopExpression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -205,8 +204,21 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 			switch bodyNodeType {
 			case "Block":
 				fsBody, err = GetBlock(gn, body, logger)
+			case "ExpressionStatement":
+				fsBody, err = GetExpressionStatement(gn, body, logger)
+			case "RevertStatement":
+				fsBody, err = GetRevertStatement(gn, body, logger)
+			case "Return":
+				fsBody, err = GetReturn(gn, body, logger)
+			case "IfStatement":
+				fsBody, err = GetIfStatement(gn, body, logger)
+			case "Break":
+				fsBody, err = GetBreak(gn, body, logger)
+			case "Continue":
+				fsBody, err = GetContinue(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for ForStatement [src:%s].", bodyNodeType, fs.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -223,81 +235,3 @@ func GetForStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 	return fs, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (fs *ForStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if fs.initializationExpression != nil {
-		switch initializationExpression := fs.initializationExpression.(type) {
-		case *VariableDeclarationStatement:
-			initializationExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (fs *ForStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseTaintOwner(opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
-	}
-}
-
-func (fs *ForStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if fs.initializationExpression != nil {
-		switch initializationExpression := fs.initializationExpression.(type) {
-		case *VariableDeclarationStatement:
-			initializationExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.condition != nil {
-		switch condition := fs.condition.(type) {
-		case *BinaryOperation:
-			condition.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.loopExpression != nil {
-		switch loopExpression := fs.loopExpression.(type) {
-		case *ExpressionStatement:
-			loopExpression.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if fs.body != nil {
-		switch body := fs.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
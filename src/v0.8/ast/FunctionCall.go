@@ -1,10 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/types"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -39,39 +40,23 @@ func (fc *FunctionCall) SourceCode(isSc bool, isIndent bool, indent string, logg
 	// expression
 	{
 		if fc.expression != nil {
+			argumentCount := -1
 			switch expression := fc.expression.(type) {
 			case *Identifier:
-				if len(expression.ArgumentTypes) != len(fc.arguments) {
-					logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", len(expression.ArgumentTypes), len(fc.arguments), fc.Src)
-				}
-				code = code + expression.SourceCode(false, false, indent, logger)
+				argumentCount = len(expression.ArgumentTypes)
 			case *MemberAccess:
-				if len(expression.ArgumentTypes) != len(fc.arguments) {
-					logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", len(expression.ArgumentTypes), len(fc.arguments), fc.Src)
-				}
-				code = code + expression.SourceCode(false, false, indent, logger)
+				argumentCount = len(expression.ArgumentTypes)
 			case *ElementaryTypeNameExpression:
-				if len(expression.ArgumentTypes) != len(fc.arguments) {
-					logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", len(expression.ArgumentTypes), len(fc.arguments), fc.Src)
-				}
-				code = code + expression.SourceCode(false, false, indent, logger)
+				argumentCount = len(expression.ArgumentTypes)
 			case *NewExpression:
-				if len(expression.ArgumentTypes) != len(fc.arguments) {
-					logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", len(expression.ArgumentTypes), len(fc.arguments), fc.Src)
-				}
-				code = code + expression.SourceCode(false, false, indent, logger)
+				argumentCount = len(expression.ArgumentTypes)
 			case *FunctionCallOptions:
-				if len(expression.ArgumentTypes) != len(fc.arguments) {
-					logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", len(expression.ArgumentTypes), len(fc.arguments), fc.Src)
-				}
-				code = code + expression.SourceCode(false, false, indent, logger)
-			default:
-				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for FunctionCall [src:%s].", expression.Type(), fc.Src)
-				} else {
-					logger.Warnf("Unknown expression nodeType for FunctionCall [src:%s].", fc.Src)
-				}
+				argumentCount = len(expression.ArgumentTypes)
 			}
+			if argumentCount >= 0 && argumentCount != len(fc.arguments) {
+				logger.Warnf("Number of arguments mismatch [%d:%d] in FunctionCall: [src:%s].", argumentCount, len(fc.arguments), fc.Src)
+			}
+			code = code + ExpressionCode(fc.expression, indent, "expression", "FunctionCall", fc.Src, logger)
 		}
 	}
 
@@ -81,34 +66,7 @@ func (fc *FunctionCall) SourceCode(isSc bool, isIndent bool, indent string, logg
 	{
 		if len(fc.arguments) > 0 {
 			for index, argument := range fc.arguments {
-				switch arg := argument.(type) {
-				case *FunctionCall:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *Identifier:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *Literal:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *BinaryOperation:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *MemberAccess:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *UnaryOperation:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *Conditional:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *ElementaryTypeNameExpression:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *TupleExpression:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				case *IndexAccess:
-					code = code + arg.SourceCode(false, false, indent, logger)
-				default:
-					if arg != nil {
-						logger.Warnf("Unknown argument nodeType [%s] for FunctionCall [src:%s].", arg.Type(), fc.Src)
-					} else {
-						logger.Warnf("Unknown argument nodeType for FunctionCall [src:%s].", fc.Src)
-					}
-				}
+				code = code + ExpressionCode(argument, indent, "argument", "FunctionCall", fc.Src, logger)
 				if index < len(fc.arguments)-1 {
 					code = code + ", "
 				}
@@ -144,7 +102,7 @@ func (fc *FunctionCall) NodeID() int {
 func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionCall, error) {
 	fc := new(FunctionCall)
 	fc.referencedFunctionDefinition = -1
-	if err := json.Unmarshal([]byte(raw.ToString()), fc); err != nil {
+	if err := src.UnmarshalAny(raw, fc); err != nil {
 		logger.Errorf("Failed to unmarshal FunctionCall: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal FunctionCall: [%v]", err)
 	}
@@ -153,27 +111,17 @@ func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 	{
 		expression := raw.Get("expression")
 		if expression.Size() > 0 {
-			expressionNodeType := expression.Get("nodeType").ToString()
 			var fcExpression ASTNode
 			var err error
 
-			switch expressionNodeType {
-			case "Identifier":
-				fcExpression, err = GetIdentifier(gn, expression, logger)
-				identifier, _ := fcExpression.(*Identifier)
-				fc.referencedFunctionDefinition = identifier.ReferencedDeclaration
-			case "MemberAccess":
-				fcExpression, err = GetMemberAccess(gn, expression, logger)
-				memberAccess, _ := fcExpression.(*MemberAccess)
-				fc.referencedFunctionDefinition = memberAccess.ReferencedDeclaration
-			case "ElementaryTypeNameExpression":
-				fcExpression, err = GetElementaryTypeNameExpression(gn, expression, logger)
-			case "NewExpression":
-				fcExpression, err = GetNewExpression(gn, expression, logger)
-			case "FunctionCallOptions":
-				fcExpression, err = GetFunctionCallOptions(gn, expression, logger)
-			default:
-				logger.Warnf("Unknown expression nodeType [%s] for FunctionCall [src:%s].", expressionNodeType, fc.Src)
+			fcExpression, err = GetExpression(gn, expression, "expression", "FunctionCall", fc.Src, logger)
+			if err == nil {
+				switch e := fcExpression.(type) {
+				case *Identifier:
+					fc.referencedFunctionDefinition = e.ReferencedDeclaration
+				case *MemberAccess:
+					fc.referencedFunctionDefinition = e.ReferencedDeclaration
+				}
 			}
 
 			if err != nil {
@@ -194,34 +142,10 @@ func GetFunctionCall(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 			for i := 0; i < arguments.Size(); i++ {
 				argument := arguments.Get(i)
-				argumentNodeType := argument.Get("nodeType").ToString()
 				var fcArgument ASTNode
 				var err error
 
-				switch argumentNodeType {
-				case "FunctionCall":
-					fcArgument, err = GetFunctionCall(gn, argument, logger)
-				case "Identifier":
-					fcArgument, err = GetIdentifier(gn, argument, logger)
-				case "Literal":
-					fcArgument, err = GetLiteral(gn, argument, logger)
-				case "BinaryOperation":
-					fcArgument, err = GetBinaryOperation(gn, argument, logger)
-				case "MemberAccess":
-					fcArgument, err = GetMemberAccess(gn, argument, logger)
-				case "UnaryOperation":
-					fcArgument, err = GetUnaryOperation(gn, argument, logger)
-				case "Conditional":
-					fcArgument, err = GetConditional(gn, argument, logger)
-				case "ElementaryTypeNameExpression":
-					fcArgument, err = GetElementaryTypeNameExpression(gn, argument, logger)
-				case "TupleExpression":
-					fcArgument, err = GetTupleExpression(gn, argument, logger)
-				case "IndexAccess":
-					fcArgument, err = GetIndexAccess(gn, argument, logger)
-				default:
-					logger.Warnf("Unknown argument nodeType [%s] for FunctionCall [src:%s].", argumentNodeType, fc.Src)
-				}
+				fcArgument, err = GetExpression(gn, argument, "argument", "FunctionCall", fc.Src, logger)
 
 				if err != nil {
 					return nil, err
@@ -245,6 +169,7 @@ func (fc *FunctionCall) ReferencedFunctionDefinition() int {
 	return fc.referencedFunctionDefinition
 }
 
+// TraverseFunctionCall 只处理调用本身，参数等子节点中的调用由 FunctionDefinition.TraverseFunctionCall 遍历。
 func (fc *FunctionCall) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
 	if fc.ReferencedFunctionDefinition() != -1 {
 		fd := gn.Functions()[fc.ReferencedFunctionDefinition()]
@@ -254,35 +179,34 @@ func (fc *FunctionCall) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNode
 		}
 	}
 
-	if fc.expression != nil {
-		switch fcExpression := fc.expression.(type) {
-		case *MemberAccess:
-			if fcExpression.MemberName == "delegatecall" {
-				if fcExpression.expression != nil {
-					switch maExpression := fcExpression.expression.(type) {
-					case *FunctionCall:
-						if maExpression.Kind == "typeConversion" && maExpression.TypeDescriptions.TypeString == "address" {
-							if maExpression.expression != nil {
-								switch fcExpression2 := maExpression.expression.(type) {
-								case *ElementaryTypeNameExpression:
-									if fcExpression2.ArgumentTypes[0].TypeString[0:8] == "contract" {
-										logger.Debugf("An explicit contract [%s] is being called using delegatecall.", fcExpression2.ArgumentTypes[0].TypeString[9:])
+	if fcExpression := delegatecallMember(fc); fcExpression != nil {
+		if fcExpression.expression != nil {
+			switch maExpression := fcExpression.expression.(type) {
+			case *FunctionCall:
+				if maExpression.Kind == "typeConversion" && types.IsAddress(maExpression.TypeDescriptions.TypeIdentifier) {
+					if maExpression.expression != nil {
+						switch fcExpression2 := maExpression.expression.(type) {
+						case *ElementaryTypeNameExpression:
+							if len(fcExpression2.ArgumentTypes) > 0 {
+								if contractName := types.ContractName(fcExpression2.ArgumentTypes[0].TypeIdentifier); contractName != "" {
+									logger.Debugf("An explicit contract [%s] is being called using delegatecall.", contractName)
+									if opt != nil {
 										select {
-										case opt.delegatecallKnownContractCh <- fcExpression2.ArgumentTypes[0].TypeString[9:]:
+										case opt.delegatecallKnownContractCh <- types.ContractID(fcExpression2.ArgumentTypes[0].TypeIdentifier):
 										default:
 										}
 									}
 								}
 							}
 						}
+					}
+				}
+			default:
+				// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
+				if opt != nil {
+					select {
+					case opt.delegatecallUnknownContractCh <- struct{}{}:
 					default:
-						// logger.Warnf("A contract with an unknown address is being called using delegatecall.")
-						if opt != nil {
-							select {
-							case opt.delegatecallUnknownContractCh <- struct{}{}:
-							default:
-							}
-						}
 					}
 				}
 			}
@@ -290,10 +214,6 @@ func (fc *FunctionCall) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNode
 	}
 }
 
-func (fc *FunctionCall) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-
-}
-
 func (fc *FunctionCall) SetExpression(expression ASTNode) {
 	fc.expression = expression
 }
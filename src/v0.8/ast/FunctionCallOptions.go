@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,16 +37,7 @@ func (fco *FunctionCallOptions) SourceCode(isSc bool, isIndent bool, indent stri
 	}
 
 	if fco.expression != nil {
-		switch expression := fco.expression.(type) {
-		case *MemberAccess:
-			code = code + expression.SourceCode(false, false, indent, logger)
-		default:
-			if expression != nil {
-				logger.Warnf("Unknown expression nodeType [%s] for FunctionCallOptions [src:%s].", expression.Type(), fco.Src)
-			} else {
-				logger.Warnf("Unknown expression nodeType for FunctionCallOptions [src:%s].", fco.Src)
-			}
-		}
+		code = code + ExpressionCode(fco.expression, indent, "expression", "FunctionCallOptions", fco.Src, logger)
 	}
 
 	code = code + "{"
@@ -57,20 +48,7 @@ func (fco *FunctionCallOptions) SourceCode(isSc bool, isIndent bool, indent stri
 
 	for index, option := range fco.options {
 		name := fco.Names[index]
-		switch opt := option.(type) {
-		case *MemberAccess:
-			code = code + name + ": " + opt.SourceCode(false, false, indent, logger)
-		case *Identifier:
-			code = code + name + ": " + opt.SourceCode(false, false, indent, logger)
-		case *BinaryOperation:
-			code = code + name + ": " + opt.SourceCode(false, false, indent, logger)
-		default:
-			if opt != nil {
-				logger.Warnf("Unknown option nodeType [%s] for FunctionCallOptions [src:%s].", opt.Type(), fco.Src)
-			} else {
-				logger.Warnf("Unknown option nodeType for FunctionCallOptions [src:%s].", fco.Src)
-			}
-		}
+		code = code + name + ": " + ExpressionCode(option, indent, "option", "FunctionCallOptions", fco.Src, logger)
 		if index < len(fco.options)-1 {
 			code = code + ", "
 		}
@@ -95,7 +73,7 @@ func (fco *FunctionCallOptions) NodeID() int {
 
 func GetFunctionCallOptions(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionCallOptions, error) {
 	fco := new(FunctionCallOptions)
-	if err := json.Unmarshal([]byte(raw.ToString()), fco); err != nil {
+	if err := src.UnmarshalAny(raw, fco); err != nil {
 		logger.Errorf("Failed to unmarshal FunctionCallOptions: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal FunctionCallOptions: [%v]", err)
 	}
@@ -104,16 +82,10 @@ func GetFunctionCallOptions(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 	{
 		expression := raw.Get("expression")
 		if expression.Size() > 0 {
-			expressionNodeType := expression.Get("nodeType").ToString()
 			var fcoExpression ASTNode
 			var err error
 
-			switch expressionNodeType {
-			case "MemberAccess":
-				fcoExpression, err = GetMemberAccess(gn, expression, logger)
-			default:
-				logger.Warnf("Unknown expression nodeType [%s] for FunctionCallOptions [src:%s].", expressionNodeType, fco.Src)
-			}
+			fcoExpression, err = GetExpression(gn, expression, "expression", "FunctionCallOptions", fco.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -136,20 +108,10 @@ func GetFunctionCallOptions(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 			for i := 0; i < options.Size(); i++ {
 				option := options.Get(i)
 				if option.Size() > 0 {
-					optionNodeType := option.Get("nodeType").ToString()
 					var fcoOption ASTNode
 					var err error
 
-					switch optionNodeType {
-					case "MemberAccess":
-						fcoOption, err = GetMemberAccess(gn, option, logger)
-					case "Identifier":
-						fcoOption, err = GetIdentifier(gn, option, logger)
-					case "BinaryOperation":
-						fcoOption, err = GetBinaryOperation(gn, option, logger)
-					default:
-						logger.Warnf("Unknown option nodeType [%s] for FunctionCallOptions [src:%s].", optionNodeType, fco.Src)
-					}
+					fcoOption, err = GetExpression(gn, option, "option", "FunctionCallOptions", fco.Src, logger)
 
 					if err != nil {
 						return nil, err
@@ -167,41 +129,3 @@ func GetFunctionCallOptions(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 
 	return fco, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (fco *FunctionCallOptions) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt_ *Option, logger logging.Logger) {
-	if fco.expression != nil {
-		switch expression := fco.expression.(type) {
-		case *MemberAccess:
-			expression.TraverseFunctionCall(ncp, gn, opt_, logger)
-		}
-	}
-
-	for _, option := range fco.options {
-		switch opt := option.(type) {
-		case *MemberAccess:
-			opt.TraverseFunctionCall(ncp, gn, opt_, logger)
-		case *BinaryOperation:
-			opt.TraverseFunctionCall(ncp, gn, opt_, logger)
-		}
-	}
-}
-
-func (fco *FunctionCallOptions) TraverseDelegatecall(opt_ *Option, logger logging.Logger) {
-	if fco.expression != nil {
-		switch expression := fco.expression.(type) {
-		case *MemberAccess:
-			expression.TraverseDelegatecall(opt_, logger)
-		}
-	}
-
-	for _, option := range fco.options {
-		switch opt := option.(type) {
-		case *MemberAccess:
-			opt.TraverseDelegatecall(opt_, logger)
-		case *BinaryOperation:
-			opt.TraverseDelegatecall(opt_, logger)
-		}
-	}
-}
@@ -1,16 +1,17 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
 type FunctionDefinition struct {
 	BaseFunctions    []int `json:"baseFunctions"`
 	body             ASTNode
+	documentation    ASTNode
 	FunctionSelector string `json:"functionSelector"`
 	ID               int    `json:"id"`
 	Implemented      bool   `json:"implemented"`
@@ -34,11 +35,27 @@ type FunctionDefinition struct {
 func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
 	var code string
 
+	// documentation
+	{
+		if fd.documentation != nil {
+			switch documentation := fd.documentation.(type) {
+			case *StructuredDocumentation:
+				code = code + documentation.SourceCode(false, isIndent, indent, logger) + "\n"
+			default:
+				if documentation != nil {
+					src.Dropf(logger, "Unknown documentation nodeType [%s] for FunctionDefinition [src:%s].", documentation.Type(), fd.Src)
+				} else {
+					src.Dropf(logger, "Unknown documentation nodeType for FunctionDefinition [src:%s].", fd.Src)
+				}
+			}
+		}
+	}
+
 	if isIndent {
 		code = code + indent
 	}
 
-	if fd.Kind == "function" {
+	if fd.Kind == "function" || fd.Kind == "freeFunction" {
 		code = code + "function" + " " + fd.Name + "("
 
 		// parameters
@@ -49,9 +66,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -59,28 +76,24 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 
 		code = code + ")"
 
-		// visibility
-		if fd.Visibility != "" {
+		// visibility, free functions must not declare one.
+		if fd.Visibility != "" && fd.Kind != "freeFunction" {
 			code = code + " " + fd.Visibility
 		}
 
 		// modifiers
 		if len(fd.modifiers) > 0 {
-			for index, modifier := range fd.modifiers {
+			for _, modifier := range fd.modifiers {
 				switch m := modifier.(type) {
 				case *ModifierInvocation:
 					code = code + " " + m.SourceCode(false, false, indent, logger)
 				default:
 					if m != nil {
-						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
-
-				if index < len(fd.modifiers)-1 {
-					code = code + ","
-				}
 			}
 		}
 
@@ -96,9 +109,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + " " + overrides.SourceCode(false, false, indent, logger)
 			default:
 				if overrides != nil {
-					logger.Warnf("Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overrides.Type(), fd.Src)
+					src.Dropf(logger, "Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overrides.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown overrides nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown overrides nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 		}
@@ -117,9 +130,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				}
 			default:
 				if returnParameters != nil {
-					logger.Warnf("Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParameters.Type(), fd.Src)
+					src.Dropf(logger, "Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParameters.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown returnParameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown returnParameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 		}
@@ -134,9 +147,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -146,21 +159,17 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 
 		// modifiers
 		if len(fd.modifiers) > 0 {
-			for index, modifier := range fd.modifiers {
+			for _, modifier := range fd.modifiers {
 				switch m := modifier.(type) {
 				case *ModifierInvocation:
 					code = code + " " + m.SourceCode(false, false, indent, logger)
 				default:
 					if m != nil {
-						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", m.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown modifier nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
-
-				if index < len(fd.modifiers)-1 {
-					code = code + ","
-				}
 			}
 		}
 
@@ -179,9 +188,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 					code = code + parameters.SourceCode(false, false, indent, logger)
 				default:
 					if parameters != nil {
-						logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parameters.Type(), fd.Src)
 					} else {
-						logger.Warnf("Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
+						src.Dropf(logger, "Unknown parameters nodeType for FunctionDefinition [src:%s].", fd.Src)
 					}
 				}
 			}
@@ -226,9 +235,9 @@ func (fd *FunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for FunctionDefinition [src:%s].", body.Type(), fd.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for FunctionDefinition [src:%s].", body.Type(), fd.Src)
 				} else {
-					logger.Warnf("Unknown body nodeType for FunctionDefinition [src:%s].", fd.Src)
+					src.Dropf(logger, "Unknown body nodeType for FunctionDefinition [src:%s].", fd.Src)
 				}
 			}
 
@@ -265,11 +274,37 @@ func (fd *FunctionDefinition) NodeID() int {
 
 func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionDefinition, error) {
 	fd := new(FunctionDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), fd); err != nil {
+	if err := src.UnmarshalAny(raw, fd); err != nil {
 		logger.Errorf("Failed to unmarshal FunctionDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal FunctionDefinition: [%v]", err)
 	}
 
+	// documentation
+	{
+		documentation := raw.Get("documentation")
+		if documentation.Size() > 0 {
+			documentationNodeType := documentation.Get("nodeType").ToString()
+			var fdDocumentation ASTNode
+			var err error
+
+			switch documentationNodeType {
+			case "StructuredDocumentation":
+				fdDocumentation, err = GetStructuredDocumentation(gn, documentation, logger)
+			default:
+				logger.Warnf("Unknown documentation nodeType [%s] for FunctionDefinition [src:%s].", documentationNodeType, fd.Src)
+				gn.AddUnknownNode(documentationNodeType, documentation.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if fdDocumentation != nil {
+				fd.documentation = fdDocumentation
+			}
+		}
+	}
+
 	// modifiers
 	{
 		modifiers := raw.Get("modifiers")
@@ -288,6 +323,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 						fdModifier, err = GetModifierInvocation(gn, modifier, logger)
 					default:
 						logger.Warnf("Unknown modifier nodeType [%s] for FunctionDefinition [src:%s].", modifierNodeType, fd.Src)
+						gn.AddUnknownNode(modifierNodeType, modifier.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -317,6 +353,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdBody, err = GetBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for FunctionDefinition [src:%s].", bodyNodeType, fd.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -342,6 +379,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdOverrides, err = GetOverrideSpecifier(gn, overrides, logger)
 			default:
 				logger.Warnf("Unknown overrides nodeType [%s] for FunctionDefinition [src:%s].", overridesNodeType, fd.Src)
+				gn.AddUnknownNode(overridesNodeType, overrides.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -367,6 +405,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for FunctionDefinition [src:%s].", parametersNodeType, fd.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -392,6 +431,7 @@ func GetFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				fdReturnParameters, err = GetParameterList(gn, returnParameters, logger)
 			default:
 				logger.Warnf("Unknown returnParameters nodeType [%s] for FunctionDefinition [src:%s].", returnParametersNodeType, fd.Src)
+				gn.AddUnknownNode(returnParametersNodeType, returnParameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -419,6 +459,10 @@ func (fd *FunctionDefinition) MakeSignature(contractName string, logger logging.
 		signature = signature + "." + "constructor"
 	} else if fd.Kind == "receive" {
 		signature = signature + "." + "receive"
+	} else if fd.Kind == "fallback" {
+		signature = signature + "." + "fallback"
+	} else if fd.Kind == "freeFunction" {
+		signature = signature + fd.Name
 	}
 
 	signature = signature + "("
@@ -445,25 +489,18 @@ func (fd *FunctionDefinition) Signature() string {
 	return fd.signature
 }
 
+// TraverseFunctionCall 把函数体中的每个函数调用记录为 ncp 的被调用者，并通过 opt 报告其中的 delegatecall。
 func (fd *FunctionDefinition) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
 	ncp.SetCaller(fd.Signature(), fd.NodeID())
 
 	// Function call statements are generally inside functions.
 	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (fd *FunctionDefinition) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	opt.TrackFunctionDefinitionName = fd.Signature()
-	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
+		Inspect(fd.body, func(node ASTNode) bool {
+			if fc, ok := node.(*FunctionCall); ok {
+				fc.TraverseFunctionCall(ncp, gn, opt, logger)
+			}
+			return true
+		})
 	}
 }
 
@@ -474,13 +511,3 @@ func (fd *FunctionDefinition) SetBody(body ASTNode) {
 func (fd *FunctionDefinition) SetReturnParameters(returnParameters ASTNode) {
 	fd.returnParameters = returnParameters
 }
-
-func (fd *FunctionDefinition) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	opt.TrackFunctionDefinitionName = fd.Signature()
-	if fd.body != nil {
-		switch body := fd.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
\ No newline at end of file
@@ -0,0 +1,159 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type FunctionTypeName struct {
+	ID                   int    `json:"id"`
+	NodeType             string `json:"nodeType"`
+	parameterTypes       ASTNode
+	returnParameterTypes ASTNode
+	Src                  string `json:"src"`
+	StateMutability      string `json:"stateMutability"`
+	TypeDescriptions     struct {
+		TypeIdentifier string `json:"typeIdentifier"`
+		TypeString     string `json:"typeString"`
+	} `json:"typeDescriptions"`
+	Visibility string `json:"visibility"`
+}
+
+func (ftn *FunctionTypeName) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "function" + " " + "("
+
+	// parameterTypes
+	{
+		if ftn.parameterTypes != nil {
+			switch parameterTypes := ftn.parameterTypes.(type) {
+			case *ParameterList:
+				code = code + parameterTypes.SourceCode(false, false, indent, logger)
+			default:
+				if parameterTypes != nil {
+					src.Dropf(logger, "Unknown parameterTypes nodeType [%s] for FunctionTypeName [src:%s].", parameterTypes.Type(), ftn.Src)
+				} else {
+					src.Dropf(logger, "Unknown parameterTypes nodeType for FunctionTypeName [src:%s].", ftn.Src)
+				}
+			}
+		}
+	}
+
+	code = code + ")"
+
+	if ftn.Visibility != "" && ftn.Visibility != "internal" {
+		code = code + " " + ftn.Visibility
+	}
+
+	if ftn.StateMutability != "" && ftn.StateMutability != "nonpayable" {
+		code = code + " " + ftn.StateMutability
+	}
+
+	// returnParameterTypes
+	{
+		if ftn.returnParameterTypes != nil {
+			switch returnParameterTypes := ftn.returnParameterTypes.(type) {
+			case *ParameterList:
+				rpl := returnParameterTypes.SourceCode(false, false, indent, logger)
+				if rpl != "" {
+					code = code + " " + "returns" + " " + "(" + rpl + ")"
+				}
+			default:
+				if returnParameterTypes != nil {
+					src.Dropf(logger, "Unknown returnParameterTypes nodeType [%s] for FunctionTypeName [src:%s].", returnParameterTypes.Type(), ftn.Src)
+				} else {
+					src.Dropf(logger, "Unknown returnParameterTypes nodeType for FunctionTypeName [src:%s].", ftn.Src)
+				}
+			}
+		}
+	}
+
+	if isSc {
+		code = code + ";"
+	}
+
+	return code
+}
+
+func (ftn *FunctionTypeName) Type() string {
+	return ftn.NodeType
+}
+
+func (ftn *FunctionTypeName) Nodes() []ASTNode {
+	return nil
+}
+
+func (ftn *FunctionTypeName) NodeID() int {
+	return ftn.ID
+}
+
+func GetFunctionTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*FunctionTypeName, error) {
+	ftn := new(FunctionTypeName)
+	if err := src.UnmarshalAny(raw, ftn); err != nil {
+		logger.Errorf("Failed to unmarshal FunctionTypeName: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal FunctionTypeName: [%v]", err)
+	}
+
+	// parameterTypes
+	{
+		parameterTypes := raw.Get("parameterTypes")
+		if parameterTypes.Size() > 0 {
+			parameterTypesNodeType := parameterTypes.Get("nodeType").ToString()
+			var ftnParameterTypes ASTNode
+			var err error
+
+			switch parameterTypesNodeType {
+			case "ParameterList":
+				ftnParameterTypes, err = GetParameterList(gn, parameterTypes, logger)
+			default:
+				logger.Warnf("Unknown parameterTypes nodeType [%s] for FunctionTypeName [src:%s].", parameterTypesNodeType, ftn.Src)
+				gn.AddUnknownNode(parameterTypesNodeType, parameterTypes.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if ftnParameterTypes != nil {
+				ftn.parameterTypes = ftnParameterTypes
+			}
+		}
+	}
+
+	// returnParameterTypes
+	{
+		returnParameterTypes := raw.Get("returnParameterTypes")
+		if returnParameterTypes.Size() > 0 {
+			returnParameterTypesNodeType := returnParameterTypes.Get("nodeType").ToString()
+			var ftnReturnParameterTypes ASTNode
+			var err error
+
+			switch returnParameterTypesNodeType {
+			case "ParameterList":
+				ftnReturnParameterTypes, err = GetParameterList(gn, returnParameterTypes, logger)
+			default:
+				logger.Warnf("Unknown returnParameterTypes nodeType [%s] for FunctionTypeName [src:%s].", returnParameterTypesNodeType, ftn.Src)
+				gn.AddUnknownNode(returnParameterTypesNodeType, returnParameterTypes.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if ftnReturnParameterTypes != nil {
+				ftn.returnParameterTypes = ftnReturnParameterTypes
+			}
+		}
+	}
+
+	gn.AddASTNode(ftn)
+
+	return ftn, nil
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -45,7 +45,7 @@ func (ip *IdentifierPath) NodeID() int {
 
 func GetIdentifierPath(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IdentifierPath, error) {
 	ip := new(IdentifierPath)
-	if err := json.Unmarshal([]byte(raw.ToString()), ip); err != nil {
+	if err := src.UnmarshalAny(raw, ip); err != nil {
 		logger.Errorf("Failed to unmarshal IdentifierPath: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal IdentifierPath: [%v]", err)
 	}
@@ -1,11 +1,11 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -30,24 +30,7 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 	// condition
 	{
 		if is.condition != nil {
-			switch condition := is.condition.(type) {
-			case *BinaryOperation:
-				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
-			case *UnaryOperation:
-				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
-			case *Literal:
-				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
-			case *IndexAccess:
-				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
-			case *Identifier:
-				code = code + "(" + condition.SourceCode(false, false, indent, logger) + ")"
-			default:
-				if condition != nil {
-					logger.Warnf("Unknown condition nodeType [%s] for IfStatement [src:%s].", condition.Type(), is.Src)
-				} else {
-					logger.Warnf("Unknown condition nodeType for IfStatement [src:%s].", is.Src)
-				}
-			}
+			code = code + "(" + ExpressionCode(is.condition, indent, "condition", "IfStatement", is.Src, logger) + ")"
 		}
 	}
 
@@ -69,11 +52,13 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 				code = code + trueBody.SourceCode(false, true, indent, logger)
 			case *Break:
 				code = code + trueBody.SourceCode(true, true, indent+"    ", logger)
+			case *Continue:
+				code = code + trueBody.SourceCode(true, true, indent+"    ", logger)
 			default:
 				if trueBody != nil {
-					logger.Warnf("Unknown trueBody nodeType [%s] for IfStatement [src:%s].", trueBody.Type(), is.Src)
+					src.Dropf(logger, "Unknown trueBody nodeType [%s] for IfStatement [src:%s].", trueBody.Type(), is.Src)
 				} else {
-					logger.Warnf("Unknown trueBody nodeType for IfStatement [src:%s].", is.Src)
+					src.Dropf(logger, "Unknown trueBody nodeType for IfStatement [src:%s].", is.Src)
 				}
 			}
 		}
@@ -102,11 +87,51 @@ func (is *IfStatement) SourceCode(isSc bool, isIndent bool, indent string, logge
 			case *IfStatement:
 				code = code + " " + "else "
 				code = code + strings.TrimLeft(falseBody.SourceCode(false, true, indent, logger), " ")
+			case *ExpressionStatement:
+				code = code + " " + "else {\n"
+				code = code + falseBody.SourceCode(true, true, indent+"    ", logger)
+				code = code + "\n"
+				if isIndent {
+					code = code + indent
+				}
+				code = code + "}"
+			case *RevertStatement:
+				code = code + " " + "else {\n"
+				code = code + falseBody.SourceCode(true, true, indent+"    ", logger)
+				code = code + "\n"
+				if isIndent {
+					code = code + indent
+				}
+				code = code + "}"
+			case *Return:
+				code = code + " " + "else {\n"
+				code = code + falseBody.SourceCode(true, true, indent+"    ", logger)
+				code = code + "\n"
+				if isIndent {
+					code = code + indent
+				}
+				code = code + "}"
+			case *Break:
+				code = code + " " + "else {\n"
+				code = code + falseBody.SourceCode(true, true, indent+"    ", logger)
+				code = code + "\n"
+				if isIndent {
+					code = code + indent
+				}
+				code = code + "}"
+			case *Continue:
+				code = code + " " + "else {\n"
+				code = code + falseBody.SourceCode(true, true, indent+"    ", logger)
+				code = code + "\n"
+				if isIndent {
+					code = code + indent
+				}
+				code = code + "}"
 			default:
 				if falseBody != nil {
-					logger.Warnf("Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBody.Type(), is.Src)
+					src.Dropf(logger, "Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBody.Type(), is.Src)
 				} else {
-					logger.Warnf("Unknown falseBody nodeType for IfStatement [src:%s].", is.Src)
+					src.Dropf(logger, "Unknown falseBody nodeType for IfStatement [src:%s].", is.Src)
 				}
 			}
 		}
@@ -129,7 +154,7 @@ func (is *IfStatement) NodeID() int {
 
 func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IfStatement, error) {
 	is := new(IfStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), is); err != nil {
+	if err := src.UnmarshalAny(raw, is); err != nil {
 		logger.Errorf("Failed to unmarshal IfStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal IfSatement: [%v]", err)
 	}
@@ -138,24 +163,10 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 	{
 		condition := raw.Get("condition")
 		if condition.Size() > 0 {
-			conditionNodeType := condition.Get("nodeType").ToString()
 			var isCondition ASTNode
 			var err error
 
-			switch conditionNodeType {
-			case "BinaryOperation":
-				isCondition, err = GetBinaryOperation(gn, condition, logger)
-			case "UnaryOperation":
-				isCondition, err = GetUnaryOperation(gn, condition, logger)
-			case "Literal":
-				isCondition, err = GetLiteral(gn, condition, logger)
-			case "IndexAccess":
-				isCondition, err = GetIndexAccess(gn, condition, logger)
-			case "Identifier":
-				isCondition, err = GetIdentifier(gn, condition, logger)
-			default:
-				logger.Warnf("Unknown condition nodeType [%s] for IfStatement [src:%s].", conditionNodeType, is.Src)
-			}
+			isCondition, err = GetExpression(gn, condition, "condition", "IfStatement", is.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -180,8 +191,19 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				isFalseBody, err = GetBlock(gn, falseBody, logger)
 			case "IfStatement":
 				isFalseBody, err = GetIfStatement(gn, falseBody, logger)
+			case "ExpressionStatement":
+				isFalseBody, err = GetExpressionStatement(gn, falseBody, logger)
+			case "RevertStatement":
+				isFalseBody, err = GetRevertStatement(gn, falseBody, logger)
+			case "Return":
+				isFalseBody, err = GetReturn(gn, falseBody, logger)
+			case "Break":
+				isFalseBody, err = GetBreak(gn, falseBody, logger)
+			case "Continue":
+				isFalseBody, err = GetContinue(gn, falseBody, logger)
 			default:
 				logger.Warnf("Unknown falseBody nodeType [%s] for IfStatement [src:%s].", falseBodyNodeType, is.Src)
+				gn.AddUnknownNode(falseBodyNodeType, falseBody.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -215,8 +237,11 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 				isTrueBody, err = GetIfStatement(gn, trueBody, logger)
 			case "Break":
 				isTrueBody, err = GetBreak(gn, trueBody, logger)
+			case "Continue":
+				isTrueBody, err = GetContinue(gn, trueBody, logger)
 			default:
 				logger.Warnf("Unknown trueBody [%s] for IfStatement [src:%s].", trueBodyNodeType, is.Src)
+				gn.AddUnknownNode(trueBodyNodeType, trueBody.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -233,103 +258,3 @@ func GetIfStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 
 	return is, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (is *IfStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// condition
-	{
-		if is.condition != nil {
-			switch condition := is.condition.(type) {
-			case *BinaryOperation:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *UnaryOperation:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				condition.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IfStatement:
-				falseBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (is *IfStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseTaintOwner(opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseTaintOwner(opt, logger)
-			case *IfStatement:
-				falseBody.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseTaintOwner(opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-}
-
-func (is *IfStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	//falseBody
-	{
-		if is.falseBody != nil {
-			switch falseBody := is.falseBody.(type) {
-			case *Block:
-				falseBody.TraverseDelegatecall(opt, logger)
-			case *ExpressionStatement:
-				falseBody.TraverseDelegatecall(opt, logger)
-			case *IfStatement:
-				falseBody.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-
-	// trueBody
-	{
-		if is.trueBody != nil {
-			switch trueBody := is.trueBody.(type) {
-			case *Block:
-				trueBody.TraverseDelegatecall(opt, logger)
-			case *ExpressionStatement:
-				trueBody.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
\ No newline at end of file
@@ -0,0 +1,91 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type ImportDirective struct {
+	AbsolutePath  string `json:"absolutePath"`
+	File          string `json:"file"`
+	ID            int    `json:"id"`
+	NameLocation  string `json:"nameLocation"`
+	NodeType      string `json:"nodeType"`
+	Scope         int    `json:"scope"`
+	SourceUnit    int    `json:"sourceUnit"`
+	Src           string `json:"src"`
+	SymbolAliases []struct {
+		Foreign struct {
+			ID                    int    `json:"id"`
+			Name                  string `json:"name"`
+			NodeType              string `json:"nodeType"`
+			ReferencedDeclaration int    `json:"referencedDeclaration"`
+			Src                   string `json:"src"`
+		} `json:"foreign"`
+		Local        string `json:"local"`
+		NameLocation string `json:"nameLocation"`
+	} `json:"symbolAliases"`
+	UnitAlias string `json:"unitAlias"`
+}
+
+func (id *ImportDirective) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "import" + " "
+
+	if len(id.SymbolAliases) > 0 {
+		code = code + "{"
+		for index, symbolAlias := range id.SymbolAliases {
+			code = code + symbolAlias.Foreign.Name
+			if symbolAlias.Local != "" {
+				code = code + " as " + symbolAlias.Local
+			}
+			if index < len(id.SymbolAliases)-1 {
+				code = code + ", "
+			}
+		}
+		code = code + "}" + " from "
+		code = code + "\"" + id.File + "\""
+	} else {
+		code = code + "\"" + id.File + "\""
+		if id.UnitAlias != "" {
+			code = code + " as " + id.UnitAlias
+		}
+	}
+
+	if isSc {
+		code = code + ";"
+	}
+
+	return code
+}
+
+func (id *ImportDirective) Type() string {
+	return id.NodeType
+}
+
+func (id *ImportDirective) Nodes() []ASTNode {
+	return nil
+}
+
+func (id *ImportDirective) NodeID() int {
+	return id.ID
+}
+
+func GetImportDirective(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ImportDirective, error) {
+	id := new(ImportDirective)
+	if err := src.UnmarshalAny(raw, id); err != nil {
+		logger.Errorf("Failed to unmarshal ImportDirective: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal ImportDirective: [%v]", err)
+	}
+
+	gn.AddASTNode(id)
+
+	return id, nil
+}
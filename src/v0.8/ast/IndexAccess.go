@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -33,22 +33,7 @@ func (ia *IndexAccess) SourceCode(isSc bool, isIndent bool, indent string, logge
 	// baseExpression
 	{
 		if ia.baseExpression != nil {
-			switch baseExpression := ia.baseExpression.(type) {
-			case *Identifier:
-				code = code + baseExpression.SourceCode(false, false, indent, logger)
-			case *IndexAccess:
-				code = code + baseExpression.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + baseExpression.SourceCode(false, false, indent, logger)
-			case *ElementaryTypeNameExpression:
-				code = code + baseExpression.SourceCode(false, false, indent, logger)
-			default:
-				if baseExpression != nil {
-					logger.Warnf("Unknown baseExpression nodeType [%s] for IndexAccess [src:%s].", baseExpression.Type(), ia.Src)
-				} else {
-					logger.Warnf("Unknown baseExpression nodeType for IndexAccess [src:%s].", ia.Src)
-				}
-			}
+			code = code + ExpressionCode(ia.baseExpression, indent, "baseExpression", "IndexAccess", ia.Src, logger)
 		}
 	}
 
@@ -57,28 +42,7 @@ func (ia *IndexAccess) SourceCode(isSc bool, isIndent bool, indent string, logge
 	// indexExpression
 	{
 		if ia.indexExpression != nil {
-			switch indexExpression := ia.indexExpression.(type) {
-			case *Identifier:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *FunctionCall:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *Literal:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *IndexAccess:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *BinaryOperation:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			case *UnaryOperation:
-				code = code + indexExpression.SourceCode(false, false, indent, logger)
-			default:
-				if indexExpression != nil {
-					logger.Warnf("Unknown indexExpression nodeType [%s] for IndexAccess [src:%s].", indexExpression.Type(), ia.Src)
-				} else {
-					logger.Warnf("Unknown indexExpression nodeType for IndexAccess [src:%s].", ia.Src)
-				}
-			}
+			code = code + ExpressionCode(ia.indexExpression, indent, "indexExpression", "IndexAccess", ia.Src, logger)
 		}
 	}
 
@@ -105,7 +69,7 @@ func (ia *IndexAccess) NodeID() int {
 
 func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IndexAccess, error) {
 	ia := new(IndexAccess)
-	if err := json.Unmarshal([]byte(raw.ToString()), ia); err != nil {
+	if err := src.UnmarshalAny(raw, ia); err != nil {
 		logger.Errorf("Failed to unmarshal IndexAccess: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal IndexAccess: [%v]", err)
 	}
@@ -114,22 +78,10 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 	{
 		baseExpression := raw.Get("baseExpression")
 		if baseExpression.Size() > 0 {
-			baseExpressionNodeType := baseExpression.Get("nodeType").ToString()
 			var iaBaseExpression ASTNode
 			var err error
 
-			switch baseExpressionNodeType {
-			case "Identifier":
-				iaBaseExpression, err = GetIdentifier(gn, baseExpression, logger)
-			case "IndexAccess":
-				iaBaseExpression, err = GetIndexAccess(gn, baseExpression, logger)
-			case "MemberAccess":
-				iaBaseExpression, err = GetMemberAccess(gn, baseExpression, logger)
-			case "ElementaryTypeNameExpression":
-				iaBaseExpression, err = GetElementaryTypeNameExpression(gn, baseExpression, logger)
-			default:
-				logger.Warnf("Unknown baseExpression [%s] for IndexAccess [src:%s].", baseExpressionNodeType, ia.Src)
-			}
+			iaBaseExpression, err = GetExpression(gn, baseExpression, "baseExpression", "IndexAccess", ia.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -145,28 +97,10 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 	{
 		indexExpression := raw.Get("indexExpression")
 		if indexExpression.Size() > 0 {
-			indexExpressionNodeType := indexExpression.Get("nodeType").ToString()
 			var iaIndexExpression ASTNode
 			var err error
 
-			switch indexExpressionNodeType {
-			case "Identifier":
-				iaIndexExpression, err = GetIdentifier(gn, indexExpression, logger)
-			case "FunctionCall":
-				iaIndexExpression, err = GetFunctionCall(gn, indexExpression, logger)
-			case "Literal":
-				iaIndexExpression, err = GetLiteral(gn, indexExpression, logger)
-			case "IndexAccess":
-				iaIndexExpression, err = GetIndexAccess(gn, indexExpression, logger)
-			case "MemberAccess":
-				iaIndexExpression, err = GetMemberAccess(gn, indexExpression, logger)
-			case "BinaryOperation":
-				iaIndexExpression, err = GetBinaryOperation(gn, indexExpression, logger)
-			case "UnaryOperation":
-				iaIndexExpression, err = GetUnaryOperation(gn, indexExpression, logger)
-			default:
-				logger.Warnf("Unknown indexExpression [%s] for IndexAccess [src:%s].", indexExpressionNodeType, ia.Src)
-			}
+			iaIndexExpression, err = GetExpression(gn, indexExpression, "indexExpression", "IndexAccess", ia.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -185,32 +119,6 @@ func GetIndexAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (ia *IndexAccess) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// baseExpression
-	{
-		if ia.baseExpression != nil {
-			switch baseExpression := ia.baseExpression.(type) {
-			case *IndexAccess:
-				baseExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-
-	// indexExpression
-	{
-		if ia.indexExpression != nil {
-			switch indexExpression := ia.indexExpression.(type) {
-			case *FunctionCall:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *IndexAccess:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				indexExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (ia *IndexAccess) SetBaseExpression(baseExpression ASTNode) {
 	ia.baseExpression = baseExpression
 }
@@ -0,0 +1,139 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type IndexRangeAccess struct {
+	baseExpression   ASTNode
+	endExpression    ASTNode
+	ID               int    `json:"id"`
+	IsConstant       bool   `json:"isConstant"`
+	IsLValue         bool   `json:"isLValue"`
+	IsPure           bool   `json:"isPure"`
+	LValueRequested  bool   `json:"lValueRequested"`
+	NodeType         string `json:"nodeType"`
+	Src              string `json:"src"`
+	startExpression  ASTNode
+	TypeDescriptions struct {
+		TypeIdentifier string `json:"typeIdentifier"`
+		TypeString     string `json:"typeString"`
+	} `json:"typeDescriptions"`
+}
+
+func (ira *IndexRangeAccess) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	// baseExpression
+	{
+		if ira.baseExpression != nil {
+			code = code + ExpressionCode(ira.baseExpression, indent, "baseExpression", "IndexRangeAccess", ira.Src, logger)
+		}
+	}
+
+	code = code + "["
+
+	// startExpression
+	{
+		if ira.startExpression != nil {
+			code = code + ExpressionCode(ira.startExpression, indent, "startExpression", "IndexRangeAccess", ira.Src, logger)
+		}
+	}
+
+	code = code + ":"
+
+	// endExpression
+	{
+		if ira.endExpression != nil {
+			code = code + ExpressionCode(ira.endExpression, indent, "endExpression", "IndexRangeAccess", ira.Src, logger)
+		}
+	}
+
+	code = code + "]"
+
+	if isSc {
+		code = code + ";"
+	}
+
+	return code
+}
+
+func (ira *IndexRangeAccess) Type() string {
+	return ira.NodeType
+}
+
+func (ira *IndexRangeAccess) Nodes() []ASTNode {
+	return nil
+}
+
+func (ira *IndexRangeAccess) NodeID() int {
+	return ira.ID
+}
+
+func GetIndexRangeAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*IndexRangeAccess, error) {
+	ira := new(IndexRangeAccess)
+	if err := src.UnmarshalAny(raw, ira); err != nil {
+		logger.Errorf("Failed to unmarshal IndexRangeAccess: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal IndexRangeAccess: [%v]", err)
+	}
+
+	// baseExpression
+	{
+		baseExpression := raw.Get("baseExpression")
+		if baseExpression.Size() > 0 {
+			var iraBaseExpression ASTNode
+			var err error
+
+			iraBaseExpression, err = GetExpression(gn, baseExpression, "baseExpression", "IndexRangeAccess", ira.Src, logger)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if iraBaseExpression != nil {
+				ira.baseExpression = iraBaseExpression
+			}
+		}
+	}
+
+	// startExpression
+	{
+		startExpression := raw.Get("startExpression")
+		if startExpression.Size() > 0 {
+			iraStartExpression, err := GetExpression(gn, startExpression, "startExpression", "IndexRangeAccess", ira.Src, logger)
+			if err != nil {
+				return nil, err
+			}
+
+			if iraStartExpression != nil {
+				ira.startExpression = iraStartExpression
+			}
+		}
+	}
+
+	// endExpression
+	{
+		endExpression := raw.Get("endExpression")
+		if endExpression.Size() > 0 {
+			iraEndExpression, err := GetExpression(gn, endExpression, "endExpression", "IndexRangeAccess", ira.Src, logger)
+			if err != nil {
+				return nil, err
+			}
+
+			if iraEndExpression != nil {
+				ira.endExpression = iraEndExpression
+			}
+		}
+	}
+
+	gn.AddASTNode(ira)
+
+	return ira, nil
+}
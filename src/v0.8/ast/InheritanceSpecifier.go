@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -39,7 +39,7 @@ func (is *InheritanceSpecifier) NodeID() int {
 
 func GetInheritanceSpecifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*InheritanceSpecifier, error) {
 	is := new(InheritanceSpecifier)
-	if err := json.Unmarshal([]byte(raw.ToString()), is); err != nil {
+	if err := src.UnmarshalAny(raw, is); err != nil {
 		logger.Error("Failed to unmarshal InheritanceSpecifier: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal InheritanceSpecifier: [%v]", err)
 	}
@@ -55,6 +55,7 @@ func GetInheritanceSpecifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.L
 			}
 		default:
 			logger.Warnf("Unknown baseName nodeType: [%s-%s]", baseNameNodeType, baseName.Get("src").ToString())
+			gn.AddUnknownNode(baseNameNodeType, baseName.Get("src").ToString())
 		}
 	}
 
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -37,9 +37,9 @@ func (ia *InlineAssembly) SourceCode(isSc bool, isIndent bool, indent string, lo
 			code = code + ast.SourceCode(false, false, indent, logger)
 		default:
 			if ast != nil {
-				logger.Warnf("Unknown ast nodeType [%s] for InlineAssembly [src:%s]", ast.Type(), ia.Src)
+				src.Dropf(logger, "Unknown ast nodeType [%s] for InlineAssembly [src:%s]", ast.Type(), ia.Src)
 			} else {
-				logger.Warnf("Unknown ast nodeType for InlineAssembly [src:%s]", ia.Src)
+				src.Dropf(logger, "Unknown ast nodeType for InlineAssembly [src:%s]", ia.Src)
 			}
 		}
 	}
@@ -69,7 +69,7 @@ func (ia *InlineAssembly) NodeID() int {
 
 func GetInlineAssembly(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*InlineAssembly, error) {
 	ia := new(InlineAssembly)
-	if err := json.Unmarshal([]byte(raw.ToString()), ia); err != nil {
+	if err := src.UnmarshalAny(raw, ia); err != nil {
 		logger.Errorf("Failed to unmarshal InlineAssembly: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal InlineAssembly: [%v]", err)
 	}
@@ -87,6 +87,7 @@ func GetInlineAssembly(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				iaAST, err = GetYulBlock(gn, ast, logger)
 			default:
 				logger.Warnf("Unknown ast nodeType [%s] for InlineAssembly [src:%s].", astNodeType, ia.Src)
+				gn.AddUnknownNode(astNodeType, ast.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -104,6 +105,6 @@ func GetInlineAssembly(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 	return ia, nil
 }
 
-func (ia *InlineAssembly) TraverseFunctionCall() {
-	
+func (ia *InlineAssembly) SetAST(ast ASTNode) {
+	ia.ast = ast
 }
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -35,33 +35,47 @@ func (m *Mapping) SourceCode(isSc bool, isIndent bool, indent string, logger log
 			switch keyType := m.keyType.(type) {
 			case *ElementaryTypeName:
 				code = code + " " + "(" + keyType.SourceCode(false, false, indent, logger)
+			case *UserDefinedTypeName:
+				code = code + " " + "(" + keyType.SourceCode(false, false, indent, logger)
 			default:
 				if keyType != nil {
-					logger.Warnf("Unknown keyType nodeType [%s] for Mapping [src:%s].", keyType.Type(), m.Src)
+					src.Dropf(logger, "Unknown keyType nodeType [%s] for Mapping [src:%s].", keyType.Type(), m.Src)
 				} else {
-					logger.Warnf("Unknown keyType nodeType for Mapping [src:%s].", m.Src)
+					src.Dropf(logger, "Unknown keyType nodeType for Mapping [src:%s].", m.Src)
 				}
 			}
+			if m.KeyName != "" {
+				code = code + " " + m.KeyName
+			}
 		}
 	}
 
 	// valueType
 	{
 		if m.valueType != nil {
+			var value string
 			switch valueType := m.valueType.(type) {
 			case *ElementaryTypeName:
-				code = code + " " + "=>" + " " + valueType.SourceCode(false, false, indent, logger) + ")"
+				value = valueType.SourceCode(false, false, indent, logger)
 			case *Mapping:
-				code = code + " " + "=>" + " " + valueType.SourceCode(false, false, indent, logger) + ")"
+				value = valueType.SourceCode(false, false, indent, logger)
 			case *UserDefinedTypeName:
-				code = code + " " + "=>" + " " + valueType.SourceCode(false, false, indent, logger) + ")"
+				value = valueType.SourceCode(false, false, indent, logger)
+			case *ArrayTypeName:
+				value = valueType.SourceCode(false, false, indent, logger)
+			case *FunctionTypeName:
+				value = valueType.SourceCode(false, false, indent, logger)
 			default:
 				if valueType != nil {
-					logger.Warnf("Unknown valueType nodeType [%s] for Mapping [src:%s].", valueType.Type(), m.Src)
+					src.Dropf(logger, "Unknown valueType nodeType [%s] for Mapping [src:%s].", valueType.Type(), m.Src)
 				} else {
-					logger.Warnf("Unknown valueType nodeType for Mapping [src:%s].", m.Src)
+					src.Dropf(logger, "Unknown valueType nodeType for Mapping [src:%s].", m.Src)
 				}
 			}
+			if m.ValueName != "" {
+				value = value + " " + m.ValueName
+			}
+			code = code + " " + "=>" + " " + value + ")"
 		}
 	}
 
@@ -86,7 +100,7 @@ func (m *Mapping) NodeID() int {
 
 func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapping, error) {
 	m := new(Mapping)
-	if err := json.Unmarshal([]byte(raw.ToString()), m); err != nil {
+	if err := src.UnmarshalAny(raw, m); err != nil {
 		logger.Errorf("Failed to unmarshal Mapping: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Mapping: [%v]", err)
 	}
@@ -101,8 +115,11 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 		switch keyTypeNodeType {
 		case "ElementaryTypeName":
 			mKeyType, err = GetElementaryTypeName(gn, keyType, logger)
+		case "UserDefinedTypeName":
+			mKeyType, err = GetUserDefinedTypeName(gn, keyType, logger)
 		default:
 			logger.Warnf("Unknown keyType nodeType [%s] for Mapping [src:%s].", keyTypeNodeType, m.Src)
+			gn.AddUnknownNode(keyTypeNodeType, keyType.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -128,8 +145,13 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 			mValueType, err = GetMapping(gn, valueType, logger)
 		case "UserDefinedTypeName":
 			mValueType, err = GetUserDefinedTypeName(gn, valueType, logger)
+		case "ArrayTypeName":
+			mValueType, err = GetArrayTypeName(gn, valueType, logger)
+		case "FunctionTypeName":
+			mValueType, err = GetFunctionTypeName(gn, valueType, logger)
 		default:
 			logger.Warnf("Unknown valueType nodeType [%s] for Mapping [src:%s].", valueTypeNodeType, m.Src)
+			gn.AddUnknownNode(valueTypeNodeType, valueType.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -148,23 +170,6 @@ func GetMapping(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Mapp
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (m *Mapping) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// keyType
-	{
-
-	}
-
-	// valueType
-	{
-		if m.valueType != nil {
-			switch valueType := m.valueType.(type) {
-			case *Mapping:
-				valueType.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (m *Mapping) SetKeyType(kt ASTNode) {
 	m.keyType = kt
 }
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -39,22 +39,7 @@ func (ma *MemberAccess) SourceCode(isSc bool, isIndent bool, indent string, logg
 	// expression
 	{
 		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *IndexAccess:
-				code = code + expression.SourceCode(false, false, indent, logger)
-			case *Identifier:
-				code = code + expression.SourceCode(false, false, indent, logger)
-			case *FunctionCall:
-				code = code + expression.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + expression.SourceCode(false, false, indent, logger)
-			default:
-				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for MemberAccess [src:%s].", expression.Type(), ma.Src)
-				} else {
-					logger.Warnf("Unknown expression nodeType for MemberAccess [src:%s].", ma.Src)
-				}
-			}
+			code = code + ExpressionCode(ma.expression, indent, "expression", "MemberAccess", ma.Src, logger)
 		}
 	}
 
@@ -85,7 +70,7 @@ func (ma *MemberAccess) NodeID() int {
 
 func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*MemberAccess, error) {
 	ma := new(MemberAccess)
-	if err := json.Unmarshal([]byte(raw.ToString()), ma); err != nil {
+	if err := src.UnmarshalAny(raw, ma); err != nil {
 		logger.Errorf("Failed to unmarshal MemberAccess: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal MemberAccess: [%v]", err)
 	}
@@ -94,22 +79,10 @@ func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 	{
 		expression := raw.Get("expression")
 		if expression.Size() > 0 {
-			expressionNodeType := expression.Get("nodeType").ToString()
 			var maExpression ASTNode
 			var err error
 
-			switch expressionNodeType {
-			case "IndexAccess":
-				maExpression, err = GetIndexAccess(gn, expression, logger)
-			case "Identifier":
-				maExpression, err = GetIdentifier(gn, expression, logger)
-			case "FunctionCall":
-				maExpression, err = GetFunctionCall(gn, expression, logger)
-			case "MemberAccess":
-				maExpression, err = GetMemberAccess(gn, expression, logger)
-			default:
-				logger.Warnf("Unknown expression nodeType [%s] for MemberAccess [src:%s].", expressionNodeType, ma.Src)
-			}
+			maExpression, err = GetExpression(gn, expression, "expression", "MemberAccess", ma.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -125,35 +98,3 @@ func GetMemberAccess(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 	return ma, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (ma *MemberAccess) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *IndexAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *FunctionCall:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (ma *MemberAccess) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if ma.expression != nil {
-			switch expression := ma.expression.(type) {
-			case *FunctionCall:
-				expression.TraverseDelegatecall(opt, logger)
-			case *MemberAccess:
-				expression.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
\ No newline at end of file
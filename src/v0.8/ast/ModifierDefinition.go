@@ -1,27 +1,45 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
 type ModifierDefinition struct {
-	body         ASTNode
-	ID           int    `json:"id"`
-	Name         string `json:"name"`
-	NameLocation string `json:"nameLocation"`
-	NodeType     string `json:"nodeType"`
-	parameters   ASTNode
-	Src          string `json:"src"`
-	Virtual      bool   `json:"virtual"`
-	Visibility   string `json:"visibility"`
+	body          ASTNode
+	documentation ASTNode
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	NameLocation  string `json:"nameLocation"`
+	NodeType      string `json:"nodeType"`
+	parameters    ASTNode
+	Src           string `json:"src"`
+	Virtual       bool   `json:"virtual"`
+	Visibility    string `json:"visibility"`
 }
 
 func (md *ModifierDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
 	var code string
+
+	// documentation
+	{
+		if md.documentation != nil {
+			switch documentation := md.documentation.(type) {
+			case *StructuredDocumentation:
+				code = code + documentation.SourceCode(false, isIndent, indent, logger) + "\n"
+			default:
+				if documentation != nil {
+					src.Dropf(logger, "Unknown documentation nodeType [%s] for ModifierDefinition [src:%s].", documentation.Type(), md.Src)
+				} else {
+					src.Dropf(logger, "Unknown documentation nodeType for ModifierDefinition [src:%s].", md.Src)
+				}
+			}
+		}
+	}
+
 	if isIndent {
 		code = code + indent
 	}
@@ -33,14 +51,14 @@ func (md *ModifierDefinition) SourceCode(isSc bool, isIndent bool, indent string
 	// parameters
 	{
 		if md.parameters != nil {
-			switch parameters:= md.parameters.(type) {
+			switch parameters := md.parameters.(type) {
 			case *ParameterList:
 				code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 			default:
 				if parameters != nil {
-					logger.Warnf("Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parameters.Type(), md.Src)
+					src.Dropf(logger, "Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parameters.Type(), md.Src)
 				} else {
-					logger.Warnf("Unknown parameters nodeType for ModifierDefinition [src:%s].", md.Src)
+					src.Dropf(logger, "Unknown parameters nodeType for ModifierDefinition [src:%s].", md.Src)
 				}
 			}
 		}
@@ -56,7 +74,7 @@ func (md *ModifierDefinition) SourceCode(isSc bool, isIndent bool, indent string
 				code = code + body.SourceCode(false, false, indent, logger)
 			default:
 				if body != nil {
-					logger.Warnf("Unknown body nodeType [%s] for ModifierDefinition [src:%s].", body.Type(), md.Src)
+					src.Dropf(logger, "Unknown body nodeType [%s] for ModifierDefinition [src:%s].", body.Type(), md.Src)
 				} else {
 					logger.Errorf("Unknown body nodeType for ModifierDefinition [src:%s].", md.Src)
 				}
@@ -89,11 +107,37 @@ func (md *ModifierDefinition) NodeID() int {
 
 func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ModifierDefinition, error) {
 	md := new(ModifierDefinition)
-	if err := json.Unmarshal([]byte(raw.ToString()), md); err != nil {
+	if err := src.UnmarshalAny(raw, md); err != nil {
 		logger.Errorf("Failed to unmarshal ModifierDefinition: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ModifierDefinition: [%v]", err)
 	}
 
+	// documentation
+	{
+		documentation := raw.Get("documentation")
+		if documentation.Size() > 0 {
+			documentationNodeType := documentation.Get("nodeType").ToString()
+			var mdDocumentation ASTNode
+			var err error
+
+			switch documentationNodeType {
+			case "StructuredDocumentation":
+				mdDocumentation, err = GetStructuredDocumentation(gn, documentation, logger)
+			default:
+				logger.Warnf("Unknown documentation nodeType [%s] for ModifierDefinition [src:%s].", documentationNodeType, md.Src)
+				gn.AddUnknownNode(documentationNodeType, documentation.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if mdDocumentation != nil {
+				md.documentation = mdDocumentation
+			}
+		}
+	}
+
 	// parameters
 	{
 		parameters := raw.Get("parameters")
@@ -107,6 +151,7 @@ func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				mdParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for ModifierDefinition [src:%s].", parametersNodeType, md.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -132,6 +177,7 @@ func GetModifierDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				mdBody, err = GetBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for ModifierDefinition [src:%s].", bodyNodeType, md.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -30,9 +30,9 @@ func (mi *ModifierInvocation) SourceCode(isSc bool, isIndent bool, indent string
 			code = code + modifierName.SourceCode(false, false, indent, logger)
 		default:
 			if modifierName != nil {
-				logger.Warnf("Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierName.Type(), mi.Src)
+				src.Dropf(logger, "Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierName.Type(), mi.Src)
 			} else {
-				logger.Warnf("Unknown modifierName nodeType for ModifierInvocation [src:%s].", mi.Src)
+				src.Dropf(logger, "Unknown modifierName nodeType for ModifierInvocation [src:%s].", mi.Src)
 			}
 		}
 	}
@@ -41,18 +41,7 @@ func (mi *ModifierInvocation) SourceCode(isSc bool, isIndent bool, indent string
 	if len(mi.arguments) > 0 {
 		code = code + "("
 		for index, argument := range mi.arguments {
-			switch arg := argument.(type) {
-			case *Identifier:
-				code = code + arg.SourceCode(false, false, indent, logger)
-			case *Literal:
-				code = code + arg.SourceCode(false, false, indent, logger)
-			default:
-				if arg != nil {
-					logger.Warnf("Unknown argument nodeType [%s] for ModifierInvocation [src:%s].", arg.Type(), mi.Src)
-				} else {
-					logger.Warnf("Unknown argument nodeType for ModifierInvocation [src:%s].", mi.Src)
-				}
-			}
+			code = code + ExpressionCode(argument, indent, "argument", "ModifierInvocation", mi.Src, logger)
 
 			if index < len(mi.arguments)-1 {
 				code = code + ", "
@@ -78,7 +67,7 @@ func (mi *ModifierInvocation) NodeID() int {
 
 func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*ModifierInvocation, error) {
 	mi := new(ModifierInvocation)
-	if err := json.Unmarshal([]byte(raw.ToString()), mi); err != nil {
+	if err := src.UnmarshalAny(raw, mi); err != nil {
 		logger.Errorf("Failed to unmarshal ModifierInvocation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal ModifierInvocation: [%v]", err)
 	}
@@ -92,18 +81,10 @@ func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 			for i := 0; i < arguments.Size(); i++ {
 				argument := arguments.Get(i)
 				if argument.Size() > 0 {
-					argumentNodeType := argument.Get("nodeType").ToString()
 					var miArgument ASTNode
 					var err error
 
-					switch argumentNodeType {
-					case "Identifier":
-						miArgument, err = GetIdentifier(gn, argument, logger)
-					case "Literal":
-						miArgument, err = GetLiteral(gn, argument, logger)
-					default:
-						logger.Warnf("Unknown argument nodeType [%s] for ModifierInvocation [src:%s].", argumentNodeType, mi.Src)
-					}
+					miArgument, err = GetExpression(gn, argument, "argument", "ModifierInvocation", mi.Src, logger)
 
 					if err != nil {
 						return nil, err
@@ -132,6 +113,7 @@ func GetModifierInvocation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Log
 				miModifierName, err = GetIdentifierPath(gn, modifierName, logger)
 			default:
 				logger.Warnf("Unknown modifierName nodeType [%s] for ModifierInvocation [src:%s].", modifierNameNodeType, mi.Src)
+				gn.AddUnknownNode(modifierNameNodeType, modifierName.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -42,11 +42,13 @@ func (ne *NewExpression) SourceCode(isSc bool, isIndent bool, indent string, log
 			code = code + " " + typeName.SourceCode(false, false, indent, logger)
 		case *ElementaryTypeName:
 			code = code + " " + typeName.SourceCode(false, false, indent, logger)
+		case *UserDefinedTypeName:
+			code = code + " " + typeName.SourceCode(false, false, indent, logger)
 		default:
 			if typeName != nil {
-				logger.Warnf("Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeName.Type(), ne.Src)
+				src.Dropf(logger, "Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeName.Type(), ne.Src)
 			} else {
-				logger.Warnf("Unknown typeName nodeType for NewExpression [src:%s].", ne.Src)
+				src.Dropf(logger, "Unknown typeName nodeType for NewExpression [src:%s].", ne.Src)
 			}
 		}
 	}
@@ -72,7 +74,7 @@ func (ne *NewExpression) NodeID() int {
 
 func GetNewExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*NewExpression, error) {
 	ne := new(NewExpression)
-	if err := json.Unmarshal([]byte(raw.ToString()), ne); err != nil {
+	if err := src.UnmarshalAny(raw, ne); err != nil {
 		logger.Errorf("Failed to unmarshal NewExpression: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal NewExpression: [%v]", err)
 	}
@@ -90,8 +92,11 @@ func GetNewExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				neTypeName, err = GetArrayTypeName(gn, typeName, logger)
 			case "ElementaryTypeName":
 				neTypeName, err = GetElementaryTypeName(gn, typeName, logger)
+			case "UserDefinedTypeName":
+				neTypeName, err = GetUserDefinedTypeName(gn, typeName, logger)
 			default:
 				logger.Warnf("Unknown typeName nodeType [%s] for NewExpression [src:%s].", typeNameNodeType, ne.Src)
+				gn.AddUnknownNode(typeNameNodeType, typeName.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -56,7 +56,7 @@ func (os *OverrideSpecifier) NodeID() int {
 
 func GetOverrideSpecifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*OverrideSpecifier, error) {
 	os := new(OverrideSpecifier)
-	if err := json.Unmarshal([]byte(raw.ToString()), os); err != nil {
+	if err := src.UnmarshalAny(raw, os); err != nil {
 		logger.Errorf("Failed to unmarshal OverrideSpecifier: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal OverrideSpecifier: [%v]", err)
 	}
@@ -79,6 +79,7 @@ func GetOverrideSpecifier(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logg
 						osOverride, err = GetIdentifierPath(gn, override, logger)
 					default:
 						logger.Warnf("Unknown override nodeType [%s] for OverrideSpecifier [src:%s].", overrideNodeType, os.Src)
+						gn.AddUnknownNode(overrideNodeType, override.Get("src").ToString())
 					}
 
 					if err != nil {
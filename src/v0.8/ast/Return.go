@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -28,30 +28,7 @@ func (r *Return) SourceCode(isSc bool, isIndent bool, indent string, logger logg
 	// expression
 	{
 		if r.expression != nil {
-			switch expression := r.expression.(type) {
-			case *IndexAccess:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *Literal:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *Conditional:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *BinaryOperation:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *Identifier:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *FunctionCall:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			case *TupleExpression:
-				code = code + " " + expression.SourceCode(false, false, indent, logger)
-			default:
-				if expression != nil {
-					logger.Warnf("Unknown expression nodeType [%s] for Return [src:%s].", expression.Type(), r.Src)
-				} else {
-					logger.Warnf("Unknown expression nodeType for Return [src:%s].", r.Src)
-				}
-			}
+			code = code + " " + ExpressionCode(r.expression, indent, "expression", "Return", r.Src, logger)
 		}
 	}
 
@@ -76,7 +53,7 @@ func (r *Return) NodeID() int {
 
 func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Return, error) {
 	r := new(Return)
-	if err := json.Unmarshal([]byte(raw.ToString()), r); err != nil {
+	if err := src.UnmarshalAny(raw, r); err != nil {
 		logger.Errorf("Failed to unmarshal Return: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal Return: [%v]", err)
 	}
@@ -87,28 +64,8 @@ func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Retur
 		if expression.Size() > 0 {
 			var rExpression ASTNode
 			var err error
-			var expressionNodeType = expression.Get("nodeType").ToString()
-
-			switch expressionNodeType {
-			case "IndexAccess":
-				rExpression, err = GetIndexAccess(gn, expression, logger)
-			case "Literal":
-				rExpression, err = GetLiteral(gn, expression, logger)
-			case "Conditional":
-				rExpression, err = GetConditional(gn, expression, logger)
-			case "BinaryOperation":
-				rExpression, err = GetBinaryOperation(gn, expression, logger)
-			case "MemberAccess":
-				rExpression, err = GetMemberAccess(gn, expression, logger)
-			case "Identifier":
-				rExpression, err = GetIdentifier(gn, expression, logger)
-			case "FunctionCall":
-				rExpression, err = GetFunctionCall(gn, expression, logger)
-			case "TupleExpression":
-				rExpression, err = GetTupleExpression(gn, expression, logger)
-			default:
-				logger.Warnf("Unknown expression nodeType [%s] for Return [src:%s].", expressionNodeType, r.Src)
-			}
+
+			rExpression, err = GetExpression(gn, expression, "expression", "Return", r.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -127,28 +84,6 @@ func GetReturn(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Retur
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (r *Return) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	// expression
-	{
-		if r.expression != nil {
-			switch expression := r.expression.(type) {
-			case *IndexAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *Conditional:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *BinaryOperation:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *MemberAccess:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *FunctionCall:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *TupleExpression:
-				expression.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
 func (r *Return) SetExpression(expression ASTNode) {
 	r.expression = expression
-}
\ No newline at end of file
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -29,9 +29,9 @@ func (rs *RevertStatement) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + " " + errorCall.SourceCode(false, false, indent, logger)
 		default:
 			if errorCall != nil {
-				logger.Warnf("Unknown errorCall nodeType [%s] for RevertStatement [src:%s].", errorCall.Type(), rs.Src)
+				src.Dropf(logger, "Unknown errorCall nodeType [%s] for RevertStatement [src:%s].", errorCall.Type(), rs.Src)
 			} else {
-				logger.Warnf("Unknown errorCall nodeType for RevertStatement [src:%s].", rs.Src)
+				src.Dropf(logger, "Unknown errorCall nodeType for RevertStatement [src:%s].", rs.Src)
 			}
 		}
 	}
@@ -57,7 +57,7 @@ func (rs *RevertStatement) NodeID() int {
 
 func GetRevertStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*RevertStatement, error) {
 	rs := new(RevertStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), rs); err != nil {
+	if err := src.UnmarshalAny(raw, rs); err != nil {
 		logger.Errorf("Failed to unmarshal RevertStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal RevertStatement: [%v]", err)
 	}
@@ -75,6 +75,7 @@ func GetRevertStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				rsErrorCall, err = GetFunctionCall(gn, errorCall, logger)
 			default:
 				logger.Warnf("Unknown errorCall nodeType [%s] for RevertStatement [src:%s].", errorCallNodeType, rs.Src)
+				gn.AddUnknownNode(errorCallNodeType, errorCall.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -91,14 +92,3 @@ func GetRevertStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 	return rs, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (rs *RevertStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if rs.errorCall != nil {
-		switch errorCall := rs.errorCall.(type) {
-		case *FunctionCall:
-			errorCall.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -35,8 +35,18 @@ func (su *SourceUnit) SourceCode(isSc bool, isIndent bool, indent string, logger
 			code = code + node.SourceCode(true, false, indent, logger) + "\n"
 		case "VariableDeclaration":
 			code = code + node.SourceCode(true, false, indent, logger) + "\n"
+		case "ImportDirective":
+			code = code + node.SourceCode(true, false, indent, logger) + "\n"
+		case "FunctionDefinition":
+			code = code + node.SourceCode(false, false, indent, logger) + "\n"
+		case "StructDefinition":
+			code = code + node.SourceCode(false, false, indent, logger) + "\n"
+		case "EnumDefinition":
+			code = code + node.SourceCode(false, false, indent, logger) + "\n"
+		case "UserDefinedValueTypeDefinition":
+			code = code + node.SourceCode(true, false, indent, logger) + "\n"
 		default:
-			logger.Warnf("Unknown node nodeType [%s] for SourceUnit [src:%s].", node.Type(), su.Src)
+			src.Dropf(logger, "Unknown node nodeType [%s] for SourceUnit [src:%s].", node.Type(), su.Src)
 		}
 	}
 
@@ -63,8 +73,14 @@ func (su *SourceUnit) AppendNode(node ASTNode) {
 }
 
 func GetSourceUnit(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*SourceUnit, error) {
+	raw, err := src.Preload(raw)
+	if err != nil {
+		logger.Errorf("Failed to load SourceUnit: [%v].", err)
+		return nil, fmt.Errorf("failed to load SourceUnit: [%v]", err)
+	}
+
 	su := new(SourceUnit)
-	if err := json.Unmarshal([]byte(raw.ToString()), su); err != nil {
+	if err := src.UnmarshalAny(raw, su); err != nil {
 		logger.Errorf("Failed to unmarshal SourceUnit: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal SourceUnit: [%v]", err)
 	}
@@ -98,8 +114,40 @@ func GetSourceUnit(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*S
 				return nil, err
 			}
 			su.AppendNode(variableDeclaration)
+		case "ImportDirective":
+			importDirective, err := GetImportDirective(gn, sourceUnitChild, logger)
+			if err != nil {
+				return nil, err
+			}
+			su.AppendNode(importDirective)
+		case "FunctionDefinition":
+			functionDefinition, err := GetFunctionDefinition(gn, sourceUnitChild, logger)
+			if err != nil {
+				return nil, err
+			}
+			functionDefinition.MakeSignature("", logger)
+			su.AppendNode(functionDefinition)
+		case "StructDefinition":
+			structDefinition, err := GetStructDefinition(gn, sourceUnitChild, logger)
+			if err != nil {
+				return nil, err
+			}
+			su.AppendNode(structDefinition)
+		case "EnumDefinition":
+			enumDefinition, err := GetEnumDefinition(gn, sourceUnitChild, logger)
+			if err != nil {
+				return nil, err
+			}
+			su.AppendNode(enumDefinition)
+		case "UserDefinedValueTypeDefinition":
+			userDefinedValueTypeDefinition, err := GetUserDefinedValueTypeDefinition(gn, sourceUnitChild, logger)
+			if err != nil {
+				return nil, err
+			}
+			su.AppendNode(userDefinedValueTypeDefinition)
 		default:
 			logger.Warnf("Unknown node nodeType [%s] for SourceUnit [src:%s].", sourceUnitChildType, su.Src)
+			gn.AddUnknownNode(sourceUnitChildType, sourceUnitChild.Get("src").ToString())
 		}
 		
 	}
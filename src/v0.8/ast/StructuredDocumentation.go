@@ -0,0 +1,60 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type StructuredDocumentation struct {
+	ID       int    `json:"id"`
+	NodeType string `json:"nodeType"`
+	Src      string `json:"src"`
+	Text     string `json:"text"`
+}
+
+func (sd *StructuredDocumentation) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+
+	lines := strings.Split(sd.Text, "\n")
+	for index, line := range lines {
+		if isIndent {
+			code = code + indent
+		}
+
+		code = code + "///" + line
+
+		if index < len(lines)-1 {
+			code = code + "\n"
+		}
+	}
+
+	return code
+}
+
+func (sd *StructuredDocumentation) Type() string {
+	return sd.NodeType
+}
+
+func (sd *StructuredDocumentation) Nodes() []ASTNode {
+	return nil
+}
+
+func (sd *StructuredDocumentation) NodeID() int {
+	return sd.ID
+}
+
+func GetStructuredDocumentation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*StructuredDocumentation, error) {
+	sd := new(StructuredDocumentation)
+	if err := src.UnmarshalAny(raw, sd); err != nil {
+		logger.Errorf("Failed to unmarshal StructuredDocumentation: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal StructuredDocumentation: [%v]", err)
+	}
+
+	gn.AddASTNode(sd)
+
+	return sd, nil
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -26,9 +26,9 @@ func (tcc *TryCatchClause) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + "(" + parameters.SourceCode(false, false, indent, logger) + ")"
 		default:
 			if parameters != nil {
-				logger.Warnf("Unknown parameters nodeType [%s] for TryCatchClause [src:%s].", parameters.Type(), tcc.Src)
+				src.Dropf(logger, "Unknown parameters nodeType [%s] for TryCatchClause [src:%s].", parameters.Type(), tcc.Src)
 			} else {
-				logger.Warnf("Unknown parameters nodeType for TryCatchClause [src:%s].", tcc.Src)
+				src.Dropf(logger, "Unknown parameters nodeType for TryCatchClause [src:%s].", tcc.Src)
 			}
 		}
 	}
@@ -41,9 +41,9 @@ func (tcc *TryCatchClause) SourceCode(isSc bool, isIndent bool, indent string, l
 			code = code + block.SourceCode(false, false, indent, logger)
 		default:
 			if block != nil {
-				logger.Warnf("Unknown block nodeType [%s] for TryCatchClause [src:%s].", block.Type(), tcc.Src)
+				src.Dropf(logger, "Unknown block nodeType [%s] for TryCatchClause [src:%s].", block.Type(), tcc.Src)
 			} else {
-				logger.Warnf("Unknown block nodeType for TryCatchClause [src:%s].", tcc.Src)
+				src.Dropf(logger, "Unknown block nodeType for TryCatchClause [src:%s].", tcc.Src)
 			}
 		}
 	}
@@ -69,7 +69,7 @@ func (tcc *TryCatchClause) NodeID() int {
 
 func GetTryCatchClause(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*TryCatchClause, error) {
 	tcc := new(TryCatchClause)
-	if err := json.Unmarshal([]byte(raw.ToString()), tcc); err != nil {
+	if err := src.UnmarshalAny(raw, tcc); err != nil {
 		logger.Errorf("Failed to unmarshal TryCatchClause: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal TryCatchClause: [%v]", err)
 	}
@@ -87,6 +87,7 @@ func GetTryCatchClause(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				tccBlock, err = GetBlock(gn, block, logger)
 			default:
 				logger.Warnf("Unknown block nodeType [%s] for TryCatchClause [src:%s].", blockNodeType, tcc.Src)
+				gn.AddUnknownNode(blockNodeType, block.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -112,6 +113,7 @@ func GetTryCatchClause(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 				tccParameters, err = GetParameterList(gn, parameters, logger)
 			default:
 				logger.Warnf("Unknown parameters nodeType [%s] for TryCatchClause [src:%s].", parametersNodeType, tcc.Src)
+				gn.AddUnknownNode(parametersNodeType, parameters.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -127,31 +129,4 @@ func GetTryCatchClause(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 	gn.AddASTNode(tcc)
 
 	return tcc, nil
-}
-
-func (tcc *TryCatchClause) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if tcc.block != nil {
-		switch block := tcc.block.(type) {
-		case *Block:
-			block.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (tcc *TryCatchClause) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if tcc.block != nil {
-		switch block := tcc.block.(type) {
-		case *Block:
-			block.TraverseTaintOwner(opt, logger)
-		}
-	}
-}
-
-func (tcc *TryCatchClause) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if tcc.block != nil {
-		switch block := tcc.block.(type) {
-		case *Block:
-			block.TraverseDelegatecall(opt, logger)
-		}
-	}
 }
\ No newline at end of file
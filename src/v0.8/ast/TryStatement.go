@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -30,9 +30,9 @@ func (ts *TryStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 			code = code + " " + externalCall.SourceCode(false, false, indent, logger)
 		default:
 			if externalCall != nil {
-				logger.Warnf("Unknown externalCall nodeType [%s] for TryStatement [src:%s].", externalCall.Type(), ts.Src)
+				src.Dropf(logger, "Unknown externalCall nodeType [%s] for TryStatement [src:%s].", externalCall.Type(), ts.Src)
 			} else {
-				logger.Warnf("Unknown externalCall nodeType for TryStatement [src:%s].", ts.Src)
+				src.Dropf(logger, "Unknown externalCall nodeType for TryStatement [src:%s].", ts.Src)
 			}
 		}
 	}
@@ -48,9 +48,9 @@ func (ts *TryStatement) SourceCode(isSc bool, isIndent bool, indent string, logg
 				}
 			default:
 				if c != nil {
-					logger.Warnf("Unknown clause nodeType [%s] for TryStatement [src:%s].", c.Type(), ts.Src)
+					src.Dropf(logger, "Unknown clause nodeType [%s] for TryStatement [src:%s].", c.Type(), ts.Src)
 				} else {
-					logger.Warnf("Unknown clause nodeType for TryStatement [src:%s].", ts.Src)
+					src.Dropf(logger, "Unknown clause nodeType for TryStatement [src:%s].", ts.Src)
 				}
 			}
 		}
@@ -73,7 +73,7 @@ func (ts *TryStatement) NodeID() int {
 
 func GetTryStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*TryStatement, error) {
 	ts := new(TryStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), ts); err != nil {
+	if err := src.UnmarshalAny(raw, ts); err != nil {
 		logger.Errorf("Failed to unmarshal TryStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal TryStatement: [%v]", err)
 	}
@@ -96,6 +96,7 @@ func GetTryStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 						tsClause, err = GetTryCatchClause(gn, clause, logger)
 					default:
 						logger.Warnf("Unknown clause nodeType [%s] for TryStatement [src:%s].", clauseNodeType, ts.Src)
+						gn.AddUnknownNode(clauseNodeType, clause.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -125,6 +126,7 @@ func GetTryStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 				tsExternalCall, err = GetFunctionCall(gn, externalCall, logger)
 			default:
 				logger.Warnf("Unknown externalCall nodeType [%s] for TryStatement [src:%s].", externalCallNodeType, ts.Src)
+				gn.AddUnknownNode(externalCallNodeType, externalCall.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -141,50 +143,3 @@ func GetTryStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (
 
 	return ts, nil
 }
-
-func (ts *TryStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if ts.externalCall != nil {
-		switch externalCall := ts.externalCall.(type) {
-		case *FunctionCall:
-			externalCall.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if len(ts.clauses) > 0 {
-		for _, clause := range ts.clauses {
-			switch c := clause.(type) {
-			case *TryCatchClause:
-				c.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (ts *TryStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if len(ts.clauses) > 0 {
-		for _, clause := range ts.clauses {
-			switch c := clause.(type) {
-			case *TryCatchClause:
-				c.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-}
-
-func (ts *TryStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if ts.externalCall != nil {
-		switch externalCall := ts.externalCall.(type) {
-		case *FunctionCall:
-			externalCall.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if len(ts.clauses) > 0 {
-		for _, clause := range ts.clauses {
-			switch c := clause.(type) {
-			case *TryCatchClause:
-				c.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -34,30 +34,7 @@ func (te *TupleExpression) SourceCode(isSc bool, isIndent bool, indent string, l
 
 	if len(te.components) > 0 {
 		for index, component := range te.components {
-			switch c := component.(type) {
-			case *BinaryOperation:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *ElementaryTypeNameExpression:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *Identifier:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *Literal:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *Conditional:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *IndexAccess:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *FunctionCall:
-				code = code + c.SourceCode(false, false, indent, logger)
-			case *MemberAccess:
-				code = code + c.SourceCode(false, false, indent, logger)
-			default:
-				if c != nil {
-					logger.Warnf("Unknown component nodeType [%s] for TupleExpression [src:%s].", c.Type(), te.Src)
-				} else {
-					logger.Warnf("Unknown component nodeType for TupleExpression [src:%s].", te.Src)
-				}
-			}
+			code = code + ExpressionCode(component, indent, "component", "TupleExpression", te.Src, logger)
 			if index < len(te.components)-1 {
 				code = code + ", "
 			}
@@ -85,7 +62,7 @@ func (te *TupleExpression) NodeID() int {
 
 func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*TupleExpression, error) {
 	te := new(TupleExpression)
-	if err := json.Unmarshal([]byte(raw.ToString()), te); err != nil {
+	if err := src.UnmarshalAny(raw, te); err != nil {
 		logger.Errorf("Failed to unmarshal TupleExpression: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal TupleExpression: [%v]", err)
 	}
@@ -101,28 +78,8 @@ func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 				var err error
 
 				if component.Size() > 0 {
-					componentNodeType := component.Get("nodeType").ToString()
-
-					switch componentNodeType {
-					case "BinaryOperation":
-						teComponent, err = GetBinaryOperation(gn, component, logger)
-					case "ElementaryTypeNameExpression":
-						teComponent, err = GetElementaryTypeNameExpression(gn, component, logger)
-					case "Identifier":
-						teComponent, err = GetIdentifier(gn, component, logger)
-					case "Literal":
-						teComponent, err = GetLiteral(gn, component, logger)
-					case "Conditional":
-						teComponent, err = GetConditional(gn, component, logger)
-					case "IndexAccess":
-						teComponent, err = GetIndexAccess(gn, component, logger)
-					case "FunctionCall":
-						teComponent, err = GetFunctionCall(gn, component, logger)
-					case "MemberAccess":
-						teComponent, err = GetMemberAccess(gn, component, logger)
-					default:
-						logger.Warnf("Unknown component nodeType [%s] for TupleExpression [src:%s].", componentNodeType, te.Src)
-					}
+
+					teComponent, err = GetExpression(gn, component, "component", "TupleExpression", te.Src, logger)
 				} else {
 					teComponent = &Literal{
 						ID:       0,
@@ -148,16 +105,3 @@ func GetTupleExpression(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger
 
 	return te, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (te *TupleExpression) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(te.components) > 0 {
-		for _, component := range te.components {
-			switch c := component.(type) {
-			case *BinaryOperation:
-				c.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -32,21 +32,7 @@ func (uo *UnaryOperation) SourceCode(isSc bool, isIndent bool, indent string, lo
 	}
 
 	if uo.subExpression != nil {
-		var expression string
-		switch subExpression := uo.subExpression.(type) {
-		case *Identifier:
-			expression = subExpression.SourceCode(false, false, indent, logger)
-		case *FunctionCall:
-			expression = subExpression.SourceCode(false, false, indent, logger)
-		case *IndexAccess:
-			expression = subExpression.SourceCode(false, false, indent, logger)
-		default:
-			if subExpression != nil {
-				logger.Warnf("Unknown subExpression nodeType [%s] for UnaryOperation [src:%s].", subExpression.Type(), uo.Src)
-			} else {
-				logger.Warnf("Unknown subExpression nodeType for UnaryOperation [src:%s].", uo.Src)
-			}
-		}
+		expression := ExpressionCode(uo.subExpression, indent, "subExpression", "UnaryOperation", uo.Src, logger)
 		if uo.Prefix {
 			if uo.Operator == "delete" {
 				code = code + uo.Operator + " " + expression
@@ -81,7 +67,7 @@ func (uo *UnaryOperation) NodeID() int {
 
 func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UnaryOperation, error) {
 	uo := new(UnaryOperation)
-	if err := json.Unmarshal([]byte(raw.ToString()), uo); err != nil {
+	if err := src.UnmarshalAny(raw, uo); err != nil {
 		logger.Errorf("Failed to unmarshal UnaryOperation: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UnaryOperation: [%v]", err)
 	}
@@ -90,20 +76,10 @@ func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 	{
 		subExpression := raw.Get("subExpression")
 		if subExpression.Size() > 0 {
-			subExpressionNodeType := subExpression.Get("nodeType").ToString()
 			var uoSubExpression ASTNode
 			var err error
 
-			switch subExpressionNodeType {
-			case "Identifier":
-				uoSubExpression, err = GetIdentifier(gn, subExpression, logger)
-			case "FunctionCall":
-				uoSubExpression, err = GetFunctionCall(gn, subExpression, logger)
-			case "IndexAccess":
-				uoSubExpression, err = GetIndexAccess(gn, subExpression, logger)
-			default:
-				logger.Warnf("Unknown subExpression nodeType [%s] for UnaryOperation [src:%s].", subExpressionNodeType, uo.Src)
-			}
+			uoSubExpression, err = GetExpression(gn, subExpression, "subExpression", "UnaryOperation", uo.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -119,16 +95,3 @@ func GetUnaryOperation(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return uo, nil
 }
-
-/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (uo *UnaryOperation) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if uo.subExpression != nil {
-		switch subExpression := uo.subExpression.(type) {
-		case *FunctionCall:
-			subExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *IndexAccess:
-			subExpression.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
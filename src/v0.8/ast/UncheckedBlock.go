@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -40,11 +40,15 @@ func (ub *UncheckedBlock) SourceCode(isSc bool, isIndent bool, indent string, lo
 				code = code + stat.SourceCode(false, true, indent+"    ", logger)
 			case *EmitStatement:
 				code = code + stat.SourceCode(true, true, indent+"    ", logger)
+			case *Break:
+				code = code + stat.SourceCode(true, true, indent+"    ", logger)
+			case *Continue:
+				code = code + stat.SourceCode(true, true, indent+"    ", logger)
 			default:
 				if stat != nil {
-					logger.Warnf("Unknown statement nodeType [%s] for UncheckedBlock [src:%s].", stat.Type(), ub.Src)
+					src.Dropf(logger, "Unknown statement nodeType [%s] for UncheckedBlock [src:%s].", stat.Type(), ub.Src)
 				} else {
-					logger.Warnf("Unknown statement nodeType for UncheckedBlock [src:%s].", ub.Src)
+					src.Dropf(logger, "Unknown statement nodeType for UncheckedBlock [src:%s].", ub.Src)
 				}
 			}
 			code = code + "\n"
@@ -73,7 +77,7 @@ func (ub *UncheckedBlock) NodeID() int {
 
 func GetUncheckedBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UncheckedBlock, error) {
 	ub := new(UncheckedBlock)
-	if err := json.Unmarshal([]byte(raw.ToString()), ub); err != nil {
+	if err := src.UnmarshalAny(raw, ub); err != nil {
 		logger.Errorf("Failed to unmarshal UncheckedBlock: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UncheckedBlock: [%v]", err)
 	}
@@ -106,8 +110,13 @@ func GetUncheckedBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 						ubStatement, err = GetWhileStatement(gn, statement, logger)
 					case "EmitStatement":
 						ubStatement, err = GetEmitStatement(gn, statement, logger)
+					case "Break":
+						ubStatement, err = GetBreak(gn, statement, logger)
+					case "Continue":
+						ubStatement, err = GetContinue(gn, statement, logger)
 					default:
 						logger.Warnf("Unknown statement nodeType [%s] for UncheckedBlock [src:%s].", statementNodeType, ub.Src)
+						gn.AddUnknownNode(statementNodeType, statement.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -126,42 +135,3 @@ func GetUncheckedBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return ub, nil
 }
-
-//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
-
-func (ub *UncheckedBlock) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if len(ub.statements) > 0 {
-		for _, statement := range ub.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			case *VariableDeclarationStatement:
-				stat.TraverseFunctionCall(ncp, gn, opt, logger)
-			}
-		}
-	}
-}
-
-func (ub *UncheckedBlock) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if len(ub.statements) > 0 {
-		for _, statement := range ub.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				stat.TraverseTaintOwner(opt, logger)
-			}
-		}
-	}
-}
-
-func (ub *UncheckedBlock) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if len(ub.statements) > 0 {
-		for _, statement := range ub.statements {
-			switch stat := statement.(type) {
-			case *ExpressionStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			case *VariableDeclarationStatement:
-				stat.TraverseDelegatecall(opt, logger)
-			}
-		}
-	}
-}
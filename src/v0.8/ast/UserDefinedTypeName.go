@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -32,9 +32,9 @@ func (udtn *UserDefinedTypeName) SourceCode(isSc bool, isIndent bool, indent str
 			code = code + pathNode.SourceCode(false, false, indent, logger)
 		default:
 			if pathNode != nil {
-				logger.Warnf("Unknown pathNode nodeType [%s] for UserDefinedTypeName [src:%s].", pathNode.Type(), udtn.Src)
+				src.Dropf(logger, "Unknown pathNode nodeType [%s] for UserDefinedTypeName [src:%s].", pathNode.Type(), udtn.Src)
 			} else {
-				logger.Warnf("Unknown pathNode nodeType for UserDefinedTypeName [src:%s].", udtn.Src)
+				src.Dropf(logger, "Unknown pathNode nodeType for UserDefinedTypeName [src:%s].", udtn.Src)
 			}
 		}
 	}
@@ -60,7 +60,7 @@ func (udtn *UserDefinedTypeName) NodeID() int {
 
 func GetUserDefinedTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UserDefinedTypeName, error) {
 	udtn := new(UserDefinedTypeName)
-	if err := json.Unmarshal([]byte(raw.ToString()), udtn); err != nil {
+	if err := src.UnmarshalAny(raw, udtn); err != nil {
 		logger.Errorf("Failed to unmarshal UserDefinedTypeName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal UserDefinedTypeName: [%v]", err)
 	}
@@ -78,6 +78,7 @@ func GetUserDefinedTypeName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 				udtnPathNode, err = GetIdentifierPath(gn, pathNode, logger)
 			default:
 				logger.Warnf("Unknown pathNode nodeType [%s] for UserDefinedTypeName [src:%s].", pathNodeNodeType, udtn.Src)
+				gn.AddUnknownNode(pathNodeNodeType, pathNode.Get("src").ToString())
 			}
 
 			if err != nil {
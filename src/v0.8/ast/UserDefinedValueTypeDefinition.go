@@ -0,0 +1,97 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type UserDefinedValueTypeDefinition struct {
+	CanonicalName  string `json:"canonicalName"`
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	NameLocation   string `json:"nameLocation"`
+	NodeType       string `json:"nodeType"`
+	Src            string `json:"src"`
+	underlyingType ASTNode
+}
+
+func (udvtd *UserDefinedValueTypeDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "type" + " " + udvtd.Name + " " + "is"
+
+	if udvtd.underlyingType != nil {
+		switch underlyingType := udvtd.underlyingType.(type) {
+		case *ElementaryTypeName:
+			code = code + " " + underlyingType.SourceCode(false, false, indent, logger)
+		default:
+			if underlyingType != nil {
+				src.Dropf(logger, "Unknown underlyingType nodeType [%s] for UserDefinedValueTypeDefinition [src:%s].", underlyingType.Type(), udvtd.Src)
+			} else {
+				src.Dropf(logger, "Unknown underlyingType nodeType for UserDefinedValueTypeDefinition [src:%s].", udvtd.Src)
+			}
+		}
+	}
+
+	if isSc {
+		code = code + ";"
+	}
+
+	return code
+}
+
+func (udvtd *UserDefinedValueTypeDefinition) Type() string {
+	return udvtd.NodeType
+}
+
+func (udvtd *UserDefinedValueTypeDefinition) Nodes() []ASTNode {
+	return nil
+}
+
+func (udvtd *UserDefinedValueTypeDefinition) NodeID() int {
+	return udvtd.ID
+}
+
+func GetUserDefinedValueTypeDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*UserDefinedValueTypeDefinition, error) {
+	udvtd := new(UserDefinedValueTypeDefinition)
+	if err := src.UnmarshalAny(raw, udvtd); err != nil {
+		logger.Errorf("Failed to unmarshal UserDefinedValueTypeDefinition: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal UserDefinedValueTypeDefinition: [%v]", err)
+	}
+
+	// underlyingType
+	{
+		underlyingType := raw.Get("underlyingType")
+		if underlyingType.Size() > 0 {
+			underlyingTypeNodeType := underlyingType.Get("nodeType").ToString()
+			var udvtdUnderlyingType ASTNode
+			var err error
+
+			switch underlyingTypeNodeType {
+			case "ElementaryTypeName":
+				udvtdUnderlyingType, err = GetElementaryTypeName(gn, underlyingType, logger)
+			default:
+				logger.Warnf("Unknown underlyingType nodeType [%s] for UserDefinedValueTypeDefinition [src:%s].", underlyingTypeNodeType, udvtd.Src)
+				gn.AddUnknownNode(underlyingTypeNodeType, underlyingType.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if udvtdUnderlyingType != nil {
+				udvtd.underlyingType = udvtdUnderlyingType
+			}
+		}
+	}
+
+	gn.AddASTNode(udvtd)
+
+	return udvtd, nil
+}
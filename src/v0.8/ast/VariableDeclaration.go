@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -46,11 +46,13 @@ func (vd *VariableDeclaration) SourceCode(isSc bool, isIndent bool, indent strin
 			code = code + typeName.SourceCode(false, false, indent, logger)
 		case *ArrayTypeName:
 			code = code + typeName.SourceCode(false, false, indent, logger)
+		case *FunctionTypeName:
+			code = code + typeName.SourceCode(false, false, indent, logger)
 		default:
 			if typeName != nil {
-				logger.Warnf("Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeName.Type(), vd.Src)
+				src.Dropf(logger, "Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeName.Type(), vd.Src)
 			} else {
-				logger.Warnf("Unknown typeName nodeType for VariableDeclaration [src:%s].", vd.Src)
+				src.Dropf(logger, "Unknown typeName nodeType for VariableDeclaration [src:%s].", vd.Src)
 			}
 		}
 	}
@@ -76,28 +78,7 @@ func (vd *VariableDeclaration) SourceCode(isSc bool, isIndent bool, indent strin
 	}
 
 	if vd.value != nil {
-		switch value := vd.value.(type) {
-		case *Literal:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *BinaryOperation:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *FunctionCall:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *Identifier:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *MemberAccess:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *UnaryOperation:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		case *TupleExpression:
-			code = code + " = " + value.SourceCode(false, false, indent, logger)
-		default:
-			if value != nil {
-				logger.Warnf("Unknown value nodeType [%s] for VariableDeclaration [src:%s].", value.Type(), vd.Src)
-			} else {
-				logger.Warnf("Unknown value nodeType for VariableDeclaration [src:%s].", vd.Src)
-			}
-		}
+		code = code + " = " + ExpressionCode(vd.value, indent, "value", "VariableDeclaration", vd.Src, logger)
 	}
 
 	if isSc {
@@ -121,7 +102,7 @@ func (vd *VariableDeclaration) NodeID() int {
 
 func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*VariableDeclaration, error) {
 	vd := new(VariableDeclaration)
-	if err := json.Unmarshal([]byte(raw.ToString()), vd); err != nil {
+	if err := src.UnmarshalAny(raw, vd); err != nil {
 		logger.Errorf("Failed to unmarshal VariableDeclaration: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal VariableDeclaration: [%v]", err)
 	}
@@ -143,8 +124,11 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 			vdTypeName, err = GetUserDefinedTypeName(gn, typeName, logger)
 		case "ArrayTypeName":
 			vdTypeName, err = GetArrayTypeName(gn, typeName, logger)
+		case "FunctionTypeName":
+			vdTypeName, err = GetFunctionTypeName(gn, typeName, logger)
 		default:
 			logger.Warnf("Unknown typeName nodeType [%s] for VariableDeclaration [src:%s].", typeNameNodeType, vd.Src)
+			gn.AddUnknownNode(typeNameNodeType, typeName.Get("src").ToString())
 		}
 
 		if err != nil {
@@ -157,29 +141,11 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 	{
 		value := raw.Get("value")
 		if value.Size() > 0 {
-			valueNodeType := value.Get("nodeType").ToString()
 
 			var vdValue ASTNode
 			var err error
 
-			switch valueNodeType {
-			case "Literal":
-				vdValue, err = GetLiteral(gn, value, logger)
-			case "BinaryOperation":
-				vdValue, err = GetBinaryOperation(gn, value, logger)
-			case "FunctionCall":
-				vdValue, err = GetFunctionCall(gn, value, logger)
-			case "Identifier":
-				vdValue, err = GetIdentifier(gn, value, logger)
-			case "MemberAccess":
-				vdValue, err = GetMemberAccess(gn, value, logger)
-			case "UnaryOperation":
-				vdValue, err = GetUnaryOperation(gn, value, logger)
-			case "TupleExpression":
-				vdValue, err = GetTupleExpression(gn, value, logger)
-			default:
-				logger.Warnf("Unknown value nodeType [%s] for VariableDeclaration [src:%s]", valueNodeType, vd.Src)
-			}
+			vdValue, err = GetExpression(gn, value, "value", "VariableDeclaration", vd.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -198,24 +164,6 @@ func GetVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Lo
 
 /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (vd *VariableDeclaration) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if vd.typeName != nil {
-		switch typeName := vd.typeName.(type) {
-		case *Mapping:
-			typeName.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if vd.value != nil {
-		switch value := vd.value.(type) {
-		case *BinaryOperation:
-			value.TraverseFunctionCall(ncp, gn, opt, logger)
-		case *FunctionCall:
-			value.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
 func (vd *VariableDeclaration) SetTypeName(typeName ASTNode) {
 	vd.typeName = typeName
 }
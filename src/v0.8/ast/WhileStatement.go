@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -25,20 +25,7 @@ func (ws *WhileStatement) SourceCode(isSc bool, isIndent bool, indent string, lo
 	code = code + "while"
 
 	if ws.condition != nil {
-		switch condition := ws.condition.(type) {
-		case *FunctionCall:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ") "
-		case *BinaryOperation:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ") "
-		case *Literal:
-			code = code + " (" + condition.SourceCode(false, false, indent, logger) + ") "
-		default:
-			if condition != nil {
-				logger.Warnf("Unknown condition nodeType [%s] for WhileStatement [src:%s].", condition.Type(), ws.Src)
-			} else {
-				logger.Warnf("Unknown condition nodeType for WhileStatement [src:%s].", ws.Src)
-			}
-		}
+		code = code + " (" + ExpressionCode(ws.condition, indent, "condition", "WhileStatement", ws.Src, logger) + ") "
 	}
 
 	code = code + "{\n"
@@ -47,11 +34,23 @@ func (ws *WhileStatement) SourceCode(isSc bool, isIndent bool, indent string, lo
 		switch body := ws.body.(type) {
 		case *Block:
 			code = code + body.SourceCode(false, false, indent, logger)
+		case *ExpressionStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *RevertStatement:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Return:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *IfStatement:
+			code = code + body.SourceCode(false, true, indent+"    ", logger)
+		case *Break:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
+		case *Continue:
+			code = code + body.SourceCode(true, true, indent+"    ", logger)
 		default:
 			if body != nil {
-				logger.Warnf("Unknown body nodeType [%s] for WhileStatement [src:%s].", body.Type(), ws.Src)
+				src.Dropf(logger, "Unknown body nodeType [%s] for WhileStatement [src:%s].", body.Type(), ws.Src)
 			} else {
-				logger.Warnf("Unknown body nodeType for WhileStatement [src:%s].", ws.Src)
+				src.Dropf(logger, "Unknown body nodeType for WhileStatement [src:%s].", ws.Src)
 			}
 		}
 	}
@@ -81,7 +80,7 @@ func (ws *WhileStatement) NodeID() int {
 
 func GetWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*WhileStatement, error) {
 	ws := new(WhileStatement)
-	if err := json.Unmarshal([]byte(raw.ToString()), ws); err != nil {
+	if err := src.UnmarshalAny(raw, ws); err != nil {
 		logger.Errorf("Failed to unmarshal WhileStatement: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal WhileStatement: [%v]", err)
 	}
@@ -97,8 +96,21 @@ func GetWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 			switch bodyNodeType {
 			case "Block":
 				wsBody, err = GetBlock(gn, body, logger)
+			case "ExpressionStatement":
+				wsBody, err = GetExpressionStatement(gn, body, logger)
+			case "RevertStatement":
+				wsBody, err = GetRevertStatement(gn, body, logger)
+			case "Return":
+				wsBody, err = GetReturn(gn, body, logger)
+			case "IfStatement":
+				wsBody, err = GetIfStatement(gn, body, logger)
+			case "Break":
+				wsBody, err = GetBreak(gn, body, logger)
+			case "Continue":
+				wsBody, err = GetContinue(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for WhileStatement [src:%s].", bodyNodeType, ws.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -115,20 +127,10 @@ func GetWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 	{
 		condition := raw.Get("condition")
 		if condition.Size() > 0 {
-			conditionNodeType := condition.Get("nodeType").ToString()
 			var wsCondition ASTNode
 			var err error
 
-			switch conditionNodeType {
-			case "FunctionCall":
-				wsCondition, err = GetFunctionCall(gn, condition, logger)
-			case "BinaryOperation":
-				wsCondition, err = GetBinaryOperation(gn, condition, logger)
-			case "Literal":
-				wsCondition, err = GetLiteral(gn, condition, logger)
-			default:
-				logger.Warnf("Unknown condition nodeType [%s] for WhileStatement [src:%s].", conditionNodeType, ws.Src)
-			}
+			wsCondition, err = GetExpression(gn, condition, "condition", "WhileStatement", ws.Src, logger)
 
 			if err != nil {
 				return nil, err
@@ -144,44 +146,3 @@ func GetWhileStatement(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger)
 
 	return ws, nil
 }
-
-func (ws *WhileStatement) TraverseFunctionCall(ncp *NormalCallPath, gn *GlobalNodes, opt *Option, logger logging.Logger) {
-	if ws.body != nil {
-		switch body := ws.body.(type) {
-		case *Block:
-			body.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-
-	if ws.condition != nil {
-		switch condition := ws.condition.(type) {
-		case *FunctionCall:
-			condition.TraverseFunctionCall(ncp, gn, opt, logger)
-		}
-	}
-}
-
-func (ws *WhileStatement) TraverseTaintOwner(opt *Option, logger logging.Logger) {
-	if ws.body != nil {
-		switch body := ws.body.(type) {
-		case *Block:
-			body.TraverseTaintOwner(opt, logger)
-		}
-	}
-}
-
-func (ws *WhileStatement) TraverseDelegatecall(opt *Option, logger logging.Logger) {
-	if ws.body != nil {
-		switch body := ws.body.(type) {
-		case *Block:
-			body.TraverseDelegatecall(opt, logger)
-		}
-	}
-
-	if ws.condition != nil {
-		switch condition := ws.condition.(type) {
-		case *FunctionCall:
-			condition.TraverseDelegatecall(opt, logger)
-		}
-	}
-}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -38,11 +38,17 @@ func (yb *YulBlock) SourceCode(isSc bool, isIndent bool, indent string, logger l
 				code = code + stat.SourceCode(false, true, indent+"    ", logger)
 			case *YulBreak:
 				code = code + stat.SourceCode(false, true, indent+"    ", logger)
+			case *YulContinue:
+				code = code + stat.SourceCode(false, true, indent+"    ", logger)
+			case *YulLeave:
+				code = code + stat.SourceCode(false, true, indent+"    ", logger)
+			case *YulFunctionDefinition:
+				code = code + stat.SourceCode(false, true, indent+"    ", logger)
 			default:
 				if stat != nil {
-					logger.Warnf("Unknown statement nodeType [%s] for YulBlock [src:%s].", stat.Type(), yb.Src)
+					src.Dropf(logger, "Unknown statement nodeType [%s] for YulBlock [src:%s].", stat.Type(), yb.Src)
 				} else {
-					logger.Warnf("Unknown statement nodeType for YulBlock [src:%s].", yb.Src)
+					src.Dropf(logger, "Unknown statement nodeType for YulBlock [src:%s].", yb.Src)
 				}
 			}
 
@@ -69,7 +75,7 @@ func (yb *YulBlock) NodeID() int {
 
 func GetYulBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulBlock, error) {
 	yb := new(YulBlock)
-	if err := json.Unmarshal([]byte(raw.ToString()), yb); err != nil {
+	if err := src.UnmarshalAny(raw, yb); err != nil {
 		logger.Errorf("Failed to unmarshal YulBlock: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulBlock: [%v]", err)
 	}
@@ -102,8 +108,15 @@ func GetYulBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Yul
 						ybStatement, err = GetYulIf(gn, statement, logger)
 					case "YulBreak":
 						ybStatement, err = GetYulBreak(gn, statement, logger)
+					case "YulContinue":
+						ybStatement, err = GetYulContinue(gn, statement, logger)
+					case "YulLeave":
+						ybStatement, err = GetYulLeave(gn, statement, logger)
+					case "YulFunctionDefinition":
+						ybStatement, err = GetYulFunctionDefinition(gn, statement, logger)
 					default:
 						logger.Warnf("Unknown statement nodeType [%s] for YulBlock [src:%s].", statementNodeType, yb.Src)
+						gn.AddUnknownNode(statementNodeType, statement.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -122,3 +135,7 @@ func GetYulBlock(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Yul
 
 	return yb, nil
 }
+
+func (yb *YulBlock) AppendStatement(statement ASTNode) {
+	yb.statements = append(yb.statements, statement)
+}
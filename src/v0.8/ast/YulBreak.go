@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -38,7 +38,7 @@ func (yb *YulBreak) NodeID() int {
 
 func GetYulBreak(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulBreak, error) {
 	yb := new(YulBreak)
-	if err := json.Unmarshal([]byte(raw.ToString()), yb); err != nil {
+	if err := src.UnmarshalAny(raw, yb); err != nil {
 		logger.Errorf("Failed to unmarshal YulBreak: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulBreak: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -31,9 +31,9 @@ func (yc *YulCase) SourceCode(isSc bool, isIndent bool, indent string, logger lo
 			}
 		default:
 			if value != nil {
-				logger.Warnf("Unknown value nodeType [%s] for YulCase [src:%s].", value.Type(), yc.Src)
+				src.Dropf(logger, "Unknown value nodeType [%s] for YulCase [src:%s].", value.Type(), yc.Src)
 			} else {
-				logger.Warnf("Unknown value nodeType for YulCase [src:%s].", yc.Src)
+				src.Dropf(logger, "Unknown value nodeType for YulCase [src:%s].", yc.Src)
 			}
 		}
 	}
@@ -44,9 +44,9 @@ func (yc *YulCase) SourceCode(isSc bool, isIndent bool, indent string, logger lo
 			code = code + body.SourceCode(false, false, indent, logger)
 		default:
 			if body != nil {
-				logger.Warnf("Unknown body nodeType [%s] for YulCase [src:%s].", body.Type(), yc.Src)
+				src.Dropf(logger, "Unknown body nodeType [%s] for YulCase [src:%s].", body.Type(), yc.Src)
 			} else {
-				logger.Warnf("Unknown body nodeType for YulCase [src:%s].", yc.Src)
+				src.Dropf(logger, "Unknown body nodeType for YulCase [src:%s].", yc.Src)
 			}
 		}
 	}
@@ -74,7 +74,7 @@ func (yc *YulCase) NodeID() int {
 
 func GetYulCase(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulCase, error) {
 	yc := new(YulCase)
-	if err := json.Unmarshal([]byte(raw.ToString()), yc); err != nil {
+	if err := src.UnmarshalAny(raw, yc); err != nil {
 		logger.Errorf("Failed to unmarshal YulCase: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulCase: [%v]", err)
 	}
@@ -92,6 +92,7 @@ func GetYulCase(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulC
 				ycBody, err = GetYulBlock(gn, body, logger)
 			default:
 				logger.Warnf("Unknown body nodeType [%s] for YulCase [src:%s].", bodyNodeType, yc.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -118,6 +119,7 @@ func GetYulCase(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulC
 				ycValue, err = GetYulLiteral(gn, value, logger)
 			default:
 				logger.Warnf("Unknown value nodeType [%s] for YulCase [src:%s].", valueNodeType, yc.Src)
+				gn.AddUnknownNode(valueNodeType, value.Get("src").ToString())
 			}
 		} else {
 			if yc.body != nil {
@@ -0,0 +1,47 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type YulContinue struct {
+	NodeType string `json:"nodeType"`
+	Src      string `json:"src"`
+}
+
+func (yc *YulContinue) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "continue"
+
+	return code
+}
+
+func (yc *YulContinue) Type() string {
+	return yc.NodeType
+}
+
+func (yc *YulContinue) Nodes() []ASTNode {
+	return nil
+}
+
+func (yc *YulContinue) NodeID() int {
+	return -1
+}
+
+func GetYulContinue(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulContinue, error) {
+	yc := new(YulContinue)
+	if err := src.UnmarshalAny(raw, yc); err != nil {
+		logger.Errorf("Failed to unmarshal YulContinue: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal YulContinue: [%v]", err)
+	}
+
+	return yc, nil
+}
@@ -0,0 +1,210 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type YulFunctionDefinition struct {
+	body            ASTNode
+	Name            string `json:"name"`
+	NodeType        string `json:"nodeType"`
+	parameters      []ASTNode
+	returnVariables []ASTNode
+	Src             string `json:"src"`
+}
+
+func (yfd *YulFunctionDefinition) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "function" + " " + yfd.Name + "("
+
+	// parameters
+	{
+		if len(yfd.parameters) > 0 {
+			for index, parameter := range yfd.parameters {
+				switch p := parameter.(type) {
+				case *YulTypedName:
+					code = code + p.SourceCode(false, false, indent, logger)
+				default:
+					if p != nil {
+						src.Dropf(logger, "Unknown parameter nodeType [%s] for YulFunctionDefinition [src:%s].", p.Type(), yfd.Src)
+					} else {
+						src.Dropf(logger, "Unknown parameter nodeType for YulFunctionDefinition [src:%s].", yfd.Src)
+					}
+				}
+
+				if index < len(yfd.parameters)-1 {
+					code = code + ", "
+				}
+			}
+		}
+	}
+
+	code = code + ")"
+
+	// returnVariables
+	{
+		if len(yfd.returnVariables) > 0 {
+			code = code + " -> "
+			for index, returnVariable := range yfd.returnVariables {
+				switch rv := returnVariable.(type) {
+				case *YulTypedName:
+					code = code + rv.SourceCode(false, false, indent, logger)
+				default:
+					if rv != nil {
+						src.Dropf(logger, "Unknown returnVariable nodeType [%s] for YulFunctionDefinition [src:%s].", rv.Type(), yfd.Src)
+					} else {
+						src.Dropf(logger, "Unknown returnVariable nodeType for YulFunctionDefinition [src:%s].", yfd.Src)
+					}
+				}
+
+				if index < len(yfd.returnVariables)-1 {
+					code = code + ", "
+				}
+			}
+		}
+	}
+
+	code = code + " {\n"
+
+	// body
+	{
+		if yfd.body != nil {
+			switch body := yfd.body.(type) {
+			case *YulBlock:
+				code = code + body.SourceCode(false, false, indent, logger)
+			default:
+				if body != nil {
+					src.Dropf(logger, "Unknown body nodeType [%s] for YulFunctionDefinition [src:%s].", body.Type(), yfd.Src)
+				} else {
+					src.Dropf(logger, "Unknown body nodeType for YulFunctionDefinition [src:%s].", yfd.Src)
+				}
+			}
+		}
+	}
+
+	code = code + "\n"
+	if isIndent {
+		code = code + indent
+	}
+	code = code + "}"
+
+	return code
+}
+
+func (yfd *YulFunctionDefinition) Type() string {
+	return yfd.NodeType
+}
+
+func (yfd *YulFunctionDefinition) Nodes() []ASTNode {
+	return nil
+}
+
+func (yfd *YulFunctionDefinition) NodeID() int {
+	return -1
+}
+
+func GetYulFunctionDefinition(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulFunctionDefinition, error) {
+	yfd := new(YulFunctionDefinition)
+	if err := src.UnmarshalAny(raw, yfd); err != nil {
+		logger.Errorf("Failed to unmarshal YulFunctionDefinition: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal YulFunctionDefinition: [%v]", err)
+	}
+
+	// parameters
+	{
+		parameters := raw.Get("parameters")
+		if parameters.Size() > 0 {
+			yfd.parameters = make([]ASTNode, 0)
+
+			for i := 0; i < parameters.Size(); i++ {
+				parameter := parameters.Get(i)
+				parameterNodeType := parameter.Get("nodeType").ToString()
+				var yfdParameter ASTNode
+				var err error
+
+				switch parameterNodeType {
+				case "YulTypedName":
+					yfdParameter, err = GetYulTypedName(gn, parameter, logger)
+				default:
+					logger.Warnf("Unknown parameter nodeType [%s] for YulFunctionDefinition [src:%s].", parameterNodeType, yfd.Src)
+					gn.AddUnknownNode(parameterNodeType, parameter.Get("src").ToString())
+				}
+
+				if err != nil {
+					return nil, err
+				}
+
+				if yfdParameter != nil {
+					yfd.parameters = append(yfd.parameters, yfdParameter)
+				}
+			}
+		}
+	}
+
+	// returnVariables
+	{
+		returnVariables := raw.Get("returnVariables")
+		if returnVariables.Size() > 0 {
+			yfd.returnVariables = make([]ASTNode, 0)
+
+			for i := 0; i < returnVariables.Size(); i++ {
+				returnVariable := returnVariables.Get(i)
+				returnVariableNodeType := returnVariable.Get("nodeType").ToString()
+				var yfdReturnVariable ASTNode
+				var err error
+
+				switch returnVariableNodeType {
+				case "YulTypedName":
+					yfdReturnVariable, err = GetYulTypedName(gn, returnVariable, logger)
+				default:
+					logger.Warnf("Unknown returnVariable nodeType [%s] for YulFunctionDefinition [src:%s].", returnVariableNodeType, yfd.Src)
+					gn.AddUnknownNode(returnVariableNodeType, returnVariable.Get("src").ToString())
+				}
+
+				if err != nil {
+					return nil, err
+				}
+
+				if yfdReturnVariable != nil {
+					yfd.returnVariables = append(yfd.returnVariables, yfdReturnVariable)
+				}
+			}
+		}
+	}
+
+	// body
+	{
+		body := raw.Get("body")
+		if body.Size() > 0 {
+			bodyNodeType := body.Get("nodeType").ToString()
+			var yfdBody ASTNode
+			var err error
+
+			switch bodyNodeType {
+			case "YulBlock":
+				yfdBody, err = GetYulBlock(gn, body, logger)
+			default:
+				logger.Warnf("Unknown body nodeType [%s] for YulFunctionDefinition [src:%s].", bodyNodeType, yfd.Src)
+				gn.AddUnknownNode(bodyNodeType, body.Get("src").ToString())
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if yfdBody != nil {
+				yfd.body = yfdBody
+			}
+		}
+	}
+
+	return yfd, nil
+}
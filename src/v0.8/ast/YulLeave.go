@@ -0,0 +1,47 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type YulLeave struct {
+	NodeType string `json:"nodeType"`
+	Src      string `json:"src"`
+}
+
+func (yl *YulLeave) SourceCode(isSc bool, isIndent bool, indent string, logger logging.Logger) string {
+	var code string
+	if isIndent {
+		code = code + indent
+	}
+
+	code = code + "leave"
+
+	return code
+}
+
+func (yl *YulLeave) Type() string {
+	return yl.NodeType
+}
+
+func (yl *YulLeave) Nodes() []ASTNode {
+	return nil
+}
+
+func (yl *YulLeave) NodeID() int {
+	return -1
+}
+
+func GetYulLeave(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulLeave, error) {
+	yl := new(YulLeave)
+	if err := src.UnmarshalAny(raw, yl); err != nil {
+		logger.Errorf("Failed to unmarshal YulLeave: [%v].", err)
+		return nil, fmt.Errorf("failed to unmarshal YulLeave: [%v]", err)
+	}
+
+	return yl, nil
+}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -46,7 +46,7 @@ func (yl *YulLiteral) NodeID() int {
 
 func GetYulLiteral(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulLiteral, error) {
 	yl := new(YulLiteral)
-	if err := json.Unmarshal([]byte(raw.ToString()), yl); err != nil {
+	if err := src.UnmarshalAny(raw, yl); err != nil {
 		logger.Errorf("Failed to unmarshal YulLiteral: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulLiteral: [%v]", err)
 	}
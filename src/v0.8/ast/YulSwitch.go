@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -31,9 +31,9 @@ func (ys *YulSwitch) SourceCode(isSc bool, isIndent bool, indent string, logger
 			code = code + " " + expression.SourceCode(false, false, indent, logger) + "\n"
 		default:
 			if expression != nil {
-				logger.Warnf("Unknown expression nodeType [%s] for YulSwitch [src:%s].", expression.Type(), ys.Src)
+				src.Dropf(logger, "Unknown expression nodeType [%s] for YulSwitch [src:%s].", expression.Type(), ys.Src)
 			} else {
-				logger.Warnf("Unknown expression nodeType for YulSwitch [src:%s].", ys.Src)
+				src.Dropf(logger, "Unknown expression nodeType for YulSwitch [src:%s].", ys.Src)
 			}
 		}
 	}
@@ -45,9 +45,9 @@ func (ys *YulSwitch) SourceCode(isSc bool, isIndent bool, indent string, logger
 				code = code + ce.SourceCode(false, true, indent, logger) + "\n"
 			default:
 				if ce != nil {
-					logger.Warnf("Unknown case nodeType [%s] for YulSwitch [src:%s].", ce.Type(), ys.Src)
+					src.Dropf(logger, "Unknown case nodeType [%s] for YulSwitch [src:%s].", ce.Type(), ys.Src)
 				} else {
-					logger.Warnf("Unknown case nodeType for YulSwitch [src:%s].", ys.Src)
+					src.Dropf(logger, "Unknown case nodeType for YulSwitch [src:%s].", ys.Src)
 				}
 			}
 		}
@@ -70,7 +70,7 @@ func (ys *YulSwitch) NodeID() int {
 
 func GetYulSwitch(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulSwitch, error) {
 	ys := new(YulSwitch)
-	if err := json.Unmarshal([]byte(raw.ToString()), ys); err != nil {
+	if err := src.UnmarshalAny(raw, ys); err != nil {
 		logger.Errorf("Failed to unmarshal YulSwitch: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulSwitch: [%v]", err)
 	}
@@ -92,6 +92,7 @@ func GetYulSwitch(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Yu
 						ysCase, err = GetYulCase(gn, c, logger)
 					default:
 						logger.Warnf("Unknown case nodeType [%s] for YulSwitch [src:%s].", caseNodeType, ys.Src)
+						gn.AddUnknownNode(caseNodeType, c.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -123,6 +124,7 @@ func GetYulSwitch(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*Yu
 				ysExpression, err = GetYulIdentifier(gn, expression, logger)
 			default:
 				logger.Warnf("Unknown expression nodeType [%s] for YulSwitch [src:%s].", expressionNodeType, ys.Src)
+				gn.AddUnknownNode(expressionNodeType, expression.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -40,7 +40,7 @@ func (ytn *YulTypedName) NodeID() int {
 
 func GetYulTypedName(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulTypedName, error) {
 	ytn := new(YulTypedName)
-	if err := json.Unmarshal([]byte(raw.ToString()), ytn); err != nil {
+	if err := src.UnmarshalAny(raw, ytn); err != nil {
 		logger.Errorf("Failed to unmarshal YulTypedName: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulTypedName: [%v]", err)
 	}
@@ -1,10 +1,10 @@
 package ast
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -25,9 +25,9 @@ func (yvd *YulVariableDeclaration) SourceCode(isSc bool, isIndent bool, indent s
 				code = code + v.SourceCode(false, false, indent, logger)
 			default:
 				if v != nil {
-					logger.Warnf("Unknown variable nodeType [%s] for YulVariableDeclaration [src:%s].", v.Type(), yvd.Src)
+					src.Dropf(logger, "Unknown variable nodeType [%s] for YulVariableDeclaration [src:%s].", v.Type(), yvd.Src)
 				} else {
-					logger.Warnf("Unknown variable nodeType for YulVariableDeclaration [src:%s].", yvd.Src)
+					src.Dropf(logger, "Unknown variable nodeType for YulVariableDeclaration [src:%s].", yvd.Src)
 				}
 			}
 
@@ -53,11 +53,13 @@ func (yvd *YulVariableDeclaration) SourceCode(isSc bool, isIndent bool, indent s
 			code = code + " := " + value.SourceCode(false, false, indent, logger)
 		case *YulIdentifier:
 			code = code + " := " + value.SourceCode(false, false, indent, logger)
+		case *YulLiteral:
+			code = code + " := " + value.SourceCode(false, false, indent, logger)
 		default:
 			if value != nil {
-				logger.Warnf("Unknown value nodeType [%s] for YulVariableDeclaration [src:%s].", value.Type(), yvd.Src)
+				src.Dropf(logger, "Unknown value nodeType [%s] for YulVariableDeclaration [src:%s].", value.Type(), yvd.Src)
 			} else {
-				logger.Warnf("Unknown value nodeType for YulVariableDeclaration [src:%s].", yvd.Src)
+				src.Dropf(logger, "Unknown value nodeType for YulVariableDeclaration [src:%s].", yvd.Src)
 			}
 		}
 	}
@@ -79,7 +81,7 @@ func (yvd *YulVariableDeclaration) NodeID() int {
 
 func GetYulVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging.Logger) (*YulVariableDeclaration, error) {
 	yvd := new(YulVariableDeclaration)
-	if err := json.Unmarshal([]byte(raw.ToString()), yvd); err != nil {
+	if err := src.UnmarshalAny(raw, yvd); err != nil {
 		logger.Errorf("Failed to unmarshal YulVariableDeclaration: [%v].", err)
 		return nil, fmt.Errorf("failed to unmarshal YulVariableDeclaration: [%v]", err)
 	}
@@ -97,8 +99,11 @@ func GetYulVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging
 				yvdValue, err = GetYulFunctionCall(gn, value, logger)
 			case "YulIdentifier":
 				yvdValue, err = GetYulIdentifier(gn, value, logger)
+			case "YulLiteral":
+				yvdValue, err = GetYulLiteral(gn, value, logger)
 			default:
 				logger.Warnf("Unknown value nodeType [%s] for YulVariableDeclaration [src:%s].", valueNodeType, yvd.Src)
+				gn.AddUnknownNode(valueNodeType, value.Get("src").ToString())
 			}
 
 			if err != nil {
@@ -129,6 +134,7 @@ func GetYulVariableDeclaration(gn *GlobalNodes, raw jsoniter.Any, logger logging
 						yvdVariable, err = GetYulTypedName(gn, variable, logger)
 					default:
 						logger.Warnf("Unknown variable nodeType [%s] for YulVariableDeclaration [src:%s].", variableNodeType, yvd.Src)
+						gn.AddUnknownNode(variableNodeType, variable.Get("src").ToString())
 					}
 
 					if err != nil {
@@ -0,0 +1,83 @@
+package ast
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/golden"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestNodeCoverage 检查导入指令、文件级的函数、结构体、枚举与自定义值类型、函数类型、映射与数组在各类型位置上的嵌套、
+// Yul 函数定义及 leave 与 continue、以及 NatSpec 注释能够被解析并输出，不会丢弃任何节点。
+func TestNodeCoverage(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", "coverage.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	gn := NewGlobalNodes()
+	sourceUnit, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gn.UnknownNodes()) > 0 {
+		t.Fatalf("unexpected unknown nodes %+v", gn.UnknownNodes())
+	}
+
+	code, err := src.PrintSourceCode(sourceUnit, true, logger)
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, log.String())
+	}
+	golden.Assert(t, filepath.Join("..", "testdata", "coverage.sol.golden"), []byte(code))
+}
+
+// TestExpressionCoverage 检查 if、while、do、for 的条件，一元运算的操作数，变量声明的初始值，return 的表达式，
+// 修饰器的参数以及下标访问的基表达式中出现的函数调用、成员访问、下标访问、一元运算、括号与标识符不会被丢弃，
+// 并且条件中的函数调用能够被遍历到。
+func TestExpressionCoverage(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", "expressions.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	gn := NewGlobalNodes()
+	sourceUnit, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gn.UnknownNodes()) > 0 {
+		t.Fatalf("unexpected unknown nodes %+v", gn.UnknownNodes())
+	}
+
+	code, err := src.PrintSourceCode(sourceUnit, true, logger)
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, log.String())
+	}
+	golden.Assert(t, filepath.Join("..", "testdata", "expressions.sol.golden"), []byte(code))
+
+	g := -1
+	Inspect(sourceUnit, func(node ASTNode) bool {
+		if fd, ok := node.(*FunctionDefinition); ok && fd.Name == "g" {
+			g = fd.NodeID()
+		}
+		return true
+	})
+	calls := 0
+	Inspect(sourceUnit, func(node ASTNode) bool {
+		if fc, ok := node.(*FunctionCall); ok && fc.ReferencedFunctionDefinition() == g {
+			calls++
+		}
+		return true
+	})
+	if calls != 2 {
+		t.Errorf("expected the calls to g in the if and for conditions to be walked, got %d", calls)
+	}
+}
@@ -0,0 +1,45 @@
+package ast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestTraverseDelegatecallOptions 检查 t.delegatecall{gas: 1000}(d) 这样带调用选项的 delegatecall 同样会被报告为调用未知合约。
+func TestTraverseDelegatecallOptions(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", "delegatecall.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gn := NewGlobalNodes()
+	sourceUnit, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), src.SilentLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var call *FunctionCall
+	Inspect(sourceUnit, func(node ASTNode) bool {
+		if fc, ok := node.(*FunctionCall); ok {
+			if _, ok := fc.expression.(*FunctionCallOptions); ok {
+				call = fc
+			}
+		}
+		return call == nil
+	})
+	if call == nil {
+		t.Fatal("expected a delegatecall with call options")
+	}
+
+	opt := new(Option)
+	opt.MakeDelegatecallUnknownContractCh(1)
+	call.TraverseFunctionCall(new(NormalCallPath), gn, opt, src.SilentLogger)
+	select {
+	case <-opt.DelegatecallUnknownContractCh():
+	default:
+		t.Error("expected the delegatecall with call options to be reported")
+	}
+}
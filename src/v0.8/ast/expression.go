@@ -0,0 +1,72 @@
+package ast
+
+import (
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// GetExpression 解析表达式位置上的节点，所有表达式位置共用这一组表达式类型，避免每个节点各自维护一份不完整的列表；
+// field 与 owner 为所在的字段与节点类型，at 为所在节点的 src，只用于日志。nodeType 不是表达式时记录为未知节点并返回 nil。
+func GetExpression(gn *GlobalNodes, raw jsoniter.Any, field string, owner string, at string, logger logging.Logger) (ASTNode, error) {
+	nodeType := raw.Get("nodeType").ToString()
+
+	switch nodeType {
+	case "Assignment":
+		return GetAssignment(gn, raw, logger)
+	case "BinaryOperation":
+		return GetBinaryOperation(gn, raw, logger)
+	case "Conditional":
+		return GetConditional(gn, raw, logger)
+	case "ElementaryTypeNameExpression":
+		return GetElementaryTypeNameExpression(gn, raw, logger)
+	case "FunctionCall":
+		return GetFunctionCall(gn, raw, logger)
+	case "FunctionCallOptions":
+		return GetFunctionCallOptions(gn, raw, logger)
+	case "Identifier":
+		return GetIdentifier(gn, raw, logger)
+	case "IndexAccess":
+		return GetIndexAccess(gn, raw, logger)
+	case "IndexRangeAccess":
+		return GetIndexRangeAccess(gn, raw, logger)
+	case "Literal":
+		return GetLiteral(gn, raw, logger)
+	case "MemberAccess":
+		return GetMemberAccess(gn, raw, logger)
+	case "NewExpression":
+		return GetNewExpression(gn, raw, logger)
+	case "TupleExpression":
+		return GetTupleExpression(gn, raw, logger)
+	case "UnaryOperation":
+		return GetUnaryOperation(gn, raw, logger)
+	default:
+		logger.Warnf("Unknown %s nodeType [%s] for %s [src:%s].", field, nodeType, owner, at)
+		gn.AddUnknownNode(nodeType, raw.Get("src").ToString())
+	}
+
+	return nil, nil
+}
+
+// IsExpression 判断 node 是否为 GetExpression 能够解析的表达式节点。
+func IsExpression(node ASTNode) bool {
+	switch node.(type) {
+	case *Assignment, *BinaryOperation, *Conditional, *ElementaryTypeNameExpression, *FunctionCall, *FunctionCallOptions, *Identifier,
+		*IndexAccess, *IndexRangeAccess, *Literal, *MemberAccess, *NewExpression, *TupleExpression, *UnaryOperation:
+		return true
+	}
+	return false
+}
+
+// ExpressionCode 输出表达式位置上的节点 node，node 不是表达式时丢弃它并返回空字符串；field、owner 与 at 的含义同 GetExpression。
+func ExpressionCode(node ASTNode, indent string, field string, owner string, at string, logger logging.Logger) string {
+	if node != nil && IsExpression(node) {
+		return node.SourceCode(false, false, indent, logger)
+	}
+	if node != nil {
+		src.Dropf(logger, "Unknown %s nodeType [%s] for %s [src:%s].", field, node.Type(), owner, at)
+	} else {
+		src.Dropf(logger, "Unknown %s nodeType for %s [src:%s].", field, owner, at)
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package ast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func FuzzGetSourceUnit(f *testing.F) {
+	// 种子使用手写的小型语法树，变异后仍然能够到达检测、插桩与输出源码的逻辑；contracts 下的语法树太大，变异没有意义。
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":[{"nodeType":"PragmaDirective","literals":["solidity","^","0.8",".0"]},{"id":1,"nodeType":"ContractDefinition","name":"C","contractKind":"contract","nodes":[]}]}`))
+	for _, seed := range []string{"owner.json", "delegatecall.json"} {
+		jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", seed))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(jsonBytes)
+	}
+	// nodes 不是数组时按下标访问不能 panic。
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":{"":""}}`))
+
+	logger := src.SilentLogger
+
+	f.Fuzz(func(t *testing.T, jsonBytes []byte) {
+		gn := NewGlobalNodes()
+		sourceUnit, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), logger)
+		if err != nil {
+			return
+		}
+		sourceUnit.SourceCode(false, false, "", logger)
+
+		for _, function := range gn.Functions() {
+			fd, ok := function.(*FunctionDefinition)
+			if !ok {
+				continue
+			}
+			opt := &Option{}
+			opt.MakeDelegatecallUnknownContractCh(1)
+			opt.MakeDelegatecallKnownContractCh(1)
+			fd.TraverseFunctionCall(NewNormalCallPath(), gn, opt, logger)
+			fd.TraverseFunctionCall(NewNormalCallPath(), gn, nil, logger)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package ast
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/golden"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestBreakContinue(t *testing.T) {
+	logger := logging.MustNewLogger(logging.Option{
+		Module:         "Test",
+		FilterLevel:    logging.PanicLevel,
+		FormatSelector: "terminal",
+		Writer:         io.Discard,
+	})
+
+	raw := `{"id":5,"nodeType":"Block","src":"0:40:0","statements":[
+		{"id":1,"nodeType":"Break","src":"2:5:0"},
+		{"id":2,"nodeType":"Continue","src":"9:8:0"},
+		{"id":4,"nodeType":"IfStatement","src":"19:20:0",
+			"condition":{"id":3,"nodeType":"Literal","kind":"bool","value":"true","src":"23:4:0","typeDescriptions":{"typeIdentifier":"t_bool","typeString":"bool"}},
+			"trueBody":{"id":6,"nodeType":"Continue","src":"29:8:0"}}
+	]}`
+	gn := NewGlobalNodes()
+	block, err := GetBlock(gn, jsoniter.Get([]byte(raw)), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gn.UnknownNodes()) > 0 {
+		t.Fatalf("unexpected unknown nodes %+v", gn.UnknownNodes())
+	}
+
+	statements := block.Nodes()
+	if b, ok := statements[0].(*Break); !ok || b.NodeID() != 1 {
+		t.Fatalf("expected Break, got %T", statements[0])
+	}
+	if c, ok := statements[1].(*Continue); !ok || c.NodeID() != 2 || c.Type() != "Continue" {
+		t.Fatalf("expected Continue, got %T", statements[1])
+	}
+
+	expected := "    break;\n    continue;\n    if(true) {\n        continue;\n    }"
+	if got := block.SourceCode(false, false, "", logger); got != expected {
+		t.Errorf("unexpected source code:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+// TestStatementBodies 检查 else 分支与循环体是单个语句、unchecked 中的 break 与 continue 以及返回切片的语句能够被解析并输出，
+// 不会丢弃任何节点。
+func TestStatementBodies(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", "bodies.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	gn := NewGlobalNodes()
+	sourceUnit, err := GetSourceUnit(gn, jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gn.UnknownNodes()) > 0 {
+		t.Fatalf("unexpected unknown nodes %+v", gn.UnknownNodes())
+	}
+
+	code, err := src.PrintSourceCode(sourceUnit, true, logger)
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, log.String())
+	}
+	golden.Assert(t, filepath.Join("..", "testdata", "bodies.sol.golden"), []byte(code))
+}
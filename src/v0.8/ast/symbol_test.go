@@ -0,0 +1,121 @@
+package ast
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/logging"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestSymbolTable(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "..", "contracts", "v0.8", "*.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := logging.MustNewLogger(logging.Option{
+		Module:         "Test",
+		FilterLevel:    logging.PanicLevel,
+		FormatSelector: "terminal",
+		Writer:         io.Discard,
+	})
+
+	for _, fixture := range fixtures {
+		jsonBytes, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		su, err := GetSourceUnit(NewGlobalNodes(), jsoniter.Get(jsonBytes), logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st := NewSymbolTable(su)
+
+		// 标识符与它引用的声明同名。
+		Inspect(su, func(node ASTNode) bool {
+			if identifier, ok := node.(*Identifier); ok {
+				if symbol := st.Resolve(identifier); symbol != nil && symbol.Name != identifier.Name {
+					t.Errorf("%s: identifier [%s] is resolved to [%s]", fixture, identifier.Name, symbol.Name)
+				}
+			}
+			return true
+		})
+
+		for _, symbol := range st.Symbols() {
+			for _, reference := range st.References(symbol.ID) {
+				if st.Resolve(reference) != symbol {
+					t.Errorf("%s: reference of [%s] is not resolved to it", fixture, symbol.Name)
+				}
+			}
+
+			switch symbol.Kind {
+			case SymbolParameter:
+				// 参数在所属函数的作用域中可以按名字找到，并且遮蔽同名的状态变量。
+				if symbol.Name != "" {
+					if found := st.Lookup(symbol.Scope, symbol.Name); found != symbol {
+						t.Errorf("%s: lookup of parameter [%s] in scope [%d] returns %v", fixture, symbol.Name, symbol.Scope, found)
+					}
+				}
+			case SymbolStateVariable:
+				if symbol.Contract == nil {
+					t.Errorf("%s: state variable [%s] has no contract", fixture, symbol.Name)
+				} else if found := st.StateVariable(symbol.Contract, symbol.Name); found != symbol {
+					t.Errorf("%s: state variable [%s] of [%s] is not found", fixture, symbol.Name, symbol.Contract.Name)
+				}
+			case SymbolContract:
+				if exported := st.Exported(symbol.Name); len(exported) == 0 || exported[0] != symbol {
+					t.Errorf("%s: contract [%s] is not exported", fixture, symbol.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestTaintOwnerComparesDeclarations(t *testing.T) {
+	logger := logging.MustNewLogger(logging.Option{
+		Module:         "Test",
+		FilterLevel:    logging.PanicLevel,
+		FormatSelector: "terminal",
+		Writer:         io.Discard,
+	})
+
+	jsonBytes, err := os.ReadFile(filepath.Join("..", "testdata", "shadow.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	su, err := GetSourceUnit(NewGlobalNodes(), jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := NewSymbolTable(su)
+	contract := su.nodes[1].(*ContractDefinition)
+	owner := st.StateVariable(contract, "owner")
+	if owner == nil {
+		t.Fatal("state variable [owner] is not found")
+	}
+
+	// setOwner 对状态变量 owner 赋值，需要追踪；init 的参数 owner 遮蔽了状态变量，对它的赋值不需要追踪。
+	tainted := make(map[string]bool)
+	for _, node := range contract.nodes {
+		fd, ok := node.(*FunctionDefinition)
+		if !ok {
+			continue
+		}
+		Inspect(fd, func(node ASTNode) bool {
+			if a, ok := node.(*Assignment); ok {
+				opt := &Option{Symbols: st, OwnerVariable: owner}
+				a.TraverseTaintOwner(opt, logger)
+				tainted[fd.Name] = opt.IsTainted
+			}
+			return true
+		})
+	}
+	for name, expected := range map[string]bool{"setOwner": true, "init": false} {
+		if got, ok := tainted[name]; !ok || got != expected {
+			t.Errorf("assignment to owner in [%s]: tainted = %v, expected %v", name, got, expected)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package v08
+
+import (
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+)
+
+// Detector 是一个检测器，Run 对每个被分析的函数调用一次，通过 ctx.Report 上报检测结果。
+type Detector = src.Detector[*AnalysisContext]
+
+// AnalysisContext 是检测器分析单个函数时可以访问的上下文，与语法树无关的部分见 analysis.FunctionContext；
+// Contract 在自由函数中为 nil。
+type AnalysisContext struct {
+	analysis.FunctionContext
+
+	GlobalNodes *ast.GlobalNodes
+	Symbols     *ast.SymbolTable
+	Function    *ast.FunctionDefinition
+	Contract    *ast.ContractDefinition
+}
+
+var registry src.Registry[*AnalysisContext]
+
+// Register 注册一个检测器，一般在 init 中调用；id 重复时 panic。
+func Register(d Detector) {
+	registry.Register(d)
+}
+
+// Detectors 按照注册的顺序返回所有检测器。
+func Detectors() []Detector {
+	return registry.Detectors()
+}
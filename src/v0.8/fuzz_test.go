@@ -0,0 +1,77 @@
+package v08
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/ir"
+	"github.com/geistwelt/taintguard/src/rule"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// fuzzRules 覆盖自定义规则的各类匹配条件，使 rule.Run 在变异后的语法树上同样被执行。
+const fuzzRules = `
+rules:
+  - id: fuzz-delegatecall
+    message: "delegatecall to $TARGET"
+    match:
+      nodeType: FunctionCall
+      expression:
+        memberName: delegatecall
+        expression: {name: $TARGET}
+    inside:
+      nodeType: FunctionDefinition
+      visibility: [public, external]
+    not-inside:
+      nodeType: FunctionDefinition
+      modifiers:
+        modifierName: {name: {regex: "^only"}}
+  - id: fuzz-unguarded-function
+    match:
+      nodeType: FunctionDefinition
+      modifiers: {not: {nodeType: ModifierInvocation}}
+      has: {nodeType: Assignment}
+`
+
+// FuzzRun 直接调用 run、analysis.Run 与 rule.Run，使 panic 暴露为测试失败，而不是被 Run 或 main 转换为错误。
+func FuzzRun(f *testing.F) {
+	// 种子使用手写的小型语法树，变异后仍然能够到达检测、插桩与输出源码的逻辑；contracts 下的语法树太大，变异没有意义。
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":[{"nodeType":"PragmaDirective","literals":["solidity","^","0.8",".0"]},{"id":1,"nodeType":"ContractDefinition","name":"C","contractKind":"contract","nodes":[]}]}`))
+	for _, seed := range []string{"owner.json", "delegatecall.json"} {
+		jsonBytes, err := os.ReadFile(filepath.Join("testdata", seed))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(jsonBytes)
+	}
+	// nodes 不是数组时按下标访问不能 panic。
+	f.Add([]byte(`{"nodeType":"SourceUnit","nodes":{"":""}}`))
+
+	logger := src.SilentLogger
+	rules, err := rule.Parse([]byte(fuzzRules))
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, jsonBytes []byte) {
+		source, err := src.Preload(jsoniter.Get(jsonBytes))
+		if err != nil {
+			return
+		}
+		conf := config.Default()
+		reachability := analysis.New(ir.Build(source))
+		// 基于中间表示的检测器与自定义规则不依赖 run 的结果，即使 run 返回错误也执行。
+		analysis.Run(reachability, conf)
+		rule.Run(rules, source, conf)
+
+		node, _, err := run(source, reachability, false, logger, "fuzz.sol", t.TempDir(), conf, false)
+		if err != nil {
+			return
+		}
+		node.SourceCode(false, false, "", logger)
+	})
+}
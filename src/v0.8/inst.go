@@ -4,60 +4,40 @@ import (
 	"fmt"
 
 	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/layout"
 	"github.com/geistwelt/taintguard/src/v0.8/ast"
 )
 
-func IsInheritFromOwnableContract(contract *ast.ContractDefinition, gn *ast.GlobalNodes, variables []string) (bool, *ast.ContractDefinition) {
-	for _, node := range contract.Nodes() {
-		switch n := node.(type) {
-		case *ast.VariableDeclaration:
-			// Here the parameter names can be defined via the command line.
-			for _, variable := range variables {
-				if n.Name == variable {
-					return false, nil
-				}
-			}
-		}
+// OwnerVariable 通过符号表 symbols 查找合约 contract（包括继承来的）中名字在 variables 中的状态变量，
+// 多个变量匹配时按照 C3 线性化的顺序返回声明在最接近 contract 的合约中的那个，没有匹配的变量时返回 nil。
+func OwnerVariable(contract *ast.ContractDefinition, variables []string, symbols *ast.SymbolTable) *ast.Symbol {
+	order := make(map[int]int, len(contract.LinearizedBaseContracts))
+	for index, base := range contract.LinearizedBaseContracts {
+		order[base] = index
 	}
-
-	for _, contractNodeID := range contract.LinearizedBaseContracts {
-		if contractNodeID == contract.NodeID() {
-			continue
-		}
-		baseContract := gn.ContractsByID()[contractNodeID].(*ast.ContractDefinition)
-		if baseContract.Name == "Ownable" {
-			return true, baseContract
-		} else {
-			for _, node := range baseContract.Nodes() {
-				switch n := node.(type) {
-				case *ast.VariableDeclaration:
-					for _, variable := range variables {
-						if n.Name == variable {
-							return true, baseContract
-						}
-					}
-				}
-			}
+	var owner *ast.Symbol
+	for _, variable := range variables {
+		symbol := symbols.StateVariable(contract, variable)
+		if symbol != nil && (owner == nil || order[symbol.Contract.ID] < order[owner.Contract.ID]) {
+			owner = symbol
 		}
 	}
-
-	return false, nil
+	return owner
 }
 
-func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string) string {
+// InstrumentCodeForOwner 为 owner 变量（见 OwnerVariable）在声明它的合约中添加记录修改的状态变量，并在每处修改之后记录修改后的值，
+// 所有修改由过程间的写入分析（见 analysis.WriteSet）找出；
+// namespaced 为 true 时记录保存在 EIP-7201 命名空间中（见 instrumentTrackStorage），不在合约末尾追加状态变量。
+func InstrumentCodeForOwner(owner *ast.Symbol, a *analysis.Analysis, layouts []*layout.Layout, namespaced bool, gn *ast.GlobalNodes, logger logging.Logger) string {
+	contract := owner.Contract
 	var ownerVariableName string
 	for _, node := range contract.Nodes() {
 		if node.Type() == "VariableDeclaration" {
 			// check
 			vdNode, _ := node.(*ast.VariableDeclaration)
-			// Here the parameter names can be defined via the command line.
-			var ok bool
-			for _, variable := range variables {
-				if vdNode.Name == variable {
-					ok = true
-				}
-			}
-			if ok {
+			if node == owner.Node {
 				ownerVariableName = vdNode.Name
 
 				instReturnOwnerFunction := &ast.FunctionDefinition{
@@ -158,20 +138,19 @@ func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string
 				var isExist bool = false
 				for _, node_ := range contract.Nodes() {
 					if node_.Type() == "VariableDeclaration" {
-						if node_.SourceCode(false, false, "", nil) == protect1.SourceCode(false, false, "", nil) {
+						if node_.SourceCode(false, false, "", src.SilentLogger) == protect1.SourceCode(false, false, "", src.SilentLogger) {
 							isExist = true
 						}
 					}
 				}
-				if !isExist {
+				if namespaced {
+					isExist = !instrumentTrackStorage(contract, vdNode.Name)
+				} else if !isExist {
 					contract.AppendNode(protect1)
 					contract.AppendNode(protect2)
-
-					contract.TraverseTaintOwner(&ast.Option{
-						TrackOwnerVariableName:   fmt.Sprintf("xxx_track_%s", vdNode.Name),
-						TrackOwnerMappingName:    fmt.Sprintf("xxx_track_mapping_%s", vdNode.Name),
-						SimilarOwnerVariableName: vdNode.Name,
-					}, logging.MustNewLogger())
+				}
+				if !isExist {
+					InstrumentCodeForWrites(vdNode.Name, a.WriteSet(contract.Name, vdNode.Name, layouts), namespaced, gn, logger)
 				}
 			}
 		}
@@ -179,7 +158,8 @@ func InstrumentCodeForOwner(contract *ast.ContractDefinition, variables []string
 	return ownerVariableName
 }
 
-func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDefinition) {
+func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDefinition, guard string, namespaced bool, logger logging.Logger) {
+	track, trackMapping := trackNames(ownerVariableName, namespaced)
 	expressionStatement := &ast.ExpressionStatement{
 		NodeType: "ExpressionStatement",
 		Src:      "xxx",
@@ -194,7 +174,7 @@ func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDef
 			TypeIdentifier string `json:"typeIdentifier"`
 			TypeString     string `json:"typeString"`
 		}{{TypeIdentifier: "t_bool", TypeString: "bool"}},
-		Name:     "assert",
+		Name:     guard,
 		NodeType: "Identifier",
 		Src:      "xxx",
 	}
@@ -218,12 +198,12 @@ func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDef
 		Src:      "xxx",
 	}
 	binaryOperationLeftExpressionBaseExpression := &ast.Identifier{
-		Name:     fmt.Sprintf("xxx_track_mapping_%s", ownerVariableName),
+		Name:     trackMapping,
 		NodeType: "Identifier",
 		Src:      "xxx",
 	}
 	binaryOperationLeftExpressionIndexExpression := &ast.Identifier{
-		Name:     fmt.Sprintf("xxx_track_%s", ownerVariableName),
+		Name:     track,
 		NodeType: "Identifier",
 		Src:      "xxx",
 	}
@@ -236,7 +216,7 @@ func InstrumentCodeForAssert(ownerVariableName string, contract *ast.ContractDef
 	binaryOperationLeftExpression.SetIndexExpression(binaryOperationLeftExpressionIndexExpression)
 	functionCall.AppendArgument(functionCallArgument)
 
-	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logging.MustNewLogger())
+	contract.TraverseDelegatecall(&ast.Option{ExpressionStatement: expressionStatement}, logger)
 }
 
 func VerifyVariableDeclarationOrder(callerContract, calleeContract *ast.ContractDefinition, gn *ast.GlobalNodes, variables []string) bool {
@@ -0,0 +1,58 @@
+package v08
+
+import (
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestOwnerVariable 检查 owner 变量按照声明而不是合约名解析：名为 Ownable 却没有声明 owner 的父合约与不在继承链上的同名变量都不会被选中，
+// 多个变量匹配时选择声明在最接近的合约中的那个。
+func TestOwnerVariable(t *testing.T) {
+	raw := `{"id":1,"nodeType":"SourceUnit","src":"0:0:0","nodes":[
+		{"id":10,"nodeType":"ContractDefinition","name":"Ownable","contractKind":"contract","linearizedBaseContracts":[10],"src":"0:0:0","nodes":[]},
+		{"id":20,"nodeType":"ContractDefinition","name":"Base","contractKind":"contract","linearizedBaseContracts":[20],"src":"0:0:0","nodes":[
+			{"id":21,"nodeType":"VariableDeclaration","name":"owner","stateVariable":true,"visibility":"internal","src":"0:0:0",
+				"typeName":{"id":22,"nodeType":"ElementaryTypeName","name":"address","src":"0:0:0","typeDescriptions":{"typeIdentifier":"t_address","typeString":"address"}},
+				"typeDescriptions":{"typeIdentifier":"t_address","typeString":"address"}}
+		]},
+		{"id":30,"nodeType":"ContractDefinition","name":"Proxy","contractKind":"contract","linearizedBaseContracts":[30,20,10],"src":"0:0:0","nodes":[
+			{"id":31,"nodeType":"VariableDeclaration","name":"admin","stateVariable":true,"visibility":"internal","src":"0:0:0",
+				"typeName":{"id":32,"nodeType":"ElementaryTypeName","name":"address","src":"0:0:0","typeDescriptions":{"typeIdentifier":"t_address","typeString":"address"}},
+				"typeDescriptions":{"typeIdentifier":"t_address","typeString":"address"}}
+		]},
+		{"id":40,"nodeType":"ContractDefinition","name":"Other","contractKind":"contract","linearizedBaseContracts":[40,10],"src":"0:0:0","nodes":[]}
+	]}`
+	gn := ast.NewGlobalNodes()
+	sourceUnit, err := ast.GetSourceUnit(gn, jsoniter.Get([]byte(raw)), src.SilentLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	symbols := ast.NewSymbolTable(sourceUnit)
+	contract := func(id int) *ast.ContractDefinition {
+		return gn.ContractsByID()[id].(*ast.ContractDefinition)
+	}
+
+	tests := []struct {
+		contract  int
+		variables []string
+		expected  int
+	}{
+		{30, []string{"owner"}, 21},
+		{30, []string{"owner", "admin"}, 31},
+		{20, []string{"owner"}, 21},
+		{40, []string{"owner"}, 0},
+	}
+	for _, test := range tests {
+		owner := OwnerVariable(contract(test.contract), test.variables, symbols)
+		var got int
+		if owner != nil {
+			got = owner.ID
+		}
+		if got != test.expected {
+			t.Errorf("owner variable of [%s] with %v is [%d], expected [%d]", contract(test.contract).Name, test.variables, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package v08
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/golden"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+)
+
+func TestRunGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "..", "contracts", "v0.8", "*.sol_json.ast"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		solFileName := strings.TrimSuffix(filepath.Base(fixture), "_json.ast")
+		t.Run(solFileName, func(t *testing.T) {
+			source, reachability := golden.Load(t, fixture)
+
+			var log bytes.Buffer
+			logger := golden.NewLogger(&log)
+			dirName := t.TempDir()
+
+			node, findings, err := Run(source, reachability, true, logger, solFileName, dirName, config.Default(), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			code := node.SourceCode(false, false, "", logger)
+
+			cg, err := golden.CallGraph(filepath.Join(dirName, "call-graph", solFileName))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := filepath.Join("testdata", solFileName)
+			golden.Assert(t, filepath.Join(dir, "findings.golden"), golden.Findings(log.Bytes(), findings))
+			golden.Assert(t, filepath.Join(dir, "instrumented.sol.golden"), []byte(code))
+			golden.Assert(t, filepath.Join(dir, "callgraph.dot.golden"), cg)
+		})
+	}
+}
+
+// TestRunNamespaced 检查 owner-guard 为 track、并且把 owner 的追踪信息保存在命名空间中时，插桩不改变合约原有的 storage 布局。
+func TestRunNamespaced(t *testing.T) {
+	solFileName := "13.sol"
+	source, reachability := golden.Load(t, filepath.Join("..", "..", "contracts", "v0.8", solFileName+"_json.ast"))
+	conf := config.Default()
+	conf.OwnerGuard = "track"
+	conf.TrackStorage = "namespaced"
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	node, _, err := Run(source, reachability, false, logger, solFileName, t.TempDir(), conf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(log.String(), "storage layout") {
+		t.Errorf("unexpected storage layout changes:\n%s", log.String())
+	}
+	golden.Assert(t, filepath.Join("testdata", solFileName, "namespaced.sol.golden"), []byte(node.SourceCode(false, false, "", logger)))
+}
+
+// TestRunSnapshot 检查 owner-guard 为 snapshot（0.7 及以上的合约默认）时，owner 与其余特权状态的快照默认保存在内存中，snapshot 为 stack 时保存在局部变量中。
+func TestRunSnapshot(t *testing.T) {
+	solFileName := "13.sol"
+	for _, location := range []string{"auto", "stack"} {
+		t.Run(location, func(t *testing.T) {
+			source, reachability := golden.Load(t, filepath.Join("..", "..", "contracts", "v0.8", solFileName+"_json.ast"))
+			conf := config.Default()
+			conf.OwnerGuard = "snapshot"
+			conf.Snapshot = location
+
+			var log bytes.Buffer
+			logger := golden.NewLogger(&log)
+			node, findings, err := Run(source, reachability, false, logger, solFileName, t.TempDir(), conf, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(log.String(), "storage layout") {
+				t.Errorf("unexpected storage layout changes:\n%s", log.String())
+			}
+			code := node.SourceCode(false, false, "", logger)
+			if location == "stack" {
+				if strings.Contains(code, "mstore") || !strings.Contains(code, "xxx_snapshot_owner_") {
+					t.Errorf("snapshots should stay on the stack:\n%s", code)
+				}
+				return
+			}
+			dir := filepath.Join("testdata", solFileName)
+			golden.Assert(t, filepath.Join(dir, "snapshot.findings.golden"), golden.Findings(log.Bytes(), findings))
+			golden.Assert(t, filepath.Join(dir, "snapshot.sol.golden"), []byte(code))
+		})
+	}
+}
+
+// TestPrintSourceCode 检查输出源码时被丢弃的节点会被记录，并在 strict 模式下导致错误。
+func TestPrintSourceCode(t *testing.T) {
+	block := &ast.Block{NodeType: "Block", Src: "0:10:0"}
+	block.AppendStatement(&ast.Break{NodeType: "Break", Src: "1:5:0"})
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	if _, err := src.PrintSourceCode(block, true, logger); err != nil || log.Len() > 0 {
+		t.Fatalf("unexpected dropped nodes: [%v] %s", err, log.String())
+	}
+
+	// Block 不会输出 Yul 节点。
+	block.AppendStatement(&ast.YulLiteral{Kind: "number", NodeType: "YulLiteral", Src: "7:1:0", Value: "1"})
+	if _, err := src.PrintSourceCode(block, false, logger); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(log.String(), "dropped [1] node(s)") {
+		t.Errorf("expected a coverage warning, got:\n%s", log.String())
+	}
+	if _, err := src.PrintSourceCode(block, true, logger); err == nil || !strings.Contains(err.Error(), "YulLiteral") {
+		t.Errorf("expected a strict mode error, got [%v]", err)
+	}
+}
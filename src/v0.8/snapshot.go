@@ -0,0 +1,256 @@
+package v08
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/geistwelt/logging"
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/layout"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+)
+
+// InstrumentCodeForSnapshot 在合约的每个 delegatecall 语句之前保存 states 中每个状态的快照，并在语句之后用 guard 检查它们没有被修改；
+// storage 不为 nil 时，storage 中能找到的状态变量通过 mstore 与 mload 保存在内存中，每个语句只占用一个保存地址的局部变量，
+// 其余的状态保存在局部变量中。返回插入的快照，合约中没有 delegatecall 语句时返回 nil。
+func InstrumentCodeForSnapshot(states []*analysis.State, contract *ast.ContractDefinition, guard string, storage *layout.Layout, logger logging.Logger) []*analysis.Snapshot {
+	if len(states) == 0 {
+		return nil
+	}
+	snapshots := make([]*analysis.Snapshot, len(states))
+	slots := make(map[*analysis.State]*layout.Variable)
+	for i, s := range states {
+		snapshots[i] = &analysis.Snapshot{State: s}
+		if storage == nil || s.Expression != s.Variable.Name {
+			continue
+		}
+		if v := storage.Find(s.Variable.Contract, s.Variable.Name); v != nil {
+			slots[s] = v
+			snapshots[i].Memory = true
+		}
+	}
+
+	found := false
+	for _, node := range contract.Nodes() {
+		f, ok := node.(*ast.FunctionDefinition)
+		if !ok {
+			continue
+		}
+		for _, b := range ast.StatementBlocks(f, ast.IsDelegatecall) {
+			found = instrumentBlock(b, f, states, slots, guard, contract, logger) || found
+		}
+	}
+	if !found {
+		return nil
+	}
+	return snapshots
+}
+
+// instrumentBlock 在语句块 b 中每个调用了 delegatecall 的语句前后插入快照与检查，返回 b 中是否有这样的语句。
+func instrumentBlock(b *ast.Block, f *ast.FunctionDefinition, states []*analysis.State, slots map[*analysis.State]*layout.Variable, guard string, contract *ast.ContractDefinition, logger logging.Logger) bool {
+	found := false
+	statements := append([]ast.ASTNode(nil), b.Nodes()...)
+	// 检查插入在 owner 的断言等已经插入的语句之后，使它们在重复插桩时仍然紧跟在 delegatecall 语句之后；
+	// 位置在插入之前确定，不会越过后一个语句的快照。
+	nexts := make([]int, len(statements))
+	for i := range statements {
+		next := i + 1
+		for next < len(statements) && inserted(statements[next]) && !ast.Calls(statements[next], ast.IsDelegatecall) {
+			next++
+		}
+		nexts[i] = next
+	}
+	// 从后往前插入，插入的语句不会影响前面语句的位置。
+	for i := len(statements) - 1; i >= 0; i-- {
+		if !ast.Calls(statements[i], ast.IsDelegatecall) {
+			continue
+		}
+		found = true
+		id := statements[i].NodeID()
+		var before, after, stores, loads []ast.ASTNode
+		pointer := fmt.Sprintf("xxx_snapshot_%d", id)
+		for _, s := range states {
+			if v, ok := slots[s]; ok {
+				// delegatecall 的目标以及重入的调用都有各自的内存，不能修改保存在当前调用帧内存中的快照。
+				var address ast.ASTNode = yulIdentifier(pointer)
+				if n := len(stores); n > 0 {
+					address = yulCall("add", address, yulNumber(fmt.Sprintf("0x%x", n*32)))
+				}
+				stores = append(stores, yulCall("mstore", address, slotValue(s, v)))
+				loads = append(loads, yulCall("eq", yulCall("mload", address), slotValue(s, v)))
+				continue
+			}
+			name := fmt.Sprintf("xxx_snapshot_%s_%d", snapshotName.ReplaceAllString(s.Expression, "_"), id)
+			before = append(before, snapshotStatement(s.Type, name, s.Expression))
+			after = append(after, checkStatement(guard, s.Expression, name))
+		}
+		if len(stores) > 0 {
+			before = append(before, memorySnapshot(pointer, stores)...)
+			after = append(memoryCheck(guard, fmt.Sprintf("xxx_unchanged_%d", id), loads), after...)
+		}
+		// 同一个合约可能被多个检测结果插桩，已经保存过快照的语句不再重复插入。
+		if i > 0 && statements[i-1].SourceCode(false, false, "", src.SilentLogger) == before[len(before)-1].SourceCode(false, false, "", src.SilentLogger) {
+			continue
+		}
+		next := nexts[i]
+		if r, ok := statements[i].(*ast.Return); ok {
+			// return 语句返回之后无法再检查，先把返回值保存在局部变量中，检查插入在声明与返回之间。
+			if declaration := ast.SplitReturn(r, f); declaration != nil {
+				b.InsertStatement(declaration, i)
+				next = i + 1
+			}
+		}
+		logger.Debugf("Snapshot %d states around the delegatecall statement [%d] of contract [%s], %d of them in memory.", len(states), id, contract.Name, len(stores))
+		for j := len(after) - 1; j >= 0; j-- {
+			b.InsertStatement(after[j], next)
+		}
+		for j := len(before) - 1; j >= 0; j-- {
+			b.InsertStatement(before[j], i)
+		}
+	}
+	return found
+}
+
+// inserted 判断 statement 是否是插桩时插入的语句。
+func inserted(statement ast.ASTNode) bool {
+	switch s := statement.(type) {
+	case *ast.ExpressionStatement:
+		return s.Src == "xxx"
+	case *ast.VariableDeclarationStatement:
+		return s.Src == "xxx"
+	case *ast.InlineAssembly:
+		return s.Src == "xxx"
+	}
+	return false
+}
+
+// snapshotName 匹配表达式中不能出现在变量名中的字符。
+var snapshotName = regexp.MustCompile(`[^A-Za-z0-9_$]+`)
+
+// snapshotStatement 返回 typeName name = expression;
+func snapshotStatement(typeName string, name string, expression string) *ast.VariableDeclarationStatement {
+	declaration := &ast.VariableDeclaration{
+		Mutability:      "mutable",
+		Name:            name,
+		NodeType:        "VariableDeclaration",
+		Src:             "xxx",
+		StorageLocation: "default",
+		Visibility:      "internal",
+	}
+	declaration.SetTypeName(&ast.ElementaryTypeName{Name: typeName, NodeType: "ElementaryTypeName", Src: "xxx"})
+	statement := &ast.VariableDeclarationStatement{NodeType: "VariableDeclarationStatement", Src: "xxx"}
+	statement.AppendDeclaration(declaration)
+	statement.SetInitialValue(&ast.Identifier{Name: expression, NodeType: "Identifier", Src: "xxx"})
+	return statement
+}
+
+// checkStatement 返回 guard(expression == name);
+func checkStatement(guard string, expression string, name string) *ast.ExpressionStatement {
+	comparison := &ast.BinaryOperation{NodeType: "BinaryOperation", Operator: "==", Src: "xxx"}
+	comparison.SetLeftExpression(&ast.Identifier{Name: expression, NodeType: "Identifier", Src: "xxx"})
+	comparison.SetRightExpression(&ast.Identifier{Name: name, NodeType: "Identifier", Src: "xxx"})
+	return guardStatement(guard, comparison)
+}
+
+// guardStatement 返回 guard(condition);
+func guardStatement(guard string, condition ast.ASTNode) *ast.ExpressionStatement {
+	call := &ast.FunctionCall{Kind: "functionCall", NodeType: "FunctionCall", Src: "xxx"}
+	call.SetExpression(&ast.Identifier{
+		ArgumentTypes: []struct {
+			TypeIdentifier string `json:"typeIdentifier"`
+			TypeString     string `json:"typeString"`
+		}{{TypeIdentifier: "t_bool", TypeString: "bool"}},
+		Name:     guard,
+		NodeType: "Identifier",
+		Src:      "xxx",
+	})
+	call.AppendArgument(condition)
+	statement := &ast.ExpressionStatement{NodeType: "ExpressionStatement", Src: "xxx"}
+	statement.SetExpression(call)
+	return statement
+}
+
+// memorySnapshot 返回 uint256 pointer; assembly { pointer := mload(0x40) mstore(pointer, value) ... mstore(0x40, add(pointer, size)) }，
+// 快照保存在空闲内存中，之后分配的内存不会覆盖它。
+func memorySnapshot(pointer string, stores []ast.ASTNode) []ast.ASTNode {
+	allocate := &ast.YulAssignment{NodeType: "YulAssignment", Src: "xxx"}
+	allocate.AppendVariableName(yulIdentifier(pointer))
+	allocate.SetValue(yulCall("mload", yulNumber("0x40")))
+	yul := &ast.YulBlock{NodeType: "YulBlock", Src: "xxx"}
+	yul.AppendStatement(allocate)
+	free := yulCall("mstore", yulNumber("0x40"), yulCall("add", yulIdentifier(pointer), yulNumber(fmt.Sprintf("0x%x", len(stores)*32))))
+	for _, store := range append(stores, free) {
+		statement := &ast.YulExpressionStatement{NodeType: "YulExpressionStatement", Src: "xxx"}
+		statement.SetExpression(store)
+		yul.AppendStatement(statement)
+	}
+	assembly := &ast.InlineAssembly{NodeType: "InlineAssembly", Src: "xxx"}
+	assembly.SetAST(yul)
+
+	return []ast.ASTNode{declarationStatement("uint256", pointer), assembly}
+}
+
+// memoryCheck 返回 bool name; assembly { name := and(eq(mload(pointer), value), ...) } guard(name);
+func memoryCheck(guard string, name string, loads []ast.ASTNode) []ast.ASTNode {
+	unchanged := loads[0]
+	for _, load := range loads[1:] {
+		unchanged = yulCall("and", unchanged, load)
+	}
+	assignment := &ast.YulAssignment{NodeType: "YulAssignment", Src: "xxx"}
+	assignment.AppendVariableName(yulIdentifier(name))
+	assignment.SetValue(unchanged)
+	yul := &ast.YulBlock{NodeType: "YulBlock", Src: "xxx"}
+	yul.AppendStatement(assignment)
+	assembly := &ast.InlineAssembly{NodeType: "InlineAssembly", Src: "xxx"}
+	assembly.SetAST(yul)
+
+	return []ast.ASTNode{declarationStatement("bool", name), assembly, guardStatement(guard, &ast.Identifier{Name: name, NodeType: "Identifier", Src: "xxx"})}
+}
+
+// declarationStatement 返回 typeName name;
+func declarationStatement(typeName string, name string) *ast.VariableDeclarationStatement {
+	declaration := &ast.VariableDeclaration{Mutability: "mutable", Name: name, NodeType: "VariableDeclaration", Src: "xxx", StorageLocation: "default", Visibility: "internal"}
+	declaration.SetTypeName(&ast.ElementaryTypeName{Name: typeName, NodeType: "ElementaryTypeName", Src: "xxx"})
+	statement := &ast.VariableDeclarationStatement{NodeType: "VariableDeclarationStatement", Src: "xxx"}
+	statement.AppendDeclaration(declaration)
+	return statement
+}
+
+// slotValue 返回在汇编中读取状态 s 的表达式：与其它变量共用一个槽时移位并截取它所占的字节，
+// 避免 delegatecall 合法地修改同一个槽中的其它变量时误报；父合约中 private 的状态变量不能通过名字访问，直接使用布局中的 slot。
+func slotValue(s *analysis.State, v *layout.Variable) ast.ASTNode {
+	var slot ast.ASTNode = yulIdentifier(s.Expression + ".slot")
+	if s.Private {
+		slot = yulNumber(fmt.Sprintf("%d", v.Slot))
+	}
+	var value ast.ASTNode = yulCall("sload", slot)
+	if v.Offset > 0 {
+		value = yulCall("shr", yulNumber(fmt.Sprintf("%d", v.Offset*8)), value)
+	}
+	if v.Size < 32 {
+		value = yulCall("and", value, yulNumber("0x"+strings.Repeat("ff", v.Size)))
+	}
+	return value
+}
+
+// yulCall 返回汇编中的函数调用 name(arguments...)。
+func yulCall(name string, arguments ...ast.ASTNode) *ast.YulFunctionCall {
+	call := &ast.YulFunctionCall{NodeType: "YulFunctionCall", Src: "xxx"}
+	call.SetFunctionName(yulIdentifier(name))
+	for _, argument := range arguments {
+		call.AppendArgument(argument)
+	}
+	return call
+}
+
+// yulIdentifier 返回汇编中的标识符 name。
+func yulIdentifier(name string) *ast.YulIdentifier {
+	return &ast.YulIdentifier{Name: name, NodeType: "YulIdentifier", Src: "xxx"}
+}
+
+// yulNumber 返回汇编中的数字字面值。
+func yulNumber(value string) *ast.YulLiteral {
+	return &ast.YulLiteral{Kind: "number", NodeType: "YulLiteral", Src: "xxx", Value: value}
+}
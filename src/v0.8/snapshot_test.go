@@ -0,0 +1,249 @@
+package v08
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/analysis"
+	"github.com/geistwelt/taintguard/src/config"
+	"github.com/geistwelt/taintguard/src/golden"
+	"github.com/geistwelt/taintguard/src/layout"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// TestMemorySnapshot 在一个简单的 Yul 解释器中执行 13.sol 中 delegatecall 前后插入的汇编：delegatecall 的目标与调用者共用 storage，
+// 但有各自的内存，既不能伪造保存在内存中的快照，重入同一个语句时也不会覆盖外层调用的快照。
+func TestMemorySnapshot(t *testing.T) {
+	solFileName := "13.sol"
+	source, reachability := golden.Load(t, filepath.Join("..", "..", "contracts", "v0.8", solFileName+"_json.ast"))
+	conf := config.Default()
+	conf.OwnerGuard = "snapshot"
+
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	node, _, err := Run(source, reachability, false, logger, solFileName, t.TempDir(), conf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocks := regexp.MustCompile(`(?s)assembly \{\n(.*?)\n\s*\}`).FindAllStringSubmatch(node.SourceCode(false, false, "", logger), -1)
+	if len(blocks) != 2 {
+		t.Fatalf("expected the snapshot and the check around one delegatecall, got %d assembly blocks", len(blocks))
+	}
+	before, after := blocks[0][1], blocks[1][1]
+	const unchanged = "xxx_unchanged_47"
+
+	evm := &yulMachine{storage: map[string]*big.Int{"0": big.NewInt(0xa), "1": big.NewInt(0xb)}}
+	caller := evm.frame()
+	evm.run(t, caller, before)
+	evm.run(t, caller, after)
+	if caller.variable(t, unchanged).Sign() == 0 {
+		t.Fatal("the check should pass when delegatecall changes nothing")
+	}
+
+	t.Run("forge", func(t *testing.T) {
+		caller := evm.frame()
+		evm.run(t, caller, before)
+		// delegatecall 的目标把 owner 改为 0xc，并且在自己的调用帧中执行同样的快照，试图用 0xc 覆盖调用者的快照。
+		evm.storage["0"] = big.NewInt(0xc)
+		callee := evm.frame()
+		evm.run(t, callee, before)
+		evm.run(t, caller, after)
+		if caller.variable(t, unchanged).Sign() != 0 {
+			t.Fatal("the check should fail after the callee changes the owner")
+		}
+		evm.storage["0"] = big.NewInt(0xa)
+	})
+
+	t.Run("reentrancy", func(t *testing.T) {
+		outer := evm.frame()
+		evm.run(t, outer, before)
+		// delegatecall 把 owner 改为 0xc，然后通过外部调用重入同一个 delegatecall 语句。
+		evm.storage["0"] = big.NewInt(0xc)
+		inner := evm.frame()
+		evm.run(t, inner, before)
+		evm.run(t, inner, after)
+		if inner.variable(t, unchanged).Sign() == 0 {
+			t.Fatal("the check of the reentrant call should pass")
+		}
+		evm.run(t, outer, after)
+		if outer.variable(t, unchanged).Sign() != 0 {
+			t.Fatal("the check of the outer call should fail after the owner is changed")
+		}
+		evm.storage["0"] = big.NewInt(0xa)
+	})
+}
+
+// yulMachine 只支持插桩时用到的汇编指令，slot 按照 13.sol 中 HackMe 的布局：owner 在 0，lib 在 1。
+// storage 由所有调用帧共用，内存与局部变量属于各自的调用帧。
+type yulMachine struct {
+	storage map[string]*big.Int
+}
+
+// yulFrame 是一个调用帧，空闲内存指针从 0x80 开始。
+type yulFrame struct {
+	memory    map[string]*big.Int
+	variables map[string]*big.Int
+}
+
+var (
+	yulLet    = regexp.MustCompile(`^(?:let )?([A-Za-z_][A-Za-z0-9_]*) := (.*)$`)
+	yulModulo = new(big.Int).Lsh(big.NewInt(1), 256)
+	yulSlots  = map[string]int64{"owner.slot": 0, "lib.slot": 1}
+)
+
+func (m *yulMachine) frame() *yulFrame {
+	return &yulFrame{memory: map[string]*big.Int{"64": big.NewInt(0x80)}, variables: make(map[string]*big.Int)}
+}
+
+func (m *yulMachine) run(t *testing.T, f *yulFrame, code string) {
+	t.Helper()
+	for _, line := range strings.Split(code, "\n") {
+		line = strings.TrimSpace(line)
+		if match := yulLet.FindStringSubmatch(line); match != nil {
+			value, rest, err := m.eval(f, match[2])
+			if err != nil || rest != "" {
+				t.Fatalf("failed to evaluate [%s]: [%v]", line, err)
+			}
+			f.variables[match[1]] = value
+			continue
+		}
+		if _, rest, err := m.eval(f, line); err != nil || rest != "" {
+			t.Fatalf("failed to evaluate [%s]: [%v]", line, err)
+		}
+	}
+}
+
+func (f *yulFrame) variable(t *testing.T, name string) *big.Int {
+	t.Helper()
+	value, ok := f.variables[name]
+	if !ok {
+		t.Fatalf("variable [%s] is not assigned", name)
+	}
+	return value
+}
+
+// eval 计算 code 开头的一个表达式，返回其值以及剩余的代码。
+func (m *yulMachine) eval(f *yulFrame, code string) (*big.Int, string, error) {
+	end := strings.IndexAny(code, "(,)")
+	if end < 0 {
+		end = len(code)
+	}
+	token, rest := strings.TrimSpace(code[:end]), code[end:]
+	if !strings.HasPrefix(rest, "(") {
+		if slot, ok := yulSlots[token]; ok {
+			return big.NewInt(slot), rest, nil
+		}
+		if value, ok := f.variables[token]; ok {
+			return value, rest, nil
+		}
+		value, ok := new(big.Int).SetString(token, 0)
+		if !ok {
+			return nil, "", fmt.Errorf("unknown identifier [%s]", token)
+		}
+		return value, rest, nil
+	}
+
+	var arguments []*big.Int
+	rest = rest[1:]
+	for !strings.HasPrefix(rest, ")") {
+		argument, r, err := m.eval(f, strings.TrimPrefix(strings.TrimSpace(rest), ","))
+		if err != nil {
+			return nil, "", err
+		}
+		arguments = append(arguments, argument)
+		rest = strings.TrimSpace(strings.TrimPrefix(r, ","))
+	}
+	rest = rest[1:]
+
+	result := new(big.Int)
+	switch token {
+	case "add":
+		result.Add(arguments[0], arguments[1]).Mod(result, yulModulo)
+	case "sub":
+		result.Sub(arguments[0], arguments[1]).Mod(result, yulModulo)
+	case "and":
+		result.And(arguments[0], arguments[1])
+	case "shr":
+		result.Rsh(arguments[1], uint(arguments[0].Uint64()))
+	case "eq":
+		if arguments[0].Cmp(arguments[1]) == 0 {
+			result.SetInt64(1)
+		}
+	case "sload":
+		if value, ok := m.storage[arguments[0].String()]; ok {
+			result.Set(value)
+		}
+	case "mload":
+		if value, ok := f.memory[arguments[0].String()]; ok {
+			result.Set(value)
+		}
+	case "mstore":
+		f.memory[arguments[0].String()] = arguments[1]
+	default:
+		return nil, "", fmt.Errorf("unsupported instruction [%s]", token)
+	}
+	return result, rest, nil
+}
+
+// TestInstrumentCodeForSnapshot 检查 delegatecall 出现在不带花括号的分支、带调用选项的调用以及 return 语句中时，
+// 快照插入在语句之前，检查插入在语句之后；return 语句先把返回值保存在新的局部变量中，检查插入在返回之前。
+func TestInstrumentCodeForSnapshot(t *testing.T) {
+	jsonBytes, err := os.ReadFile(filepath.Join("testdata", "delegatecall.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	logger := golden.NewLogger(&log)
+	sourceUnit, err := ast.GetSourceUnit(ast.NewGlobalNodes(), jsoniter.Get(jsonBytes), logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := sourceUnit.Nodes()[1].(*ast.ContractDefinition)
+	states := []*analysis.State{{Expression: "owner", Type: "address"}}
+	for i := 0; i < 2; i++ {
+		// 重复插桩时不再插入。
+		if snapshots := InstrumentCodeForSnapshot(states, contract, "require", nil, logger); len(snapshots) != 1 {
+			t.Fatalf("expected 1 snapshot, got %d", len(snapshots))
+		}
+	}
+	if log.Len() > 0 {
+		t.Errorf("unexpected warnings:\n%s", log.String())
+	}
+	// 保存返回值的变量复制自返回参数，但不能与返回参数共用 id。
+	declarations := make(map[int]bool)
+	ast.Inspect(sourceUnit, func(node ast.ASTNode) bool {
+		if v, ok := node.(*ast.VariableDeclaration); ok && v.ID != 0 {
+			if declarations[v.ID] {
+				t.Errorf("variable declarations share the id %d", v.ID)
+			}
+			declarations[v.ID] = true
+		}
+		return true
+	})
+	golden.Assert(t, filepath.Join("testdata", "delegatecall.sol.golden"), []byte(sourceUnit.SourceCode(false, false, "", logger)))
+}
+
+// TestSlotValue 检查父合约中 private 的状态变量在汇编中按照布局中的 slot 读取，而不是通过名字。
+func TestSlotValue(t *testing.T) {
+	v := &layout.Variable{Contract: "Ownable", Name: "_nominee", Type: "address", Slot: 1, Size: 20}
+	cases := []struct {
+		state    *analysis.State
+		expected string
+	}{
+		{&analysis.State{Expression: "_nominee"}, "and(sload(_nominee.slot), 0xffffffffffffffffffffffffffffffffffffffff)"},
+		{&analysis.State{Expression: "_nominee", Private: true}, "and(sload(1), 0xffffffffffffffffffffffffffffffffffffffff)"},
+	}
+	for _, c := range cases {
+		if code := slotValue(c.state, v).SourceCode(false, false, "", src.SilentLogger); code != c.expected {
+			t.Errorf("unexpected value of [%s] (private: %v): [%s], expected [%s]", c.state.Expression, c.state.Private, code, c.expected)
+		}
+	}
+}
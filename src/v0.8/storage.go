@@ -0,0 +1,86 @@
+package v08
+
+import (
+	"fmt"
+
+	"github.com/geistwelt/taintguard/src"
+	"github.com/geistwelt/taintguard/src/layout"
+	"github.com/geistwelt/taintguard/src/v0.8/ast"
+)
+
+// StorageLayout 返回每个合约按照继承顺序（从最基础的父合约开始）排列的状态变量声明，constant 与 immutable 不占用 storage，不包括在内；
+// 插桩前后各调用一次，用 layout.Diff 检查插桩是否改变了合约原有的 storage 布局。
+func StorageLayout(gn *ast.GlobalNodes) map[string][]string {
+	declarations := make(map[string][]string)
+	for _, node := range gn.ContractsByID() {
+		contract, ok := node.(*ast.ContractDefinition)
+		if !ok {
+			continue
+		}
+		list := make([]string, 0)
+		for i := len(contract.LinearizedBaseContracts) - 1; i >= 0; i-- {
+			base, ok := gn.ContractsByID()[contract.LinearizedBaseContracts[i]].(*ast.ContractDefinition)
+			if !ok {
+				continue
+			}
+			for _, n := range base.Nodes() {
+				if vd, ok := n.(*ast.VariableDeclaration); ok && !vd.Constant && vd.Mutability != "constant" && vd.Mutability != "immutable" {
+					list = append(list, fmt.Sprintf("%s in %s", vd.SourceCode(false, false, "", src.SilentLogger), base.Name))
+				}
+			}
+		}
+		declarations[contract.Name] = list
+	}
+	return declarations
+}
+
+// instrumentTrackStorage 为合约添加保存 owner 追踪信息的结构体 xxx_TrackStorage_owner，以及返回它的 xxx_track_storage_owner()：
+// 结构体位于 EIP-7201 命名空间 taintguard.track.<合约>.<owner> 的根 slot，通过汇编设置 storage 指针的 slot，
+// 不会改变合约原有的 storage 布局；已经添加过时返回 false。
+func instrumentTrackStorage(contract *ast.ContractDefinition, ownerVariableName string) bool {
+	structName := fmt.Sprintf("xxx_TrackStorage_%s", ownerVariableName)
+	for _, node := range contract.Nodes() {
+		if sd, ok := node.(*ast.StructDefinition); ok && sd.Name == structName {
+			return false
+		}
+	}
+
+	storage := &ast.StructDefinition{Name: structName, NodeType: "StructDefinition", Src: "xxx", Visibility: "public"}
+	track := &ast.VariableDeclaration{Mutability: "mutable", Name: "track", NodeType: "VariableDeclaration", Src: "xxx", StorageLocation: "default", Visibility: "internal"}
+	track.SetTypeName(&ast.ElementaryTypeName{Name: "bytes", NodeType: "ElementaryTypeName", Src: "xxx"})
+	trackMapping := &ast.VariableDeclaration{Mutability: "mutable", Name: "trackMapping", NodeType: "VariableDeclaration", Src: "xxx", StorageLocation: "default", Visibility: "internal"}
+	mapping := &ast.Mapping{NodeType: "Mapping", Src: "xxx"}
+	mapping.SetKeyType(&ast.ElementaryTypeName{Name: "bytes", NodeType: "ElementaryTypeName", Src: "xxx"})
+	mapping.SetValueType(&ast.ElementaryTypeName{Name: "address", NodeType: "ElementaryTypeName", Src: "xxx", StateMutability: "nonpayable"})
+	trackMapping.SetTypeName(mapping)
+	storage.AppendMember(track)
+	storage.AppendMember(trackMapping)
+	contract.AppendNode(storage)
+
+	accessor := &ast.FunctionDefinition{
+		Implemented:     true,
+		Kind:            "function",
+		Name:            fmt.Sprintf("xxx_track_storage_%s", ownerVariableName),
+		NodeType:        "FunctionDefinition",
+		Src:             "xxx",
+		StateMutability: "pure",
+		Visibility:      "internal",
+	}
+	pointer := &ast.VariableDeclaration{Name: "s", NodeType: "VariableDeclaration", Src: "xxx", StorageLocation: "storage", Visibility: "internal"}
+	pointer.SetTypeName(&ast.ElementaryTypeName{Name: structName, NodeType: "ElementaryTypeName", Src: "xxx"})
+	returnParameters := &ast.ParameterList{NodeType: "ParameterList", Src: "xxx"}
+	returnParameters.AppendParameter(pointer)
+	accessor.SetReturnParameters(returnParameters)
+	assignment := &ast.YulAssignment{NodeType: "YulAssignment", Src: "xxx"}
+	assignment.AppendVariableName(&ast.YulIdentifier{Name: "s.slot", NodeType: "YulIdentifier", Src: "xxx"})
+	assignment.SetValue(&ast.YulIdentifier{Name: layout.Namespace(fmt.Sprintf("taintguard.track.%s.%s", contract.Name, ownerVariableName)), NodeType: "YulIdentifier", Src: "xxx"})
+	yul := &ast.YulBlock{NodeType: "YulBlock", Src: "xxx"}
+	yul.AppendStatement(assignment)
+	assembly := &ast.InlineAssembly{NodeType: "InlineAssembly", Src: "xxx"}
+	assembly.SetAST(yul)
+	body := &ast.Block{NodeType: "Block", Src: "xxx"}
+	body.AppendStatement(assembly)
+	accessor.SetBody(body)
+	contract.InsertReturnOwnerFunction(accessor)
+	return true
+}